@@ -33,8 +33,42 @@ type Annotation struct {
 	Type string `json:"Type,omitempty"`
 	// Skip exclude the type
 	Skip bool `json:"Skip,omitempty"`
+	// QueryField is the name of the root query field that
+	// returns the connection of the annotated type.
+	QueryField string `json:"QueryField,omitempty"`
+	// RelayMutations holds the mutation operations (e.g. "create") of the
+	// annotated type that follow the Relay mutation specification.
+	RelayMutations []string `json:"RelayMutations,omitempty"`
+	// MutationInputs indicates if the Create<T>Input and Update<T>Input
+	// types should be generated for the annotated type.
+	MutationInputs bool `json:"MutationInputs,omitempty"`
+	// SoftDelete marks the annotated field as the soft-delete marker of its
+	// type. Nodes with a non-null value in this field are considered deleted.
+	SoftDelete bool `json:"SoftDelete,omitempty"`
+	// IncludeDeleted indicates if clients can include the soft-deleted nodes of the type.
+	IncludeDeleted bool `json:"IncludeDeleted,omitempty"`
+	// Scalar is the name of the GraphQL scalar that represents
+	// the custom Go type (GoType) of the annotated field.
+	Scalar string `json:"Scalar,omitempty"`
+	// Constraint holds the arguments of the @constraint directive of the
+	// annotated field in the generated input types.
+	Constraint *ConstraintArgs `json:"Constraint,omitempty"`
+	// Deprecated is the deprecation reason of the root query field of the type.
+	Deprecated string `json:"Deprecated,omitempty"`
 }
 
+// ConstraintArgs holds the arguments of the @constraint directive.
+type ConstraintArgs struct {
+	MinLength *int     `json:"minLength,omitempty"`
+	MaxLength *int     `json:"maxLength,omitempty"`
+	Min       *float64 `json:"min,omitempty"`
+	Max       *float64 `json:"max,omitempty"`
+	Pattern   string   `json:"pattern,omitempty"`
+}
+
+// ConstraintOption configures the arguments of the @constraint directive.
+type ConstraintOption func(*ConstraintArgs)
+
 // Name implements ent.Annotation interface.
 func (Annotation) Name() string {
 	return "EntGQL"
@@ -65,6 +99,126 @@ func Skip() Annotation {
 	return Annotation{Skip: true}
 }
 
+// QueryField returns a root query field annotation. The field is added
+// to the Query type, and its resolver is generated in the QueryResolver type.
+func QueryField(name string) Annotation {
+	return Annotation{QueryField: name}
+}
+
+// Deprecated returns a deprecation annotation that marks the root query field of
+// the annotated type with @deprecated. An empty reason defaults to DefaultDeprecationReason.
+func Deprecated(reason string) Annotation {
+	if reason == "" {
+		reason = DefaultDeprecationReason
+	}
+	return Annotation{Deprecated: reason}
+}
+
+// DefaultDeprecationReason is the default reason of the @deprecated directive.
+const DefaultDeprecationReason = "No longer supported"
+
+// Relay mutation operations supported by the RelayMutations annotation.
+const (
+	RelayCreate = "create"
+	RelayUpdate = "update"
+)
+
+// RelayMutations returns a Relay mutations annotation. For each operation, the
+// extension generates a <Op><T>Payload type that holds the <T>Edge of the mutated node.
+func RelayMutations(ops ...string) Annotation {
+	return Annotation{RelayMutations: ops}
+}
+
+// MutationInputs returns a mutation inputs annotation. The extension generates the
+// Create<T>Input and Update<T>Input types, with a Mutate method for applying them.
+func MutationInputs() Annotation {
+	return Annotation{MutationInputs: true}
+}
+
+// SoftDelete returns a soft-delete annotation for an optional field. Nodes with a non-null
+// value in it are excluded from the generated pagination, Noder lookups and edge resolvers.
+func SoftDelete(opts ...SoftDeleteOption) Annotation {
+	a := Annotation{SoftDelete: true}
+	for _, opt := range opts {
+		opt(&a)
+	}
+	return a
+}
+
+// SoftDeleteOption allows configuring the SoftDelete annotation.
+type SoftDeleteOption func(*Annotation)
+
+// AllowIncludeDeleted adds an "includeDeleted" argument to the query field and the edge
+// resolvers of the soft-deleted type. The argument should be gated by IncludeDeleted.
+func AllowIncludeDeleted() SoftDeleteOption {
+	return func(a *Annotation) {
+		a.IncludeDeleted = true
+	}
+}
+
+// Scalar returns a scalar annotation that maps a field with a custom Go type
+// to the given GraphQL scalar, and generates its marshalers (see ScalarTemplate).
+func Scalar(name string) Annotation {
+	return Annotation{Scalar: name}
+}
+
+// Constraint returns a constraint annotation. Its arguments override the
+// arguments of the @constraint directive that are derived from the field validators.
+func Constraint(opts ...ConstraintOption) Annotation {
+	args := &ConstraintArgs{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	return Annotation{Constraint: args}
+}
+
+// MinLen sets the minLength argument of the @constraint directive.
+func MinLen(n int) ConstraintOption {
+	return func(args *ConstraintArgs) {
+		args.MinLength = &n
+	}
+}
+
+// MaxLen sets the maxLength argument of the @constraint directive.
+func MaxLen(n int) ConstraintOption {
+	return func(args *ConstraintArgs) {
+		args.MaxLength = &n
+	}
+}
+
+// NotEmpty sets the minLength argument of the @constraint directive to 1.
+func NotEmpty() ConstraintOption {
+	return MinLen(1)
+}
+
+// Min sets the min argument of the @constraint directive.
+func Min(v float64) ConstraintOption {
+	return func(args *ConstraintArgs) {
+		args.Min = &v
+	}
+}
+
+// Max sets the max argument of the @constraint directive.
+func Max(v float64) ConstraintOption {
+	return func(args *ConstraintArgs) {
+		args.Max = &v
+	}
+}
+
+// Range sets the min and max arguments of the @constraint directive.
+func Range(min, max float64) ConstraintOption {
+	return func(args *ConstraintArgs) {
+		args.Min, args.Max = &min, &max
+	}
+}
+
+// Match sets the pattern argument of the @constraint directive.
+func Match(pattern string) ConstraintOption {
+	return func(args *ConstraintArgs) {
+		args.Pattern = pattern
+	}
+}
+
 // Merge implements the schema.Merger interface.
 func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	var ant Annotation
@@ -93,9 +247,57 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	if ant.Skip {
 		a.Skip = true
 	}
+	if ant.QueryField != "" {
+		a.QueryField = ant.QueryField
+	}
+	if len(ant.RelayMutations) != 0 {
+		a.RelayMutations = ant.RelayMutations
+	}
+	if ant.MutationInputs {
+		a.MutationInputs = true
+	}
+	if ant.SoftDelete {
+		a.SoftDelete = true
+	}
+	if ant.IncludeDeleted {
+		a.IncludeDeleted = true
+	}
+	if ant.Scalar != "" {
+		a.Scalar = ant.Scalar
+	}
+	if ant.Constraint != nil {
+		a.Constraint = a.Constraint.merge(ant.Constraint)
+	}
+	if ant.Deprecated != "" {
+		a.Deprecated = ant.Deprecated
+	}
 	return a
 }
 
+// merge returns the arguments of c overridden by the arguments set in other.
+func (c *ConstraintArgs) merge(other *ConstraintArgs) *ConstraintArgs {
+	var args ConstraintArgs
+	if c != nil {
+		args = *c
+	}
+	if other.MinLength != nil {
+		args.MinLength = other.MinLength
+	}
+	if other.MaxLength != nil {
+		args.MaxLength = other.MaxLength
+	}
+	if other.Min != nil {
+		args.Min = other.Min
+	}
+	if other.Max != nil {
+		args.Max = other.Max
+	}
+	if other.Pattern != "" {
+		args.Pattern = other.Pattern
+	}
+	return &args
+}
+
 // Decode unmarshal annotation
 func (a *Annotation) Decode(annotation interface{}) error {
 	buf, err := json.Marshal(annotation)
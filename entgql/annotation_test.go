@@ -36,6 +36,20 @@ func TestAnnotation(t *testing.T) {
 	require.ElementsMatch(t, names, annotation.Mapping)
 }
 
+func TestConstraintAnnotation(t *testing.T) {
+	t.Parallel()
+	annotation := entgql.Constraint(entgql.NotEmpty(), entgql.Max(10))
+	require.Equal(t, 1, *annotation.Constraint.MinLength)
+	require.Equal(t, float64(10), *annotation.Constraint.Max)
+	require.Nil(t, annotation.Constraint.MaxLength)
+
+	merged := annotation.Merge(entgql.Constraint(entgql.MaxLen(5), entgql.Max(20))).(entgql.Annotation)
+	require.Equal(t, 1, *merged.Constraint.MinLength)
+	require.Equal(t, 5, *merged.Constraint.MaxLength)
+	require.Equal(t, float64(20), *merged.Constraint.Max)
+	require.Equal(t, float64(10), *annotation.Constraint.Max)
+}
+
 func TestAnnotationDecode(t *testing.T) {
 	ann := &entgql.Annotation{}
 	err := ann.Decode(map[string]interface{}{})
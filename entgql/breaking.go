@@ -0,0 +1,331 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/printer"
+)
+
+// WithAllowedBreakingChanges allows breaking changes in the generated GraphQL
+// schema. By default, the extension compares the generated definitions with the
+// previous ones before writing them, and fails the generation if there are changes
+// that may break existing clients, such as removed fields, removed arguments or
+// narrowed input types.
+//
+// Removing fields that were marked with the @deprecated directive in the previous
+// schema is not considered breaking. The extension emits the directive on
+// the root query fields of types annotated with entgql.Deprecated, which allows removing
+// their entgql.QueryField annotation after a transition period. Note that GraphQL does
+// not allow deprecating input fields, and therefore, removing an ent field (or one of its
+// predicates) from the generated input types must be allowed explicitly. In schema
+// file mode (WithSchemaPath), definitions that are no longer generated are kept in
+// the file, and therefore, they are not reported as removed.
+//
+// The changes to allow are given as schema coordinates, for example:
+//
+//	entgql.WithAllowedBreakingChanges(
+//		"TodoWhereInput.textNEQ",
+//		"Query.todos(where:)",
+//	)
+//
+// If no coordinates are given, all breaking changes are allowed.
+func WithAllowedBreakingChanges(coordinates ...string) ExtensionOption {
+	return func(ex *Extension) error {
+		if len(coordinates) == 0 {
+			ex.allowBreaking = true
+			return nil
+		}
+		if ex.allowedChanges == nil {
+			ex.allowedChanges = make(map[string]bool, len(coordinates))
+		}
+		for _, c := range coordinates {
+			ex.allowedChanges[c] = true
+		}
+		return nil
+	}
+}
+
+// schemaChange describes a change in a definition of the generated schema.
+type schemaChange struct {
+	coordinate string
+	message    string
+	breaking   bool
+}
+
+func (c *schemaChange) String() string {
+	return fmt.Sprintf("%s: %s", c.coordinate, c.message)
+}
+
+// checkChanges returns an error if the given definitions contain breaking
+// changes compared to the previous ones, that were not explicitly allowed.
+func (e *Extension) checkChanges(prev, next map[string]ast.Node) error {
+	if e.allowBreaking {
+		return nil
+	}
+	var breaking []string
+	for _, c := range diffDefinitions(prev, next) {
+		if c.breaking && !e.allowedChanges[c.coordinate] {
+			breaking = append(breaking, c.String())
+		}
+	}
+	if len(breaking) == 0 {
+		return nil
+	}
+	return fmt.Errorf("entgql: breaking changes in the generated GraphQL schema (use entgql.WithAllowedBreakingChanges to allow them):\n\t%s",
+		strings.Join(breaking, "\n\t"),
+	)
+}
+
+// definitionKey returns the key of a definition in the generated definitions map,
+// and reports if the definition is supported by the schema comparison.
+func definitionKey(node ast.Node) (string, bool) {
+	switch n := node.(type) {
+	case *ast.ObjectDefinition:
+		return n.Name.Value, true
+	case *ast.InputObjectDefinition:
+		return n.Name.Value, true
+	case *ast.ScalarDefinition:
+		return n.Name.Value, true
+	case *ast.DirectiveDefinition:
+		return "@" + n.Name.Value, true
+	case *ast.TypeExtensionDefinition:
+		if n.Definition != nil {
+			return n.Definition.Name.Value, true
+		}
+	}
+	return "", false
+}
+
+// diffDefinitions returns the changes between the previous and the next definitions,
+// sorted by their coordinates and messages. Definitions that exist only in the previous map are
+// considered removed.
+func diffDefinitions(prev, next map[string]ast.Node) []*schemaChange {
+	var changes []*schemaChange
+	for name, p := range prev {
+		n, ok := next[name]
+		if !ok {
+			changes = append(changes, &schemaChange{coordinate: name, message: "definition was removed", breaking: true})
+			continue
+		}
+		if ext, ok := p.(*ast.TypeExtensionDefinition); ok {
+			p = ext.Definition
+		}
+		if ext, ok := n.(*ast.TypeExtensionDefinition); ok {
+			n = ext.Definition
+		}
+		switch p := p.(type) {
+		case *ast.ObjectDefinition:
+			if n, ok := n.(*ast.ObjectDefinition); ok {
+				changes = append(changes, diffFields(name, p.Fields, n.Fields)...)
+				continue
+			}
+		case *ast.InputObjectDefinition:
+			if n, ok := n.(*ast.InputObjectDefinition); ok {
+				changes = append(changes, diffInputValues(name, "", p.Fields, n.Fields)...)
+				continue
+			}
+		case *ast.DirectiveDefinition:
+			if n, ok := n.(*ast.DirectiveDefinition); ok {
+				changes = append(changes, diffDirective(name, p, n)...)
+				continue
+			}
+		}
+		if p.GetKind() != n.GetKind() {
+			changes = append(changes, &schemaChange{
+				coordinate: name,
+				message:    fmt.Sprintf("definition kind changed from %s to %s", p.GetKind(), n.GetKind()),
+				breaking:   true,
+			})
+		}
+	}
+	for name := range next {
+		if _, ok := prev[name]; !ok {
+			changes = append(changes, &schemaChange{coordinate: name, message: "definition was added"})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].coordinate != changes[j].coordinate {
+			return changes[i].coordinate < changes[j].coordinate
+		}
+		return changes[i].message < changes[j].message
+	})
+	return changes
+}
+
+// diffFields returns the changes between the fields of two output types.
+func diffFields(typ string, prev, next []*ast.FieldDefinition) []*schemaChange {
+	var (
+		changes []*schemaChange
+		fields  = make(map[string]*ast.FieldDefinition, len(next))
+	)
+	for _, f := range next {
+		fields[f.Name.Value] = f
+	}
+	for _, p := range prev {
+		coord := typ + "." + p.Name.Value
+		n, ok := fields[p.Name.Value]
+		if !ok {
+			changes = append(changes, removed(coord, "field", p.Directives))
+			continue
+		}
+		delete(fields, p.Name.Value)
+		if !safeOutputType(p.Type, n.Type) {
+			changes = append(changes, typeChanged(coord, p.Type, n.Type, true))
+		} else if typeString(p.Type) != typeString(n.Type) {
+			changes = append(changes, typeChanged(coord, p.Type, n.Type, false))
+		}
+		changes = append(changes, diffInputValues(coord, "(%s:)", p.Arguments, n.Arguments)...)
+	}
+	for name := range fields {
+		changes = append(changes, &schemaChange{coordinate: typ + "." + name, message: "field was added"})
+	}
+	return changes
+}
+
+// diffInputValues returns the changes between two lists of input fields or arguments.
+// The format is used for formatting the coordinates of arguments (e.g. "(%s:)").
+func diffInputValues(parent, format string, prev, next []*ast.InputValueDefinition) []*schemaChange {
+	var (
+		changes []*schemaChange
+		values  = make(map[string]*ast.InputValueDefinition, len(next))
+	)
+	kind, coordinate := "input field", func(name string) string {
+		return parent + "." + name
+	}
+	if format != "" {
+		kind, coordinate = "argument", func(name string) string {
+			return parent + fmt.Sprintf(format, name)
+		}
+	}
+	for _, v := range next {
+		values[v.Name.Value] = v
+	}
+	for _, p := range prev {
+		coord := coordinate(p.Name.Value)
+		n, ok := values[p.Name.Value]
+		if !ok {
+			changes = append(changes, removed(coord, kind, p.Directives))
+			continue
+		}
+		delete(values, p.Name.Value)
+		if !safeInputType(p.Type, n.Type) {
+			changes = append(changes, typeChanged(coord, p.Type, n.Type, true))
+		} else if typeString(p.Type) != typeString(n.Type) {
+			changes = append(changes, typeChanged(coord, p.Type, n.Type, false))
+		}
+	}
+	for name, v := range values {
+		_, required := v.Type.(*ast.NonNull)
+		c := &schemaChange{coordinate: coordinate(name), message: kind + " was added"}
+		if required && v.DefaultValue == nil {
+			c.message, c.breaking = "required "+kind+" was added", true
+		}
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+// diffDirective returns the changes between two definitions of the same directive.
+func diffDirective(name string, prev, next *ast.DirectiveDefinition) []*schemaChange {
+	changes := diffInputValues(name, "(%s:)", prev.Arguments, next.Arguments)
+	locations := make(map[string]bool, len(next.Locations))
+	for _, l := range next.Locations {
+		locations[l.Value] = true
+	}
+	for _, l := range prev.Locations {
+		if !locations[l.Value] {
+			changes = append(changes, &schemaChange{
+				coordinate: name,
+				message:    fmt.Sprintf("location %s was removed", l.Value),
+				breaking:   true,
+			})
+		}
+	}
+	return changes
+}
+
+// removed returns the change of a removed schema element. The change is
+// breaking, unless the element was deprecated in the previous schema.
+func removed(coord, kind string, directives []*ast.Directive) *schemaChange {
+	for _, d := range directives {
+		if d.Name != nil && d.Name.Value == "deprecated" {
+			return &schemaChange{coordinate: coord, message: fmt.Sprintf("deprecated %s was removed", kind)}
+		}
+	}
+	return &schemaChange{coordinate: coord, message: fmt.Sprintf("%s was removed", kind), breaking: true}
+}
+
+func typeChanged(coord string, prev, next ast.Type, breaking bool) *schemaChange {
+	return &schemaChange{
+		coordinate: coord,
+		message:    fmt.Sprintf("type changed from %s to %s", typeString(prev), typeString(next)),
+		breaking:   breaking,
+	}
+}
+
+// safeOutputType reports if changing an output type from prev to next is safe for
+// clients. For example, a nullable type can be changed to non-null, but not vice versa.
+func safeOutputType(prev, next ast.Type) bool {
+	switch p := prev.(type) {
+	case *ast.NonNull:
+		n, ok := next.(*ast.NonNull)
+		return ok && safeOutputType(p.Type, n.Type)
+	case *ast.List:
+		switch n := next.(type) {
+		case *ast.List:
+			return safeOutputType(p.Type, n.Type)
+		case *ast.NonNull:
+			return safeOutputType(prev, n.Type)
+		}
+	case *ast.Named:
+		switch n := next.(type) {
+		case *ast.Named:
+			return p.Name.Value == n.Name.Value
+		case *ast.NonNull:
+			return safeOutputType(prev, n.Type)
+		}
+	}
+	return false
+}
+
+// safeInputType reports if changing an input type from prev to next is safe for
+// clients. For example, a non-null type can be changed to nullable, but not vice
+// versa (i.e. type narrowing).
+func safeInputType(prev, next ast.Type) bool {
+	switch p := prev.(type) {
+	case *ast.NonNull:
+		if n, ok := next.(*ast.NonNull); ok {
+			return safeInputType(p.Type, n.Type)
+		}
+		return safeInputType(p.Type, next)
+	case *ast.List:
+		n, ok := next.(*ast.List)
+		return ok && safeInputType(p.Type, n.Type)
+	case *ast.Named:
+		n, ok := next.(*ast.Named)
+		return ok && p.Name.Value == n.Name.Value
+	}
+	return false
+}
+
+func typeString(t ast.Type) string {
+	s, _ := printer.Print(t).(string)
+	return s
+}
@@ -0,0 +1,162 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffDefinitions(t *testing.T) {
+	prev := parseDefs(t, `
+directive @constraint(minLength: Int, maxLength: Int) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+input TodoWhereInput { id: ID, text: String, textIn: [String!], status: Status! }
+input GroupWhereInput { id: ID }
+extend type Query {
+  todos(first: Int, where: TodoWhereInput): TodoConnection
+  count: Int!
+  names: [String]
+  old: String @deprecated(reason: "unused")
+}
+`)
+	next := parseDefs(t, `
+directive @constraint(minLength: Int) on INPUT_FIELD_DEFINITION
+input TodoWhereInput { id: ID!, textIn: [String], status: Status, priority: Int, owner: ID! }
+extend type Query {
+  todos(first: Int, after: Cursor, where: TodoWhereInput, orderBy: TodoOrder!): TodoConnection
+  count: Int
+  names: [String!]!
+  users: [User]
+}
+type User { id: ID! }
+`)
+	var changes, breaking []string
+	for _, c := range diffDefinitions(prev, next) {
+		changes = append(changes, c.String())
+		if c.breaking {
+			breaking = append(breaking, c.coordinate)
+		}
+	}
+	require.Equal(t, []string{
+		"@constraint: location ARGUMENT_DEFINITION was removed",
+		"@constraint(maxLength:): argument was removed",
+		"GroupWhereInput: definition was removed",
+		"Query.count: type changed from Int! to Int",
+		"Query.names: type changed from [String] to [String!]!",
+		"Query.old: deprecated field was removed",
+		"Query.todos(after:): argument was added",
+		"Query.todos(orderBy:): required argument was added",
+		"Query.users: field was added",
+		"TodoWhereInput.id: type changed from ID to ID!",
+		"TodoWhereInput.owner: required input field was added",
+		"TodoWhereInput.priority: input field was added",
+		"TodoWhereInput.status: type changed from Status! to Status",
+		"TodoWhereInput.text: input field was removed",
+		"TodoWhereInput.textIn: type changed from [String!] to [String]",
+		"User: definition was added",
+	}, changes)
+	require.ElementsMatch(t, []string{
+		"@constraint",
+		"@constraint(maxLength:)",
+		"GroupWhereInput",
+		"Query.count",
+		"Query.todos(orderBy:)",
+		"TodoWhereInput.id",
+		"TodoWhereInput.owner",
+		"TodoWhereInput.text",
+	}, breaking)
+}
+
+func TestCheckChanges(t *testing.T) {
+	prev := parseDefs(t, `input TodoWhereInput { id: ID, text: String }`)
+	next := parseDefs(t, `input TodoWhereInput { id: ID! }`)
+	ex, err := NewExtension()
+	require.NoError(t, err)
+	err = ex.checkChanges(prev, next)
+	require.EqualError(t, err, `entgql: breaking changes in the generated GraphQL schema (use entgql.WithAllowedBreakingChanges to allow them):
+	TodoWhereInput.id: type changed from ID to ID!
+	TodoWhereInput.text: input field was removed`)
+
+	ex, err = NewExtension(WithAllowedBreakingChanges("TodoWhereInput.text"))
+	require.NoError(t, err)
+	err = ex.checkChanges(prev, next)
+	require.EqualError(t, err, `entgql: breaking changes in the generated GraphQL schema (use entgql.WithAllowedBreakingChanges to allow them):
+	TodoWhereInput.id: type changed from ID to ID!`)
+
+	ex, err = NewExtension(WithAllowedBreakingChanges("TodoWhereInput.text", "TodoWhereInput.id"))
+	require.NoError(t, err)
+	require.NoError(t, ex.checkChanges(prev, next))
+	ex, err = NewExtension(WithAllowedBreakingChanges())
+	require.NoError(t, err)
+	require.NoError(t, ex.checkChanges(prev, next))
+}
+
+func parseDefs(t *testing.T, sdl string) map[string]ast.Node {
+	doc, err := parser.Parse(parser.ParseParams{Source: sdl})
+	require.NoError(t, err)
+	defs := make(map[string]ast.Node)
+	for _, def := range doc.Definitions {
+		name, ok := definitionKey(def)
+		require.True(t, ok)
+		defs[name] = def
+	}
+	return defs
+}
+
+func TestPreviousDefsSchemaFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schema.graphql")
+	err := ioutil.WriteFile(path, []byte(`
+type Query {
+  node(id: ID!): Node
+  nodes(ids: [ID!]!): [Node]!
+}
+input TodoWhereInput { id: ID }
+`), 0644)
+	require.NoError(t, err)
+	ex, err := NewExtension(WithSchemaPath(path))
+	require.NoError(t, err)
+	next := parseDefs(t, `
+extend type Query { todos: [Todo!]! }
+input TodoWhereInput { id: ID }
+`)
+	prev, err := ex.previousDefs(next)
+	require.NoError(t, err)
+	require.NotContains(t, prev, "Query", "user-defined Query type is not generated")
+	require.NoError(t, ex.checkChanges(prev, next))
+
+	// The generated extension is compared, and not the Query type next to it.
+	err = ioutil.WriteFile(path, []byte(`
+type Query {
+  node(id: ID!): Node
+  nodes(ids: [ID!]!): [Node]!
+}
+extend type Query { todos: [Todo!]! }
+`), 0644)
+	require.NoError(t, err)
+	ex, err = NewExtension(WithSchemaPath(path))
+	require.NoError(t, err)
+	prev, err = ex.previousDefs(next)
+	require.NoError(t, err)
+	require.IsType(t, &ast.TypeExtensionDefinition{}, prev["Query"])
+	require.NoError(t, ex.checkChanges(prev, next))
+	next = parseDefs(t, `extend type Query { users: [User!]! }`)
+	require.EqualError(t, ex.checkChanges(prev, next), `entgql: breaking changes in the generated GraphQL schema (use entgql.WithAllowedBreakingChanges to allow them):
+	Query.todos: field was removed`)
+}
@@ -0,0 +1,36 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"log"
+
+	"entgo.io/contrib/entgql/sdl"
+)
+
+func main() {
+	var (
+		sdlPath    = flag.String("schema", "", "path to the graphql schema file")
+		schemaPath = flag.String("path", "", "path to schema directory")
+	)
+	flag.Parse()
+	if *sdlPath == "" || *schemaPath == "" {
+		log.Fatal("entgql: must specify graphql schema and schema path. use entgql -schema ./schema.graphql -path ./ent/schema")
+	}
+	if err := sdl.GenerateSchema(*sdlPath, *schemaPath); err != nil {
+		log.Fatalf("entgql: failed generating ent schema: %v", err)
+	}
+}
@@ -0,0 +1,357 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// fieldPkgPath is the import path of the ent field builders.
+const fieldPkgPath = "entgo.io/ent/schema/field"
+
+// schemaConstraints holds the constraints that were derived from the
+// validators of the schema fields, keyed by the schema (or mixin) type
+// name and the field name, and the mixin type names of each schema.
+type schemaConstraints struct {
+	fields map[string]map[string]*ConstraintArgs
+	mixins map[string][]string
+}
+
+// fieldConstraints returns the @constraint arguments that are derived from the
+// builtin validators of the given nodes (e.g. MaxLen or Range). The validators are
+// read from the source of the schema package, and only constant arguments are
+// derived. Fields that are defined in mixins of other packages are skipped.
+func fieldConstraints(g *gen.Graph, nodes []*gen.Type) (map[*gen.Field]*ConstraintArgs, error) {
+	if g.Config == nil || g.Config.Schema == "" {
+		return nil, nil
+	}
+	pkg, err := build.Import(g.Config.Schema, ".", build.FindOnly)
+	if err != nil {
+		return nil, fmt.Errorf("entgql: find schema package %q: %w", g.Config.Schema, err)
+	}
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, pkg.Dir, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("entgql: parse schema package %q: %w", g.Config.Schema, err)
+	}
+	var files []*ast.File
+	for name, p := range pkgs {
+		// Skip the external test package, if exists.
+		if strings.HasSuffix(name, "_test") {
+			continue
+		}
+		for _, f := range p.Files {
+			files = append(files, f)
+		}
+	}
+	sc := parseConstraints(files)
+	constraints := make(map[*gen.Field]*ConstraintArgs)
+	for _, n := range nodes {
+		for _, f := range n.Fields {
+			if !hasValidators(f) {
+				continue
+			}
+			typ := n.Name
+			if p := f.Position; p != nil && p.MixedIn {
+				if p.MixinIndex >= len(sc.mixins[n.Name]) {
+					continue
+				}
+				typ = sc.mixins[n.Name][p.MixinIndex]
+			}
+			if args := sc.fields[typ][f.Name]; args != nil {
+				constraints[f] = args
+			}
+		}
+	}
+	return constraints, nil
+}
+
+// parseConstraints derives the constraints of the fields that are declared in
+// the Fields methods of the given files, and collects the local mixins of the
+// schemas from their Mixin methods.
+func parseConstraints(files []*ast.File) *schemaConstraints {
+	sc := &schemaConstraints{
+		fields: make(map[string]map[string]*ConstraintArgs),
+		mixins: make(map[string][]string),
+	}
+	for _, file := range files {
+		fieldPkg := importName(file, fieldPkgPath)
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 || fn.Body == nil {
+				continue
+			}
+			typ := recvName(fn.Recv.List[0].Type)
+			switch fn.Name.Name {
+			case "Fields":
+				if fieldPkg == "" {
+					continue
+				}
+				ast.Inspect(fn.Body, func(n ast.Node) bool {
+					lit, ok := n.(*ast.CompositeLit)
+					if !ok || !isSliceOf(lit.Type, "Field") {
+						return true
+					}
+					for _, elt := range lit.Elts {
+						name, args := deriveConstraint(fieldPkg, elt)
+						if args == nil {
+							continue
+						}
+						if sc.fields[typ] == nil {
+							sc.fields[typ] = make(map[string]*ConstraintArgs)
+						}
+						sc.fields[typ][name] = args
+					}
+					return false
+				})
+			case "Mixin":
+				ast.Inspect(fn.Body, func(n ast.Node) bool {
+					lit, ok := n.(*ast.CompositeLit)
+					if !ok || !isSliceOf(lit.Type, "Mixin") {
+						return true
+					}
+					mixins := make([]string, len(lit.Elts))
+					for i, elt := range lit.Elts {
+						if m, ok := elt.(*ast.CompositeLit); ok {
+							// Mixins of other packages are selector expressions.
+							if id, ok := m.Type.(*ast.Ident); ok {
+								mixins[i] = id.Name
+							}
+						}
+					}
+					sc.mixins[typ] = mixins
+					return false
+				})
+			}
+		}
+	}
+	return sc
+}
+
+// deriveConstraint returns the field name and the constraint arguments
+// of the given field builder expression (e.g. field.String("name").MaxLen(10)),
+// or nil if no arguments can be derived from its validators.
+func deriveConstraint(fieldPkg string, expr ast.Expr) (string, *ConstraintArgs) {
+	var (
+		ctor  string
+		name  string
+		calls []*ast.CallExpr
+	)
+	for expr != nil {
+		call, ok := expr.(*ast.CallExpr)
+		if !ok {
+			return "", nil
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return "", nil
+		}
+		if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == fieldPkg {
+			if len(call.Args) == 0 {
+				return "", nil
+			}
+			s, ok := stringConst(call.Args[0])
+			if !ok {
+				return "", nil
+			}
+			ctor, name = sel.Sel.Name, s
+			break
+		}
+		calls = append(calls, call)
+		expr = sel.X
+	}
+	if ctor == "" {
+		return "", nil
+	}
+	var (
+		args  ConstraintArgs
+		float = ctor == "Float" || ctor == "Float32"
+		set   bool
+	)
+	// Calls are collected from the last to the first, and
+	// applied in their order in the builder chain.
+	for i := len(calls) - 1; i >= 0; i-- {
+		call := calls[i]
+		switch method := call.Fun.(*ast.SelectorExpr).Sel.Name; {
+		case method == "MinLen" && len(call.Args) == 1:
+			if n, ok := intConst(call.Args[0]); ok {
+				MinLen(n)(&args)
+				set = true
+			}
+		case method == "MaxLen" && len(call.Args) == 1:
+			if n, ok := intConst(call.Args[0]); ok {
+				MaxLen(n)(&args)
+				set = true
+			}
+		case method == "NotEmpty" && len(call.Args) == 0:
+			if args.MinLength == nil || *args.MinLength < 1 {
+				NotEmpty()(&args)
+				set = true
+			}
+		case method == "Match" && len(call.Args) == 1:
+			if p, ok := regexpConst(call.Args[0]); ok {
+				Match(p)(&args)
+				set = true
+			}
+		case method == "Min" && len(call.Args) == 1:
+			if v, ok := floatConst(call.Args[0]); ok {
+				Min(v)(&args)
+				set = true
+			}
+		case method == "Max" && len(call.Args) == 1:
+			if v, ok := floatConst(call.Args[0]); ok {
+				Max(v)(&args)
+				set = true
+			}
+		case method == "Range" && len(call.Args) == 2:
+			min, ok1 := floatConst(call.Args[0])
+			max, ok2 := floatConst(call.Args[1])
+			if ok1 && ok2 {
+				Range(min, max)(&args)
+				set = true
+			}
+		case method == "NonNegative" && len(call.Args) == 0:
+			Min(0)(&args)
+			set = true
+		// Float bounds of Positive and Negative are exclusive,
+		// and cannot be expressed by the directive arguments.
+		case method == "Positive" && len(call.Args) == 0 && !float:
+			Min(1)(&args)
+			set = true
+		case method == "Negative" && len(call.Args) == 0 && !float:
+			Max(-1)(&args)
+			set = true
+		}
+	}
+	if !set {
+		return "", nil
+	}
+	return name, &args
+}
+
+// importName returns the name of the given import path in the file, or
+// an empty string if the file does not import it.
+func importName(file *ast.File, path string) string {
+	for _, spec := range file.Imports {
+		if p, err := strconv.Unquote(spec.Path.Value); err != nil || p != path {
+			continue
+		}
+		if spec.Name != nil {
+			return spec.Name.Name
+		}
+		return "field"
+	}
+	return ""
+}
+
+// recvName returns the type name of a method receiver.
+func recvName(expr ast.Expr) string {
+	switch x := expr.(type) {
+	case *ast.StarExpr:
+		return recvName(x.X)
+	case *ast.Ident:
+		return x.Name
+	}
+	return ""
+}
+
+// isSliceOf reports if the given type expression is a slice of the
+// given type name (e.g. []ent.Field).
+func isSliceOf(expr ast.Expr, name string) bool {
+	arr, ok := expr.(*ast.ArrayType)
+	if !ok || arr.Len != nil {
+		return false
+	}
+	switch x := arr.Elt.(type) {
+	case *ast.SelectorExpr:
+		return x.Sel.Name == name
+	case *ast.Ident:
+		return x.Name == name
+	}
+	return false
+}
+
+// regexpConst returns the pattern of a regexp.MustCompile call with a constant argument.
+func regexpConst(expr ast.Expr) (string, bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "MustCompile" {
+		return "", false
+	}
+	if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "regexp" {
+		return "", false
+	}
+	return stringConst(call.Args[0])
+}
+
+func stringConst(expr ast.Expr) (string, bool) {
+	v := constValue(expr)
+	if v == nil || v.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(v), true
+}
+
+func intConst(expr ast.Expr) (int, bool) {
+	v := constValue(expr)
+	if v == nil || v.Kind() != constant.Int {
+		return 0, false
+	}
+	n, ok := constant.Int64Val(v)
+	return int(n), ok
+}
+
+func floatConst(expr ast.Expr) (float64, bool) {
+	v := constValue(expr)
+	if v == nil || (v.Kind() != constant.Int && v.Kind() != constant.Float) {
+		return 0, false
+	}
+	f, _ := constant.Float64Val(v)
+	return f, true
+}
+
+// constValue evaluates the given expression if it is a literal constant
+// (e.g. 10, -1.5 or "text"), or returns nil otherwise.
+func constValue(expr ast.Expr) constant.Value {
+	switch x := expr.(type) {
+	case *ast.BasicLit:
+		v := constant.MakeFromLiteral(x.Value, x.Kind, 0)
+		if v.Kind() == constant.Unknown {
+			return nil
+		}
+		return v
+	case *ast.ParenExpr:
+		return constValue(x.X)
+	case *ast.UnaryExpr:
+		v := constValue(x.X)
+		if v == nil || v.Kind() == constant.String || (x.Op != token.SUB && x.Op != token.ADD) {
+			return nil
+		}
+		return constant.UnaryOp(x.Op, v, 0)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const constraintSchema = `package schema
+
+import (
+	"regexp"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+type Named struct{ mixin.Schema }
+
+func (Named) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").MaxLen(64),
+	}
+}
+
+type User struct{ ent.Schema }
+
+func (User) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+		Named{},
+	}
+}
+
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("nickname").
+			NotEmpty().
+			MaxLen(20).
+			Match(regexp.MustCompile("^[a-z]+$")),
+		field.Int("age").Range(0, 150),
+		field.Int("level").Positive(),
+		field.Float("rate").Positive(),
+		field.Float("score").NonNegative().Max(-(1.5)),
+		field.Int("size").Max(maxSize),
+		field.String("bio").Optional(),
+	}
+}
+`
+
+func TestParseConstraints(t *testing.T) {
+	f, err := parser.ParseFile(token.NewFileSet(), "schema.go", constraintSchema, 0)
+	require.NoError(t, err)
+	sc := parseConstraints([]*ast.File{f})
+	require.Equal(t, []string{"", "Named"}, sc.mixins["User"])
+	require.Equal(t, map[string]*ConstraintArgs{
+		"name": Constraint(MaxLen(64)).Constraint,
+	}, sc.fields["Named"])
+	require.Equal(t, map[string]*ConstraintArgs{
+		"nickname": Constraint(NotEmpty(), MaxLen(20), Match("^[a-z]+$")).Constraint,
+		"age":      Constraint(Range(0, 150)).Constraint,
+		"level":    Constraint(Min(1)).Constraint,
+		"score":    Constraint(Min(0), Max(-1.5)).Constraint,
+	}, sc.fields["User"], "non-constant arguments and exclusive float bounds are not derived")
+}
@@ -0,0 +1,127 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package entgql provides an ent extension for generating GraphQL schemas and
+// gqlgen resolvers, and runtime helpers (e.g. pagination, transactions and
+// validation) for GraphQL servers that are backed by ent.
+//
+// Root query fields
+//
+// Types annotated with entgql.QueryField get a field in the Query type of the GraphQL
+// schema, and a resolver in the generated QueryResolver type:
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.QueryField("todos"),
+//		}
+//	}
+//
+// Query fields can be deprecated with the entgql.Deprecated annotation, and removed
+// later without failing the breaking-change detection (see WithAllowedBreakingChanges):
+//
+//	entgql.QueryField("todos"),
+//	entgql.Deprecated("Use `tasks` instead."),
+//
+// Mutation inputs
+//
+// Types annotated with entgql.MutationInputs get the Create<T>Input and Update<T>Input
+// types, with a Mutate method for applying them on the <T>Mutation. Edges are set using
+// their IDs (e.g. parentID, addChildIDs, removeChildIDs and clearChildren), or created using
+// nested inputs if the edge type is also annotated (e.g. createChildren):
+//
+//	func (r *mutationResolver) UpdateTodo(ctx context.Context, id int, input ent.UpdateTodoInput) (*ent.Todo, error) {
+//		u := ent.FromContext(ctx).Todo.UpdateOneID(id)
+//		if err := input.Mutate(ctx, u.Mutation()); err != nil {
+//			return nil, err
+//		}
+//		return u.Save(ctx)
+//	}
+//
+// Relay mutations
+//
+// Types annotated with entgql.RelayMutations get a <Op><T>Payload type for each operation,
+// holding the clientMutationId of the mutation input and the <T>Edge of the mutated node.
+// If the type is also annotated with entgql.MutationInputs, the clientMutationId is added
+// to the input of the operation, and its Payload method passes it through to the payload:
+//
+//	func (r *mutationResolver) CreateTodo(ctx context.Context, input ent.CreateTodoInput) (*ent.CreateTodoPayload, error) {
+//		c := ent.FromContext(ctx).Todo.Create()
+//		if err := input.Mutate(ctx, c.Mutation()); err != nil {
+//			return nil, err
+//		}
+//		t, err := c.Save(ctx)
+//		if err != nil {
+//			return nil, err
+//		}
+//		return input.Payload(t, nil), nil
+//	}
+//
+// Soft delete
+//
+// Fields annotated with entgql.SoftDelete mark the soft-deleted nodes of their type. These
+// nodes are excluded from the generated pagination, Noder lookups and edge resolvers, unless
+// the annotation is configured with the AllowIncludeDeleted option:
+//
+//	field.Time("deleted_at").
+//		Optional().
+//		Annotations(
+//			entgql.SoftDelete(entgql.AllowIncludeDeleted()),
+//		)
+//
+// The option adds an "includeDeleted" argument to the query field of the type and to the
+// edge resolvers pointing to it. The edge fields of the GraphQL schema should declare the
+// argument as well, and since it exposes deleted nodes to clients, the server is expected to
+// gate it using the IncludeDeleted extension:
+//
+//	type Todo implements Node {
+//		children(includeDeleted: Boolean): [Todo!]
+//	}
+//
+//	srv.Use(entgql.IncludeDeleted{Authorize: authorize})
+//
+// Custom scalars
+//
+// Fields annotated with entgql.Scalar are mapped to a GraphQL scalar that is defined in the
+// generated schema, and the Marshal<Name> and Unmarshal<Name> functions are generated for it.
+// The Go type must implement the encoding.TextMarshaler and encoding.TextUnmarshaler interfaces
+// (e.g. net.IP), or the field.ValueScanner interface. Values are converted using the MarshalText
+// and UnmarshalText methods if they exist, and using the Value and Scan methods otherwise:
+//
+//	// MarshalText and UnmarshalText are implemented by *money.Amount.
+//	field.String("amount").
+//		GoType(money.Amount("")).
+//		Annotations(
+//			entgql.Scalar("Money"),
+//		)
+//
+// Fields with a custom Go type that implements the field.ValueScanner interface are mapped to
+// a scalar named after their Go type (e.g. Amount), unless they are mapped to a GraphQL type in
+// the gqlgen.yml, or by the entgql.Type annotation.
+//
+// Input constraints
+//
+// The WithConstraints option adds the @constraint directive to the generated input
+// fields, and generates the InputValidators that are enforced by the Validator extension.
+// The directive arguments are derived from the builtin validators of the schema fields,
+// and the entgql.Constraint annotation overrides them:
+//
+//	field.Text("text").
+//		NotEmpty().
+//		Annotations(
+//			entgql.Constraint(entgql.MaxLen(280)),
+//		)
+//
+//	srv.Use(&entgql.Validator{Funcs: ent.InputValidators})
+//
+package entgql
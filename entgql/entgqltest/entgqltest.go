@@ -0,0 +1,106 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package entgqltest provides utilities for end-to-end testing of GraphQL
+// servers that are backed by ent, using an in-memory SQLite database.
+//
+//	drv := entgqltest.Open(t)
+//	client := ent.NewClient(ent.Driver(drv))
+//	if err := client.Schema.Create(ctx, migrate.WithGlobalUniqueID(true)); err != nil {
+//		t.Fatal(err)
+//	}
+//	srv := entgqltest.NewServer(todo.NewSchema(client), client)
+//	fixture.New(client).Todo().SaveX(ctx)
+//
+//	n, err := srv.Queries(`query { todos { totalCount } }`, &rsp)
+//	srv.MatchSnapshot(t, "todos", `query { todos { totalCount } }`)
+//
+package entgqltest
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Open opens a driver of a new in-memory SQLite database, that is closed when
+// the test and all its subtests complete. The returned driver counts the queries
+// executed by operations of the Server, and should be passed to the ent client.
+func Open(t testing.TB) dialect.Driver {
+	t.Helper()
+	drv, err := sql.Open(dialect.SQLite, fmt.Sprintf("file:%s-%d?mode=memory&cache=shared&_fk=1", t.Name(), time.Now().UnixNano()))
+	if err != nil {
+		t.Fatalf("entgqltest: opening sqlite driver: %v", err)
+	}
+	t.Cleanup(func() { _ = drv.Close() })
+	return (&entgql.Tracing{Tracer: counter{}}).Driver(drv)
+}
+
+// Server is a GraphQL test client of a gqlgen handler that executes
+// its mutations under transactions.
+type Server struct {
+	*client.Client
+}
+
+// NewServer returns a new Server for the given executable schema. Mutations are
+// executed under transactions that are opened by the given TxOpener (usually the
+// ent client), and the given extensions are added to the handler.
+func NewServer(es graphql.ExecutableSchema, opener entgql.TxOpener, exts ...graphql.HandlerExtension) *Server {
+	srv := handler.NewDefaultServer(es)
+	srv.Use(entgql.Transactioner{TxOpener: opener})
+	for _, ext := range exts {
+		srv.Use(ext)
+	}
+	return &Server{Client: client.New(srv)}
+}
+
+// Queries sends the given operation and unpacks its response like Post, and returns
+// the number of SQL statements that were executed by the operation. It can be used for
+// catching N+1 regressions, and requires the ent client to use a driver created by Open.
+func (s *Server) Queries(query string, response interface{}, options ...client.Option) (int, error) {
+	var n int64
+	options = append(options, func(r *client.Request) {
+		r.HTTP = r.HTTP.WithContext(context.WithValue(r.HTTP.Context(), counterKey{}, &n))
+	})
+	err := s.Post(query, response, options...)
+	return int(atomic.LoadInt64(&n)), err
+}
+
+type counterKey struct{}
+
+// counter is an entgql.Tracer that counts the statements executed by the driver.
+type counter struct{}
+
+func (counter) StartSpan(ctx context.Context, name string) (context.Context, entgql.Span) {
+	if n, ok := ctx.Value(counterKey{}).(*int64); ok && (name == entgql.SpanQuery || name == entgql.SpanExec) {
+		atomic.AddInt64(n, 1)
+	}
+	return ctx, span{}
+}
+
+type span struct{}
+
+func (span) SetTag(string, interface{}) {}
+func (span) Finish(error)              {}
@@ -0,0 +1,78 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgqltest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+)
+
+var update = flag.Bool("entgqltest.update", false, "update the golden files of snapshot assertions")
+
+// MatchSnapshot sends the given operation, and compares its response (data and errors)
+// with the golden file of the given name, located in "testdata/<test name>/<name>.golden".
+// Run the tests with the -entgqltest.update flag to create or update the golden files.
+func (s *Server) MatchSnapshot(t testing.TB, name, query string, options ...client.Option) {
+	t.Helper()
+	rsp, err := s.RawPost(query, options...)
+	if err != nil {
+		t.Fatalf("entgqltest: sending operation: %v", err)
+	}
+	snapshot := struct {
+		Data   interface{}     `json:"data"`
+		Errors json.RawMessage `json:"errors,omitempty"`
+	}{
+		Data:   rsp.Data,
+		Errors: rsp.Errors,
+	}
+	MatchSnapshot(t, name, snapshot)
+}
+
+// MatchSnapshot compares the JSON encoding of v with the golden file of the given name,
+// located in "testdata/<test name>/<name>.golden". Run the tests with the -entgqltest.update
+// flag to create or update the golden files.
+func MatchSnapshot(t testing.TB, name string, v interface{}) {
+	t.Helper()
+	got, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		t.Fatalf("entgqltest: encoding snapshot: %v", err)
+	}
+	got = append(got, '\n')
+	path := filepath.Join("testdata", filepath.FromSlash(t.Name()), name+".golden")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("entgqltest: creating snapshot directory: %v", err)
+		}
+		if err := ioutil.WriteFile(path, got, 0644); err != nil {
+			t.Fatalf("entgqltest: writing snapshot: %v", err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("entgqltest: reading snapshot (run with -entgqltest.update to create it): %v", err)
+	}
+	if !bytes.Equal(want, got) {
+		t.Errorf("entgqltest: snapshot %s mismatch:\n--- want\n%s\n+++ got\n%s", path, strings.TrimSpace(string(want)), strings.TrimSpace(string(got)))
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgqltest_test
+
+import (
+	"testing"
+
+	"entgo.io/contrib/entgql/entgqltest"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestMatchSnapshot(t *testing.T) {
+	entgqltest.MatchSnapshot(t, "value", map[string]interface{}{
+		"name":  "a8m",
+		"count": 1,
+	})
+	srv := testserver.New()
+	srv.AddTransport(transport.POST{})
+	s := &entgqltest.Server{Client: client.New(srv)}
+	s.MatchSnapshot(t, "response", `query { name }`)
+}
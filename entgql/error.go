@@ -25,3 +25,24 @@ func ErrNodeNotFound(id interface{}) *gqlerror.Error {
 	errcode.Set(err, "NOT_FOUND")
 	return err
 }
+
+// ErrTooManyNodes creates a graphql error for requesting more nodes than the given limit.
+func ErrTooManyNodes(limit int) *gqlerror.Error {
+	err := gqlerror.Errorf("Cannot resolve more than %d nodes in a single request", limit)
+	errcode.Set(err, "TOO_MANY_NODES")
+	return err
+}
+
+// ErrForbidden creates a graphql error for a field that was denied by a privacy policy.
+func ErrForbidden(field string) *gqlerror.Error {
+	err := gqlerror.Errorf("Not allowed to access the '%s' field", field)
+	errcode.Set(err, "FORBIDDEN")
+	return err
+}
+
+// ErrOperationNotAllowed creates a graphql error for an operation that is not persisted.
+func ErrOperationNotAllowed(hash string) *gqlerror.Error {
+	err := gqlerror.Errorf("Operation with the hash of '%s' is not allowed", hash)
+	errcode.Set(err, "OPERATION_NOT_ALLOWED")
+	return err
+}
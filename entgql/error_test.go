@@ -27,3 +27,24 @@ func TestErrNodeNotFound(t *testing.T) {
 	require.EqualError(t, err, "input: Could not resolve to a node with the global id of '42'")
 	require.Equal(t, "NOT_FOUND", err.Extensions["code"])
 }
+
+func TestErrTooManyNodes(t *testing.T) {
+	t.Parallel()
+	err := entgql.ErrTooManyNodes(100)
+	require.EqualError(t, err, "input: Cannot resolve more than 100 nodes in a single request")
+	require.Equal(t, "TOO_MANY_NODES", err.Extensions["code"])
+}
+
+func TestErrForbidden(t *testing.T) {
+	t.Parallel()
+	err := entgql.ErrForbidden("secret")
+	require.EqualError(t, err, "input: Not allowed to access the 'secret' field")
+	require.Equal(t, "FORBIDDEN", err.Extensions["code"])
+}
+
+func TestErrOperationNotAllowed(t *testing.T) {
+	t.Parallel()
+	err := entgql.ErrOperationNotAllowed("abc")
+	require.EqualError(t, err, "input: Operation with the hash of 'abc' is not allowed")
+	require.Equal(t, "OPERATION_NOT_ALLOWED", err.Extensions["code"])
+}
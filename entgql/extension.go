@@ -15,10 +15,18 @@
 package entgql
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"go/importer"
+	"go/token"
+	"go/types"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"entgo.io/ent/entc"
@@ -32,18 +40,32 @@ import (
 	"github.com/graphql-go/graphql/language/printer"
 	"github.com/graphql-go/graphql/language/source"
 	"github.com/graphql-go/graphql/language/visitor"
+	"github.com/vektah/gqlparser/v2"
 )
 
 type (
 	// Extension implements the entc.Extension for providing GraphQL integration.
 	Extension struct {
 		entc.DefaultExtension
-		path       string
-		doc        *ast.Document
-		cfg        *config.Config
-		hooks      []gen.Hook
-		templates  []*gen.Template
-		scalarFunc func(*gen.Field, gen.Op) string
+		path    string
+		dir     string
+		doc     *ast.Document
+		cfg     *config.Config
+		cfgPath string
+		ispPath string
+		// allowBreaking and allowedChanges configure
+		// the breaking changes that are allowed.
+		allowBreaking  bool
+		allowedChanges map[string]bool
+		hooks          []gen.Hook
+		templates      []*gen.Template
+		scalarFunc     func(*gen.Field, gen.Op) string
+		// constraints indicates if the @constraint directive is generated, and
+		// fieldConstraints holds the arguments that were derived from the schema.
+		constraints      bool
+		fieldConstraints map[*gen.Field]*ConstraintArgs
+		// importer type-checks the packages of custom scalar types.
+		importer types.ImporterFrom
 	}
 
 	// ExtensionOption allows for managing the Extension configuration
@@ -76,7 +98,36 @@ func WithSchemaPath(path string) ExtensionOption {
 			return fmt.Errorf("parsing graphql schema %q: %w", path, err)
 		}
 		ex.path = path
-		ex.hooks = append(ex.hooks, ex.genWhereInputs())
+		return nil
+	}
+}
+
+// WithSchemaDir sets the directory to write the generated GraphQL definitions
+// to, instead of updating a single schema file. The definitions are written to
+// per-type files (e.g. todo.graphql for the TodoWhereInput), and generated files
+// that are no longer needed are removed. Files in the directory that were not
+// generated by the extension are left untouched. Please note, that your gqlgen.yml
+// config file should be updated as follows to load the generated files:
+//
+//	schema:
+//	 - schema.graphql // existing schema.
+//	 - ent/*.graphql  // generated schema files.
+//
+func WithSchemaDir(dir string) ExtensionOption {
+	return func(ex *Extension) error {
+		ex.dir = dir
+		return nil
+	}
+}
+
+// WithIntrospectionPath sets the filepath to write the introspection result
+// (e.g. schema.json) of the GraphQL schema, for client code generation tools.
+// The schema is loaded from the sources of the gqlgen.yml configuration file
+// after the generated definitions were written, and therefore, this option
+// requires the WithConfigPath option.
+func WithIntrospectionPath(path string) ExtensionOption {
+	return func(ex *Extension) error {
+		ex.ispPath = path
 		return nil
 	}
 }
@@ -91,29 +142,12 @@ const GQLConfigAnnotation = "GQLConfig"
 // Note that, enabling this option is recommended as it improves the
 // GraphQL integration,
 func WithConfigPath(path string) ExtensionOption {
-	return func(ex *Extension) (err error) {
-		cwd, err := os.Getwd()
-		if err != nil {
-			return fmt.Errorf("unable to get working directory: %w", err)
-		}
-		if err := os.Chdir(filepath.Dir(path)); err != nil {
-			return fmt.Errorf("unable to enter config dir: %w", err)
-		}
-		defer func() {
-			if cerr := os.Chdir(cwd); cerr != nil {
-				err = fmt.Errorf("unable to restore working directory: %w", cerr)
-			}
-		}()
-		cfg, err := config.LoadConfig(filepath.Base(path))
+	return func(ex *Extension) error {
+		cfg, err := loadConfig(path, true)
 		if err != nil {
 			return err
 		}
-		if cfg.Schema == nil {
-			if err := cfg.LoadSchema(); err != nil {
-				return err
-			}
-		}
-		ex.cfg = cfg
+		ex.cfg, ex.cfgPath = cfg, path
 		ex.hooks = append(ex.hooks, func(next gen.Generator) gen.Generator {
 			return gen.GenerateFunc(func(g *gen.Graph) error {
 				if g.Annotations == nil {
@@ -127,6 +161,32 @@ func WithConfigPath(path string) ExtensionOption {
 	}
 }
 
+// loadConfig loads the gqlgen configuration file from its directory, and
+// optionally, parses its schema sources.
+func loadConfig(path string, schema bool) (cfg *config.Config, err error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get working directory: %w", err)
+	}
+	if err := os.Chdir(filepath.Dir(path)); err != nil {
+		return nil, fmt.Errorf("unable to enter config dir: %w", err)
+	}
+	defer func() {
+		if cerr := os.Chdir(cwd); cerr != nil {
+			err = fmt.Errorf("unable to restore working directory: %w", cerr)
+		}
+	}()
+	if cfg, err = config.LoadConfig(filepath.Base(path)); err != nil {
+		return nil, err
+	}
+	if schema && cfg.Schema == nil {
+		if err := cfg.LoadSchema(); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
+}
+
 // WithTemplates overrides the default templates (entgql.AllTemplates)
 // with specific templates.
 func WithTemplates(templates ...*gen.Template) ExtensionOption {
@@ -152,6 +212,38 @@ func WithWhereFilters(b bool) ExtensionOption {
 	}
 }
 
+// WithFixtures configures the extension to add the FixtureTemplate to the
+// code generation templates. The template generates a "fixture" package
+// with factories for creating the schema types in tests.
+//
+//	f := fixture.New(client)
+//	td := f.Todo().SetText("text").SaveX(ctx)
+//
+func WithFixtures() ExtensionOption {
+	return func(ex *Extension) error {
+		for _, t := range ex.templates {
+			if t == FixtureTemplate {
+				return nil
+			}
+		}
+		ex.templates = append(ex.templates, FixtureTemplate)
+		return nil
+	}
+}
+
+// WithConstraints configures the extension to add the @constraint directive
+// to the generated input fields, and the ValidatorTemplate to the code generation
+// templates. See the "Input constraints" section in the package docs for more info.
+func WithConstraints() ExtensionOption {
+	return func(ex *Extension) error {
+		ex.constraints = true
+		if !ex.templateExists(ValidatorTemplate) {
+			ex.templates = append(ex.templates, ValidatorTemplate)
+		}
+		return nil
+	}
+}
+
 // WithMapScalarFunc allows users to provides a custom function that
 // maps an ent.Field (*gen.Field) into its GraphQL scalar type. If the
 // function returns an empty string, the extension fallbacks to the its
@@ -188,6 +280,19 @@ func NewExtension(opts ...ExtensionOption) (*Extension, error) {
 			return nil, err
 		}
 	}
+	switch {
+	case ex.path != "" && ex.dir != "":
+		return nil, errors.New("entgql: WithSchemaPath and WithSchemaDir are mutually exclusive")
+	case ex.ispPath != "" && ex.cfgPath == "":
+		return nil, errors.New("entgql: WithIntrospectionPath requires WithConfigPath")
+	}
+	if ex.templateExists(ScalarTemplate) {
+		ex.hooks = append(ex.hooks, ex.genScalars())
+	}
+	ex.hooks = append(ex.hooks, skipTemplates)
+	if ex.path != "" || ex.dir != "" || ex.ispPath != "" {
+		ex.hooks = append(ex.hooks, ex.genSchema())
+	}
 	ex.hooks = append(ex.hooks, removeOldAssets)
 	return ex, nil
 }
@@ -210,6 +315,9 @@ func (e *Extension) mapScalar(f *gen.Field, op gen.Op) string {
 			return t
 		}
 	}
+	if s, err := e.goScalar(f); err == nil && s != nil && !op.Niladic() {
+		return s.Name
+	}
 	scalar := f.Type.String()
 	switch t := f.Type.Type; {
 	case op.Niladic():
@@ -269,6 +377,224 @@ func (e *Extension) hasMapping(f *gen.Field) (string, bool) {
 	}
 }
 
+// scalarsAnnotation is the annotation key/name that holds the custom
+// scalars of the graph, that are generated by the ScalarTemplate.
+const scalarsAnnotation = "EntGQLScalars"
+
+// goScalar describes a GraphQL scalar that is backed by a custom Go type.
+type goScalar struct {
+	// Name of the GraphQL scalar.
+	Name string
+	// Type is the Go type identifier (e.g. *pkg.T),
+	// and Elem is its element type if it is a pointer.
+	Type, Elem string
+	// PkgPath is the package path of the Go type.
+	PkgPath string
+	// Text indicates if values are converted using the
+	// MarshalText and UnmarshalText methods, or using the
+	// Value and Scan methods otherwise.
+	Text bool
+	// owner is the first type that uses the scalar.
+	owner *gen.Type
+}
+
+// goScalar returns the custom scalar of the given field, or nil if the field
+// is not mapped to a custom scalar. See entgql.Scalar for more info.
+func (e *Extension) goScalar(f *gen.Field) (*goScalar, error) {
+	ant := &Annotation{}
+	if f.Annotations != nil && f.Annotations[ant.Name()] != nil {
+		if err := ant.Decode(f.Annotations[ant.Name()]); err != nil {
+			return nil, err
+		}
+	}
+	custom := f.HasGoType() && !f.IsEnum() && !f.IsEdgeField()
+	switch {
+	case ant.Scalar != "" && !custom:
+		return nil, fmt.Errorf("entgql: scalar field %s must have a custom Go type", f.Name)
+	case ant.Scalar != "" && ant.Type != "":
+		return nil, fmt.Errorf("entgql: field %s cannot be annotated with both entgql.Scalar and entgql.Type", f.Name)
+	case !custom || ant.Type != "":
+		return nil, nil
+	}
+	has := func(names ...string) bool {
+		for _, name := range names {
+			if _, ok := f.Type.RType.Methods[name]; !ok {
+				return false
+			}
+		}
+		return true
+	}
+	s := &goScalar{
+		Name:    ant.Scalar,
+		Type:    f.Type.String(),
+		PkgPath: f.Type.PkgPath,
+		Text:    has("MarshalText", "UnmarshalText"),
+	}
+	if f.Type.RType.IsPtr() {
+		s.Elem = f.Type.RType.Ident
+	}
+	if s.Name == "" {
+		// Without the annotation, only types that implement the field.ValueScanner
+		// interface and are not mapped in the gqlgen.yml are mapped to a scalar.
+		if _, ok := e.hasMapping(f); ok || !has("Value", "Scan") || f.Type.RType.Name == "" {
+			return nil, nil
+		}
+		s.Name = f.Type.RType.Name
+	}
+	// The methods of a Go type are recorded by ent, only if it implements the
+	// field.ValueScanner interface. Hence, other types are expected to implement
+	// the encoding.TextMarshaler and encoding.TextUnmarshaler interfaces.
+	if !has("Value", "Scan") {
+		if err := e.checkText(f); err != nil {
+			return nil, err
+		}
+		s.Text = true
+	}
+	return s, nil
+}
+
+// checkText returns an error if the Go type of the given field does not implement the
+// encoding.TextMarshaler and encoding.TextUnmarshaler interfaces. Since ent does not
+// record the methods of such types, their packages are type-checked from source.
+func (e *Extension) checkText(f *gen.Field) error {
+	rt := f.Type.RType
+	err := fmt.Errorf("entgql: Go type %s of scalar field %s must implement encoding.TextMarshaler and encoding.TextUnmarshaler, or field.ValueScanner", f.Type, f.Name)
+	if rt.Name == "" || rt.PkgPath == "" {
+		return err
+	}
+	if e.importer == nil {
+		e.importer = importer.ForCompiler(token.NewFileSet(), "source", nil).(types.ImporterFrom)
+	}
+	pkg, perr := e.importer.ImportFrom(rt.PkgPath, ".", 0)
+	if perr != nil {
+		return fmt.Errorf("entgql: loading package of scalar field %s: %w", f.Name, perr)
+	}
+	obj, ok := pkg.Scope().Lookup(rt.Name).(*types.TypeName)
+	if !ok {
+		return err
+	}
+	// Generated marshalers call the methods on addressable values,
+	// and therefore, methods with pointer receivers are accepted.
+	methods := types.NewMethodSet(types.NewPointer(obj.Type()))
+	for _, name := range []string{"MarshalText", "UnmarshalText"} {
+		if methods.Lookup(pkg, name) == nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// goScalars returns the custom scalars of the given nodes, sorted by their names.
+func (e *Extension) goScalars(nodes []*gen.Type) ([]*goScalar, error) {
+	var (
+		scalars []*goScalar
+		names   = make(map[string]*goScalar)
+	)
+	for _, n := range nodes {
+		fields, err := filterFields(n.Fields)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range fields {
+			s, err := e.goScalar(f)
+			if err != nil {
+				return nil, err
+			}
+			if s == nil {
+				continue
+			}
+			switch prev, ok := names[s.Name]; {
+			case !ok:
+				s.owner = n
+				names[s.Name] = s
+				scalars = append(scalars, s)
+			case prev.Type != s.Type || prev.PkgPath != s.PkgPath:
+				return nil, fmt.Errorf("entgql: scalar %s is used for both %s and %s", s.Name, prev.Type, s.Type)
+			}
+		}
+	}
+	sort.Slice(scalars, func(i, j int) bool {
+		return scalars[i].Name < scalars[j].Name
+	})
+	return scalars, nil
+}
+
+// genScalars returns a new hook for injecting the custom scalars
+// of the graph to its global annotations.
+func (e *Extension) genScalars() gen.Hook {
+	return func(next gen.Generator) gen.Generator {
+		return gen.GenerateFunc(func(g *gen.Graph) error {
+			nodes, err := filterNodes(g.Nodes)
+			if err != nil {
+				return err
+			}
+			scalars, err := e.goScalars(nodes)
+			if err != nil {
+				return err
+			}
+			if g.Annotations == nil {
+				g.Annotations = gen.Annotations{}
+			}
+			g.Annotations[scalarsAnnotation] = scalars
+			return next.Generate(g)
+		})
+	}
+}
+
+// skipTemplates skips the templates of features that are not used by any of the
+// schema types, and removes their previously generated files. Hence, schemas that
+// do not use these features are generated as before.
+func skipTemplates(next gen.Generator) gen.Generator {
+	return gen.GenerateFunc(func(g *gen.Graph) error {
+		nodes, err := filterNodes(g.Nodes)
+		if err != nil {
+			return err
+		}
+		templates := make([]*gen.Template, 0, len(g.Templates))
+		for _, t := range g.Templates {
+			used, err := templateUsed(g, nodes, t)
+			if err != nil {
+				return err
+			}
+			if !used {
+				if err := removeTemplateFiles(g, t); err != nil {
+					return err
+				}
+				continue
+			}
+			templates = append(templates, t)
+		}
+		g.Templates = templates
+		return next.Generate(g)
+	})
+}
+
+// templateUsed reports if the feature of the given template is used by the graph.
+func templateUsed(g *gen.Graph, nodes []*gen.Type, t *gen.Template) (bool, error) {
+	switch t {
+	case QueryTemplate:
+		for _, n := range nodes {
+			if _, ok, err := queryFieldAnnotation(n); err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	case MutationInputTemplate:
+		for _, n := range nodes {
+			if ok, err := mutationInputsAnnotation(n); err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	case ScalarTemplate:
+		// The scalars are collected by the genScalars hook.
+		scalars, _ := g.Annotations[scalarsAnnotation].([]*goScalar)
+		return len(scalars) > 0, nil
+	default:
+		return true, nil
+	}
+}
+
 // isInput reports if the given type is an input object.
 func (e *Extension) isInput(name string) bool {
 	if t, ok := e.cfg.Schema.Types[name]; ok && t != nil {
@@ -277,34 +603,105 @@ func (e *Extension) isInput(name string) bool {
 	return false
 }
 
-// genWhereInputs returns a new hook for generating
-// <T>WhereInputs in the GraphQL schema.
-func (e *Extension) genWhereInputs() gen.Hook {
+// genSchema returns a new hook for generating the <T>WhereInputs
+// and the root query fields in the GraphQL schema.
+func (e *Extension) genSchema() gen.Hook {
 	return func(next gen.Generator) gen.Generator {
-		if _, exists := e.whereExists(); !exists {
-			return next
-		}
-		inputs := make(map[string]*ast.InputObjectDefinition)
 		return gen.GenerateFunc(func(g *gen.Graph) error {
 			nodes, err := filterNodes(g.Nodes)
 			if err != nil {
 				return err
 			}
+			if e.constraints {
+				if e.fieldConstraints, err = fieldConstraints(g, nodes); err != nil {
+					return err
+				}
+			}
+			defs, owners, err := e.schemaDefs(nodes)
+			if err != nil {
+				return err
+			}
+			// Breaking changes are checked before running
+			// the codegen, to leave the project untouched.
+			prev, err := e.previousDefs(defs)
+			if err != nil {
+				return err
+			}
+			if err := e.checkChanges(prev, defs); err != nil {
+				return err
+			}
 			if err := next.Generate(g); err != nil {
 				return err
 			}
-			for _, node := range nodes {
-				name, input, err := e.whereType(node)
-				if err != nil {
-					return err
-				}
-				inputs[name] = input
+			switch {
+			case e.dir != "":
+				err = e.writeSchemaDir(defs, owners)
+			case e.path != "" && len(defs) > 0:
+				err = e.updateSchema(defs)
 			}
-			return e.updateSchema(inputs)
+			if err != nil || e.ispPath == "" {
+				return err
+			}
+			return e.genIntrospection()
 		})
 	}
 }
 
+// schemaDefs returns the generated GraphQL definitions keyed by their names
+// (directives are prefixed with "@"), and the names of the types that own them.
+func (e *Extension) schemaDefs(nodes []*gen.Type) (map[string]ast.Node, map[string]*gen.Type, error) {
+	defs, owners := make(map[string]ast.Node), make(map[string]*gen.Type)
+	if _, exists := e.whereExists(); exists {
+		for _, node := range nodes {
+			name, input, err := e.whereType(node)
+			if err != nil {
+				return nil, nil, err
+			}
+			defs[name], owners[name] = input, node
+		}
+	}
+	if e.templateExists(MutationInputTemplate) {
+		for _, node := range nodes {
+			inputs, err := e.mutationInputs(node)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, input := range inputs {
+				defs[input.Name.Value], owners[input.Name.Value] = input, node
+			}
+		}
+	}
+	if e.templateExists(ScalarTemplate) {
+		scalars, err := e.goScalars(nodes)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, s := range scalars {
+			defs[s.Name], owners[s.Name] = scalarDefinition(s.Name), s.owner
+		}
+	}
+	for _, node := range nodes {
+		payloads, err := payloadTypes(node)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, p := range payloads {
+			defs[p.Name.Value], owners[p.Name.Value] = p, node
+		}
+	}
+	if hasConstraints(defs) {
+		defs["@"+ConstraintDirective] = constraintDefinition()
+	}
+	query, err := e.queryType(nodes)
+	if err != nil {
+		return nil, nil, err
+	}
+	if query != nil {
+		defs[query.Definition.Name.Value] = query
+	}
+	return defs, owners, nil
+}
+
 // whereExists reports if the WhereTemplate exists
 // in the template list and returns its index.
 func (e *Extension) whereExists() (int, bool) {
@@ -316,29 +713,214 @@ func (e *Extension) whereExists() (int, bool) {
 	return -1, false
 }
 
+// templateExists reports if the given template exists in the template list.
+func (e *Extension) templateExists(t *gen.Template) bool {
+	for i := range e.templates {
+		if e.templates[i] == t {
+			return true
+		}
+	}
+	return false
+}
+
 // updateSchema commits the changes to the GraphQL schema file.
-func (e *Extension) updateSchema(inputs map[string]*ast.InputObjectDefinition) error {
+func (e *Extension) updateSchema(defs map[string]ast.Node) error {
+	// If the definition was found in the schema, we update it.
+	update := func(name string) (string, interface{}) {
+		if def, ok := defs[name]; ok {
+			delete(defs, name)
+			return visitor.ActionUpdate, def
+		}
+		return visitor.ActionNoChange, nil
+	}
 	visitor.Visit(e.doc, &visitor.VisitorOptions{
 		LeaveKindMap: map[string]visitor.VisitFunc{
+			kinds.ObjectDefinition: func(p visitor.VisitFuncParams) (string, interface{}) {
+				// Object definitions of type extensions (e.g. Query) are updated by their parents.
+				if node, ok := p.Node.(*ast.ObjectDefinition); ok {
+					if _, ok := defs[node.Name.Value].(*ast.ObjectDefinition); ok {
+						return update(node.Name.Value)
+					}
+				}
+				return visitor.ActionNoChange, nil
+			},
 			kinds.InputObjectDefinition: func(p visitor.VisitFuncParams) (string, interface{}) {
-				// If the input object was found in the schema, we update its definition.
-				if node, ok := p.Node.(*ast.InputObjectDefinition); ok && inputs[node.Name.Value] != nil {
-					input := inputs[node.Name.Value]
-					delete(inputs, node.Name.Value)
-					return visitor.ActionUpdate, input
+				if node, ok := p.Node.(*ast.InputObjectDefinition); ok {
+					return update(node.Name.Value)
+				}
+				return visitor.ActionNoChange, nil
+			},
+			kinds.ScalarDefinition: func(p visitor.VisitFuncParams) (string, interface{}) {
+				if node, ok := p.Node.(*ast.ScalarDefinition); ok {
+					return update(node.Name.Value)
+				}
+				return visitor.ActionNoChange, nil
+			},
+			kinds.DirectiveDefinition: func(p visitor.VisitFuncParams) (string, interface{}) {
+				if node, ok := p.Node.(*ast.DirectiveDefinition); ok {
+					return update("@" + node.Name.Value)
+				}
+				return visitor.ActionNoChange, nil
+			},
+			kinds.TypeExtensionDefinition: func(p visitor.VisitFuncParams) (string, interface{}) {
+				if node, ok := p.Node.(*ast.TypeExtensionDefinition); ok && node.Definition != nil {
+					return update(node.Definition.Name.Value)
 				}
 				return visitor.ActionNoChange, nil
 			},
 		},
 	}, nil)
-	// Sorting the input types is not needed, because in the next iteration
-	// the hook updates the generated types without changing their position.
-	for _, input := range inputs {
-		e.doc.Definitions = append(e.doc.Definitions, input)
+	// Sorting the new definitions is required for getting a stable output,
+	// and in the next iteration the hook updates the generated types without
+	// changing their position.
+	names := make([]string, 0, len(defs))
+	for name := range defs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		e.doc.Definitions = append(e.doc.Definitions, defs[name])
 	}
 	return ioutil.WriteFile(e.path, []byte(printer.Print(e.doc).(string)), 0644)
 }
 
+// previousDefs returns the previous versions of the generated definitions. In
+// schema directory mode, these are all definitions in the generated files. In
+// schema file mode, these are the definitions that are updated by updateSchema.
+// Definitions that are no longer generated are kept in the schema file, as they
+// cannot be told apart from user definitions, and are not reported as removed.
+func (e *Extension) previousDefs(next map[string]ast.Node) (map[string]ast.Node, error) {
+	prev := make(map[string]ast.Node)
+	switch {
+	case e.dir != "":
+		paths, err := filepath.Glob(filepath.Join(e.dir, "*.graphql"))
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range paths {
+			if generated, err := isGenerated(path); err != nil || !generated {
+				// Missing files and user files are ignored.
+				continue
+			}
+			buf, err := ioutil.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("reading graphql schema file %q: %w", path, err)
+			}
+			doc, err := parser.Parse(parser.ParseParams{
+				Source: &source.Source{Body: buf, Name: filepath.Base(path)},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("parsing graphql schema file %q: %w", path, err)
+			}
+			for _, def := range doc.Definitions {
+				if name, ok := definitionKey(def); ok {
+					prev[name] = def
+				}
+			}
+		}
+	case e.doc != nil:
+		for _, def := range e.doc.Definitions {
+			name, ok := definitionKey(def)
+			if !ok || next[name] == nil {
+				continue
+			}
+			// Object definitions are updated only by generated object definitions. For
+			// example, a user-defined Query type is not replaced by the Query extension.
+			if _, ok := def.(*ast.ObjectDefinition); ok {
+				if _, ok := next[name].(*ast.ObjectDefinition); !ok {
+					continue
+				}
+			}
+			prev[name] = def
+		}
+	}
+	return prev, nil
+}
+
+// generatedHeader is the header of the schema files that are written by the extension.
+const generatedHeader = "# Code generated by entgql, DO NOT EDIT.\n"
+
+// writeSchemaDir writes the generated definitions to per-type files in the schema
+// directory, and removes the generated files that are no longer needed.
+func (e *Extension) writeSchemaDir(defs map[string]ast.Node, owners map[string]*gen.Type) error {
+	files := make(map[string][]string)
+	for name := range defs {
+		file := strings.ToLower(name)
+		if t, ok := owners[name]; ok {
+			file = t.Package()
+		} else if strings.HasPrefix(name, "@") {
+			file = "directives"
+		}
+		file += ".graphql"
+		files[file] = append(files[file], name)
+	}
+	if err := os.MkdirAll(e.dir, os.ModePerm); err != nil {
+		return fmt.Errorf("creating graphql schema dir %q: %w", e.dir, err)
+	}
+	entries, err := ioutil.ReadDir(e.dir)
+	if err != nil {
+		return fmt.Errorf("reading graphql schema dir %q: %w", e.dir, err)
+	}
+	for _, entry := range entries {
+		path := filepath.Join(e.dir, entry.Name())
+		if entry.IsDir() || filepath.Ext(path) != ".graphql" {
+			continue
+		}
+		generated, err := isGenerated(path)
+		if err != nil {
+			return err
+		}
+		_, used := files[entry.Name()]
+		switch {
+		case used && !generated:
+			return fmt.Errorf("entgql: cannot override graphql schema file %q that was not generated by entgql", path)
+		case !used && generated:
+			if err := os.Remove(path); err != nil {
+				return fmt.Errorf("removing graphql schema file %q: %w", path, err)
+			}
+		}
+	}
+	for file, names := range files {
+		sort.Strings(names)
+		doc := ast.NewDocument(&ast.Document{})
+		for _, name := range names {
+			doc.Definitions = append(doc.Definitions, defs[name])
+		}
+		path := filepath.Join(e.dir, file)
+		if err := ioutil.WriteFile(path, []byte(generatedHeader+"\n"+printer.Print(doc).(string)), 0644); err != nil {
+			return fmt.Errorf("writing graphql schema file %q: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// isGenerated reports if the given schema file was generated by the extension.
+func isGenerated(path string) (bool, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return false, fmt.Errorf("reading graphql schema file %q: %w", path, err)
+	}
+	return strings.HasPrefix(string(buf), generatedHeader), nil
+}
+
+// genIntrospection writes the introspection result of the GraphQL schema
+// that is loaded from the sources of the gqlgen configuration file.
+func (e *Extension) genIntrospection() error {
+	cfg, err := loadConfig(e.cfgPath, false)
+	if err != nil {
+		return err
+	}
+	schema, gerr := gqlparser.LoadSchema(cfg.Sources...)
+	if gerr != nil {
+		return fmt.Errorf("loading graphql schema: %w", gerr)
+	}
+	buf, err := json.MarshalIndent(introspect(schema), "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(e.ispPath, append(buf, '\n'), 0644)
+}
+
 // addWhereType returns the a <T>WhereInput to the given schema type (e.g. User -> UserWhereInput).
 func (e *Extension) whereType(t *gen.Type) (string, *ast.InputObjectDefinition, error) {
 	var (
@@ -462,12 +1044,425 @@ func (e *Extension) fieldDefinition(f *gen.Field, op gen.Op) *ast.InputValueDefi
 			}),
 		})
 	}
+	// Constraints apply only to predicates that match stored field values.
+	// Values that violate them cannot match any node, while other predicates
+	// (e.g. NEQ, NotIn or GT) accept them.
+	if e.constraints && constrainedOp(op) {
+		if d := e.constraintDirective(f); d != nil {
+			def.Directives = append(def.Directives, d)
+		}
+	}
 	return def
 }
 
+// constrainedOp reports if the predicate input fields of the given
+// operation carry the constraints of their field.
+func constrainedOp(op gen.Op) bool {
+	return op == gen.EQ || op == gen.In
+}
+
+// constraintDirective returns the @constraint directive of the given field, or nil
+// if it has no arguments. The arguments are derived from the field validators, and
+// can be overridden by the entgql.Constraint annotation. The validators themselves
+// are enforced by the Validator extension using the generated InputValidators.
+func (e *Extension) constraintDirective(f *gen.Field) *ast.Directive {
+	args := &ConstraintArgs{}
+	if derived, ok := e.fieldConstraints[f]; ok {
+		*args = *derived
+	}
+	ant := &Annotation{}
+	if i, ok := f.Annotations[ant.Name()]; ok && ant.Decode(i) == nil && ant.Constraint != nil {
+		args = args.merge(ant.Constraint)
+	}
+	// Text fields are defined with the maximum size by default.
+	if args.MaxLength == nil && hasValidators(f) && f.IsString() {
+		if size := f.Column().Size; size > 0 && size < math.MaxInt32 {
+			n := int(size)
+			args.MaxLength = &n
+		}
+	}
+	var arguments []*ast.Argument
+	for _, arg := range []struct {
+		name  string
+		value ast.Value
+	}{
+		{"minLength", intValue(args.MinLength)},
+		{"maxLength", intValue(args.MaxLength)},
+		{"min", floatValue(args.Min)},
+		{"max", floatValue(args.Max)},
+		{"pattern", stringValue(args.Pattern)},
+	} {
+		if arg.value == nil {
+			continue
+		}
+		arguments = append(arguments, ast.NewArgument(&ast.Argument{
+			Name: ast.NewName(&ast.Name{
+				Value: arg.name,
+			}),
+			Value: arg.value,
+		}))
+	}
+	if len(arguments) == 0 {
+		return nil
+	}
+	return ast.NewDirective(&ast.Directive{
+		Name: ast.NewName(&ast.Name{
+			Value: ConstraintDirective,
+		}),
+		Arguments: arguments,
+	})
+}
+
+func intValue(v *int) ast.Value {
+	if v == nil {
+		return nil
+	}
+	return ast.NewIntValue(&ast.IntValue{
+		Value: strconv.Itoa(*v),
+	})
+}
+
+func floatValue(v *float64) ast.Value {
+	if v == nil {
+		return nil
+	}
+	return ast.NewFloatValue(&ast.FloatValue{
+		Value: strconv.FormatFloat(*v, 'f', -1, 64),
+	})
+}
+
+func stringValue(v string) ast.Value {
+	if v == "" {
+		return nil
+	}
+	return ast.NewStringValue(&ast.StringValue{
+		Value: v,
+	})
+}
+
+// constraintDefinition returns the definition of the @constraint directive.
+func constraintDefinition() *ast.DirectiveDefinition {
+	def := ast.NewDirectiveDefinition(&ast.DirectiveDefinition{
+		Name: ast.NewName(&ast.Name{
+			Value: ConstraintDirective,
+		}),
+		Description: ast.NewStringValue(&ast.StringValue{
+			Value: "Input constraints that are derived from the ent validators,\nand enforced by the entgql.Validator extension.",
+		}),
+	})
+	for _, arg := range []struct{ name, typ string }{
+		{"minLength", graphql.Int.Name()},
+		{"maxLength", graphql.Int.Name()},
+		{"min", graphql.Float.Name()},
+		{"max", graphql.Float.Name()},
+		{"pattern", graphql.String.Name()},
+	} {
+		def.Arguments = append(def.Arguments, inputValue(arg.name, arg.typ))
+	}
+	for _, loc := range []string{"INPUT_FIELD_DEFINITION", "ARGUMENT_DEFINITION"} {
+		def.Locations = append(def.Locations, ast.NewName(&ast.Name{
+			Value: loc,
+		}))
+	}
+	return def
+}
+
+// hasConstraints reports if one of the given input objects uses the @constraint directive.
+func hasConstraints(defs map[string]ast.Node) bool {
+	for _, def := range defs {
+		input, ok := def.(*ast.InputObjectDefinition)
+		if !ok {
+			continue
+		}
+		for _, f := range input.Fields {
+			for _, d := range f.Directives {
+				if d.Name.Value == ConstraintDirective {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// queryType returns a Query type extension that holds the root query
+// fields of the types annotated with entgql.QueryField, or nil if there
+// are no such types.
+func (e *Extension) queryType(nodes []*gen.Type) (*ast.TypeExtensionDefinition, error) {
+	var (
+		fields []*ast.FieldDefinition
+		names  = make(map[string]string)
+	)
+	for _, t := range nodes {
+		name, ok, err := queryFieldAnnotation(t)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if other, ok := names[name]; ok {
+			return nil, fmt.Errorf("entgql: query field %q is defined by both %s and %s", name, other, t.Name)
+		}
+		names[name] = t.Name
+		args := []*ast.InputValueDefinition{
+			inputValue("after", "Cursor"),
+			inputValue("first", graphql.Int.Name()),
+			inputValue("before", "Cursor"),
+			inputValue("last", graphql.Int.Name()),
+		}
+		ordered, err := hasOrderFields(t)
+		if err != nil {
+			return nil, err
+		}
+		if ordered {
+			args = append(args, inputValue("orderBy", t.Name+"Order"))
+		}
+		if _, exists := e.whereExists(); exists {
+			args = append(args, inputValue("where", t.Name+"WhereInput"))
+		}
+		marker, err := includeDeletedField(t)
+		if err != nil {
+			return nil, err
+		}
+		if marker != nil {
+			args = append(args, inputValue(IncludeDeletedArg, graphql.Boolean.Name()))
+		}
+		directives, err := deprecatedDirectives(t)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, ast.NewFieldDefinition(&ast.FieldDefinition{
+			Name: ast.NewName(&ast.Name{
+				Value: name,
+			}),
+			Arguments:  args,
+			Directives: directives,
+			Type: ast.NewNamed(&ast.Named{
+				Name: ast.NewName(&ast.Name{
+					Value: t.Name + "Connection",
+				}),
+			}),
+		}))
+	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return ast.NewTypeExtensionDefinition(&ast.TypeExtensionDefinition{
+		Definition: ast.NewObjectDefinition(&ast.ObjectDefinition{
+			Name: ast.NewName(&ast.Name{
+				Value: "Query",
+			}),
+			Fields: fields,
+		}),
+	}), nil
+}
+
+// mutationInputs returns the Create<T>Input and Update<T>Input types of the
+// given type, as configured using the entgql.MutationInputs annotation.
+func (e *Extension) mutationInputs(t *gen.Type) ([]*ast.InputObjectDefinition, error) {
+	ok, err := mutationInputsAnnotation(t)
+	if err != nil || !ok {
+		return nil, err
+	}
+	fields, err := filterFields(t.Fields)
+	if err != nil {
+		return nil, err
+	}
+	edges, err := filterEdges(t.Edges)
+	if err != nil {
+		return nil, err
+	}
+	create, update := inputObject("Create"+t.Name+"Input", fmt.Sprintf("Create%sInput is used for creating %s objects.\nInput was generated by ent.", t.Name, t.Name)),
+		inputObject("Update"+t.Name+"Input", fmt.Sprintf("Update%sInput is used for updating %s objects.\nInput was generated by ent.", t.Name, t.Name))
+	// Inputs of Relay mutations hold the clientMutationId that is returned in their payload.
+	ops, err := relayMutationsAnnotation(t)
+	if err != nil {
+		return nil, err
+	}
+	for _, op := range ops {
+		switch op {
+		case RelayCreate:
+			create.Fields = append(create.Fields, inputValue("clientMutationId", graphql.String.Name()))
+		case RelayUpdate:
+			update.Fields = append(update.Fields, inputValue("clientMutationId", graphql.String.Name()))
+		}
+	}
+	for _, f := range fields {
+		if !isMutationInput(f) {
+			continue
+		}
+		fd := e.fieldDefinition(f, gen.EQ)
+		if !f.Optional && !f.Default {
+			fd.Type = ast.NewNonNull(&ast.NonNull{
+				Type: fd.Type,
+			})
+		}
+		create.Fields = append(create.Fields, fd)
+		if f.Immutable {
+			continue
+		}
+		update.Fields = append(update.Fields, e.fieldDefinition(f, gen.EQ))
+		if f.Optional {
+			update.Fields = append(update.Fields, inputValue(camel("clear_"+f.Name), graphql.Boolean.Name()))
+		}
+	}
+	for _, edge := range edges {
+		nested, err := mutationInputsAnnotation(edge.Type)
+		if err != nil {
+			return nil, err
+		}
+		// Nested inputs are supported only for edges that are owned by the type.
+		nested = nested && !edge.IsInverse()
+		if edge.Unique {
+			fd := inputValue(lowerFirst(strings.TrimPrefix(edge.MutationSet(), "Set")), graphql.ID.Name())
+			update.Fields = append(update.Fields, fd)
+			if edge.Optional {
+				update.Fields = append(update.Fields, inputValue(lowerFirst(edge.MutationClear()), graphql.Boolean.Name()))
+			}
+			if !edge.Optional && !nested {
+				fd = ast.NewInputValueDefinition(&ast.InputValueDefinition{
+					Name: fd.Name,
+					Type: ast.NewNonNull(&ast.NonNull{
+						Type: fd.Type,
+					}),
+				})
+			}
+			create.Fields = append(create.Fields, fd)
+		} else {
+			create.Fields = append(create.Fields, inputList(lowerFirst(strings.TrimPrefix(edge.MutationAdd(), "Add")), graphql.ID.Name()))
+			update.Fields = append(update.Fields,
+				inputList(lowerFirst(edge.MutationAdd()), graphql.ID.Name()),
+				inputList(lowerFirst(edge.MutationRemove()), graphql.ID.Name()),
+				inputValue(lowerFirst(edge.MutationClear()), graphql.Boolean.Name()),
+			)
+		}
+		if nested {
+			name, typ := "create"+edge.StructField(), "Create"+edge.Type.Name+"Input"
+			fd := inputValue(name, typ)
+			if !edge.Unique {
+				fd = inputList(name, typ)
+			}
+			create.Fields = append(create.Fields, fd)
+			update.Fields = append(update.Fields, fd)
+		}
+	}
+	return []*ast.InputObjectDefinition{create, update}, nil
+}
+
+// isMutationInput reports if the given field is included in the mutation inputs.
+// Like in the <T>WhereInput, only fields with comparable types are supported.
+func isMutationInput(f *gen.Field) bool {
+	return f.Type.Comparable() && !f.IsEdgeField()
+}
+
+// inputObject returns an input object definition with the given name and description.
+func inputObject(name, desc string) *ast.InputObjectDefinition {
+	return ast.NewInputObjectDefinition(&ast.InputObjectDefinition{
+		Name: ast.NewName(&ast.Name{
+			Value: name,
+		}),
+		Description: ast.NewStringValue(&ast.StringValue{
+			Value: desc,
+		}),
+	})
+}
+
+// scalarDefinition returns a scalar definition with the given name.
+func scalarDefinition(name string) *ast.ScalarDefinition {
+	return ast.NewScalarDefinition(&ast.ScalarDefinition{
+		Name: ast.NewName(&ast.Name{
+			Value: name,
+		}),
+	})
+}
+
+// inputList returns a nullable input value definition with the given name and a list
+// of non-null elements of the given type.
+func inputList(name, typ string) *ast.InputValueDefinition {
+	return ast.NewInputValueDefinition(&ast.InputValueDefinition{
+		Name: ast.NewName(&ast.Name{
+			Value: name,
+		}),
+		Type: ast.NewList(&ast.List{
+			Type: ast.NewNonNull(&ast.NonNull{
+				Type: ast.NewNamed(&ast.Named{
+					Name: ast.NewName(&ast.Name{
+						Value: typ,
+					}),
+				}),
+			}),
+		}),
+	})
+}
+
+// lowerFirst converts the first letter of the given name
+// to lower case (e.g. AddChildIDs => addChildIDs).
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// payloadTypes returns the Relay mutation payload types of the given
+// type, as configured using the entgql.RelayMutations annotation.
+func payloadTypes(t *gen.Type) ([]*ast.ObjectDefinition, error) {
+	ops, err := relayMutationsAnnotation(t)
+	if err != nil {
+		return nil, err
+	}
+	types := make([]*ast.ObjectDefinition, 0, len(ops))
+	for _, op := range ops {
+		if op != RelayCreate && op != RelayUpdate {
+			return nil, fmt.Errorf("entgql: unknown relay mutation operation %q for type %s", op, t.Name)
+		}
+		types = append(types, ast.NewObjectDefinition(&ast.ObjectDefinition{
+			Name: ast.NewName(&ast.Name{
+				Value: pascal(op) + t.Name + "Payload",
+			}),
+			Fields: []*ast.FieldDefinition{
+				fieldDefinition("clientMutationId", graphql.String.Name()),
+				fieldDefinition(camel(snake(t.Name+"Edge")), t.Name+"Edge"),
+			},
+		}))
+	}
+	return types, nil
+}
+
+// fieldDefinition returns a nullable field definition with the given name and type.
+func fieldDefinition(name, typ string) *ast.FieldDefinition {
+	return ast.NewFieldDefinition(&ast.FieldDefinition{
+		Name: ast.NewName(&ast.Name{
+			Value: name,
+		}),
+		Type: ast.NewNamed(&ast.Named{
+			Name: ast.NewName(&ast.Name{
+				Value: typ,
+			}),
+		}),
+	})
+}
+
+// inputValue returns a nullable input value definition with the given name and type.
+func inputValue(name, typ string) *ast.InputValueDefinition {
+	return ast.NewInputValueDefinition(&ast.InputValueDefinition{
+		Name: ast.NewName(&ast.Name{
+			Value: name,
+		}),
+		Type: ast.NewNamed(&ast.Named{
+			Name: ast.NewName(&ast.Name{
+				Value: typ,
+			}),
+		}),
+	})
+}
+
 var (
 	_     entc.Extension = (*Extension)(nil)
 	camel                = gen.Funcs["camel"].(func(string) string)
+	pascal               = gen.Funcs["pascal"].(func(string) string)
+	snake                = gen.Funcs["snake"].(func(string) string)
 )
 
 // typeAnnotation returns the scalar type mapping if exists (i.e. entgql.Type).
@@ -478,3 +1473,94 @@ func typeAnnotation(f *gen.Field) (string, bool) {
 	}
 	return "", false
 }
+
+// queryFieldAnnotation returns the root query field name of
+// the given type if exists (i.e. entgql.QueryField).
+func queryFieldAnnotation(t *gen.Type) (string, bool, error) {
+	ant := &Annotation{}
+	if t.Annotations == nil || t.Annotations[ant.Name()] == nil {
+		return "", false, nil
+	}
+	if err := ant.Decode(t.Annotations[ant.Name()]); err != nil {
+		return "", false, err
+	}
+	return ant.QueryField, ant.QueryField != "", nil
+}
+
+// deprecatedDirectives returns the @deprecated directive of the given
+// type if it was annotated with entgql.Deprecated.
+func deprecatedDirectives(t *gen.Type) ([]*ast.Directive, error) {
+	ant := &Annotation{}
+	if t.Annotations == nil || t.Annotations[ant.Name()] == nil {
+		return nil, nil
+	}
+	if err := ant.Decode(t.Annotations[ant.Name()]); err != nil {
+		return nil, err
+	}
+	if ant.Deprecated == "" {
+		return nil, nil
+	}
+	return []*ast.Directive{
+		ast.NewDirective(&ast.Directive{
+			Name: ast.NewName(&ast.Name{
+				Value: "deprecated",
+			}),
+			Arguments: []*ast.Argument{
+				ast.NewArgument(&ast.Argument{
+					Name: ast.NewName(&ast.Name{
+						Value: "reason",
+					}),
+					Value: stringValue(ant.Deprecated),
+				}),
+			},
+		}),
+	}, nil
+}
+
+// relayMutationsAnnotation returns the Relay mutation operations of
+// the given type if exists (i.e. entgql.RelayMutations).
+func relayMutationsAnnotation(t *gen.Type) ([]string, error) {
+	ant := &Annotation{}
+	if t.Annotations == nil || t.Annotations[ant.Name()] == nil {
+		return nil, nil
+	}
+	if err := ant.Decode(t.Annotations[ant.Name()]); err != nil {
+		return nil, err
+	}
+	return ant.RelayMutations, nil
+}
+
+// mutationInputsAnnotation reports if the given type was
+// annotated with entgql.MutationInputs.
+func mutationInputsAnnotation(t *gen.Type) (bool, error) {
+	ant := &Annotation{}
+	if t.Annotations == nil || t.Annotations[ant.Name()] == nil {
+		return false, nil
+	}
+	if err := ant.Decode(t.Annotations[ant.Name()]); err != nil {
+		return false, err
+	}
+	return ant.MutationInputs, nil
+}
+
+// hasOrderFields reports if the given type has fields that were
+// annotated with entgql.OrderField (i.e. it has a <T>Order input).
+func hasOrderFields(t *gen.Type) (bool, error) {
+	fields, err := filterFields(t.Fields)
+	if err != nil {
+		return false, err
+	}
+	for _, f := range append(fields, t.ID) {
+		ant := &Annotation{}
+		if f.Annotations == nil || f.Annotations[ant.Name()] == nil {
+			continue
+		}
+		if err := ant.Decode(f.Annotations[ant.Name()]); err != nil {
+			return false, err
+		}
+		if ant.OrderField != "" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,431 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"html/template"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/printer"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryType(t *testing.T) {
+	ex, err := NewExtension(WithWhereFilters(true))
+	require.NoError(t, err)
+	nodes := []*gen.Type{
+		{
+			Name: "Todo",
+			ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+			Fields: []*gen.Field{
+				{
+					Name: "text",
+					Type: &field.TypeInfo{Type: field.TypeString},
+					Annotations: map[string]interface{}{
+						annotationName: map[string]interface{}{"OrderField": "TEXT"},
+					},
+				},
+			},
+			Annotations: map[string]interface{}{
+				annotationName: map[string]interface{}{"QueryField": "todos"},
+			},
+		},
+		{
+			Name: "User",
+			ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+			Annotations: map[string]interface{}{
+				annotationName: map[string]interface{}{"QueryField": "users"},
+			},
+		},
+		{
+			Name: "Group",
+			ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+		},
+	}
+	def, err := ex.queryType(nodes)
+	require.NoError(t, err)
+	require.Equal(t, `extend type Query {
+  todos(after: Cursor, first: Int, before: Cursor, last: Int, orderBy: TodoOrder, where: TodoWhereInput): TodoConnection
+  users(after: Cursor, first: Int, before: Cursor, last: Int, where: UserWhereInput): UserConnection
+}`, printer.Print(def))
+
+	def, err = ex.queryType(nodes[2:])
+	require.NoError(t, err)
+	require.Nil(t, def)
+
+	nodes[1].Annotations[annotationName] = Annotation{QueryField: "users"}.Merge(Deprecated(""))
+	def, err = ex.queryType(nodes[1:])
+	require.NoError(t, err)
+	require.Equal(t, `extend type Query {
+  users(after: Cursor, first: Int, before: Cursor, last: Int, where: UserWhereInput): UserConnection @deprecated(reason: "No longer supported")
+}`, printer.Print(def))
+	prev := map[string]ast.Node{"Query": def}
+	require.NoError(t, ex.checkChanges(prev, map[string]ast.Node{"Query": ast.NewTypeExtensionDefinition(&ast.TypeExtensionDefinition{
+		Definition: ast.NewObjectDefinition(&ast.ObjectDefinition{Name: ast.NewName(&ast.Name{Value: "Query"})}),
+	})}), "deprecated query fields can be removed")
+
+	nodes[0].Fields = append(nodes[0].Fields, &gen.Field{
+		Name:     "deleted_at",
+		Type:     &field.TypeInfo{Type: field.TypeTime},
+		Optional: true,
+		Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{"SoftDelete": true},
+		},
+	})
+	def, err = ex.queryType(nodes[:1])
+	require.NoError(t, err)
+	require.Equal(t, `extend type Query {
+  todos(after: Cursor, first: Int, before: Cursor, last: Int, orderBy: TodoOrder, where: TodoWhereInput): TodoConnection
+}`, printer.Print(def), "includeDeleted is added only if allowed")
+	nodes[0].Fields[1].Annotations[annotationName] = SoftDelete(AllowIncludeDeleted())
+	def, err = ex.queryType(nodes[:1])
+	require.NoError(t, err)
+	require.Equal(t, `extend type Query {
+  todos(after: Cursor, first: Int, before: Cursor, last: Int, orderBy: TodoOrder, where: TodoWhereInput, includeDeleted: Boolean): TodoConnection
+}`, printer.Print(def))
+
+	nodes[1].Annotations[annotationName] = map[string]interface{}{"QueryField": "todos"}
+	_, err = ex.queryType(nodes)
+	require.EqualError(t, err, `entgql: query field "todos" is defined by both Todo and User`)
+}
+
+func TestPayloadTypes(t *testing.T) {
+	node := &gen.Type{
+		Name: "VerySecret",
+		ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+		Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{"RelayMutations": []string{RelayCreate, RelayUpdate}},
+		},
+	}
+	defs, err := payloadTypes(node)
+	require.NoError(t, err)
+	require.Len(t, defs, 2)
+	require.Equal(t, `type CreateVerySecretPayload {
+  clientMutationId: String
+  verySecretEdge: VerySecretEdge
+}`, printer.Print(defs[0]))
+	require.Equal(t, "UpdateVerySecretPayload", defs[1].Name.Value)
+
+	defs, err = payloadTypes(&gen.Type{Name: "Group"})
+	require.NoError(t, err)
+	require.Empty(t, defs)
+
+	node.Annotations[annotationName] = map[string]interface{}{"RelayMutations": []string{"delete"}}
+	_, err = payloadTypes(node)
+	require.EqualError(t, err, `entgql: unknown relay mutation operation "delete" for type VerySecret`)
+}
+
+func TestMutationInputs(t *testing.T) {
+	ex, err := NewExtension()
+	require.NoError(t, err)
+	todo := &gen.Type{
+		Name: "Todo",
+		ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+		Fields: []*gen.Field{
+			{Name: "created_at", Type: &field.TypeInfo{Type: field.TypeTime}, Default: true, Immutable: true},
+			{Name: "text", Type: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "priority", Type: &field.TypeInfo{Type: field.TypeInt}, Optional: true},
+		},
+		Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{"MutationInputs": true},
+		},
+	}
+	owner := &gen.Type{
+		Name: "User",
+		ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+	}
+	todo.Edges = []*gen.Edge{
+		{Name: "parent", Type: todo, Unique: true, Optional: true, Inverse: "children"},
+		{Name: "children", Type: todo},
+		{Name: "owner", Type: owner, Unique: true},
+	}
+	defs, err := ex.mutationInputs(todo)
+	require.NoError(t, err)
+	require.Len(t, defs, 2)
+	defs[0].Description, defs[1].Description = nil, nil
+	require.Equal(t, `input CreateTodoInput {
+  createdAt: Time
+  text: String!
+  priority: Int
+  parentID: ID
+  childIDs: [ID!]
+  createChildren: [CreateTodoInput!]
+  ownerID: ID!
+}`, printer.Print(defs[0]))
+	require.Equal(t, `input UpdateTodoInput {
+  text: String
+  priority: Int
+  clearPriority: Boolean
+  parentID: ID
+  clearParent: Boolean
+  addChildIDs: [ID!]
+  removeChildIDs: [ID!]
+  clearChildren: Boolean
+  createChildren: [CreateTodoInput!]
+  ownerID: ID
+}`, printer.Print(defs[1]))
+
+	todo.Fields, todo.Edges = todo.Fields[1:2], nil
+	todo.Annotations[annotationName] = map[string]interface{}{"MutationInputs": true, "RelayMutations": []string{RelayUpdate}}
+	defs, err = ex.mutationInputs(todo)
+	require.NoError(t, err)
+	require.Len(t, defs, 2)
+	defs[0].Description, defs[1].Description = nil, nil
+	require.Equal(t, `input CreateTodoInput {
+  text: String!
+}`, printer.Print(defs[0]))
+	require.Equal(t, `input UpdateTodoInput {
+  clientMutationId: String
+  text: String
+}`, printer.Print(defs[1]))
+
+	defs, err = ex.mutationInputs(owner)
+	require.NoError(t, err)
+	require.Empty(t, defs)
+}
+
+func TestConstraintDirective(t *testing.T) {
+	text := &gen.Field{
+		Name: "text",
+		Type: &field.TypeInfo{Type: field.TypeString},
+		Annotations: map[string]interface{}{
+			annotationName: Constraint(NotEmpty(), Match("^[a-z]+$")),
+		},
+	}
+	ex, err := NewExtension()
+	require.NoError(t, err)
+	require.Equal(t, "text: String", printer.Print(ex.fieldDefinition(text, gen.EQ)), "constraints are opt-in")
+
+	ex, err = NewExtension(WithConstraints())
+	require.NoError(t, err)
+	require.True(t, ex.templateExists(ValidatorTemplate))
+	require.Equal(t, `text: String @constraint(minLength: 1, pattern: "^[a-z]+$")`, printer.Print(ex.fieldDefinition(text, gen.EQ)))
+	require.Equal(t, `textIn: [String!] @constraint(minLength: 1, pattern: "^[a-z]+$")`, printer.Print(ex.fieldDefinition(text, gen.In)))
+	require.Equal(t, "textNEQ: String", printer.Print(ex.fieldDefinition(text, gen.NEQ)))
+	require.Equal(t, "textNotIn: [String!]", printer.Print(ex.fieldDefinition(text, gen.NotIn)))
+
+	priority := &gen.Field{
+		Name: "priority",
+		Type: &field.TypeInfo{Type: field.TypeInt},
+		Annotations: map[string]interface{}{
+			annotationName: Constraint(Range(0, 10.5)),
+		},
+	}
+	require.Equal(t, "priority: Int @constraint(min: 0, max: 10.5)", printer.Print(ex.fieldDefinition(priority, gen.EQ)))
+
+	// Fields without constraint arguments have no directive.
+	status := &gen.Field{Name: "status", Type: &field.TypeInfo{Type: field.TypeInt}, Validators: 1}
+	require.Equal(t, "status: Int", printer.Print(ex.fieldDefinition(status, gen.EQ)))
+
+	// Derived arguments are overridden by the annotation.
+	name := &gen.Field{
+		Name:       "name",
+		Type:       &field.TypeInfo{Type: field.TypeString},
+		Validators: 2,
+		Annotations: map[string]interface{}{
+			annotationName: Constraint(MaxLen(10)),
+		},
+	}
+	ex.fieldConstraints = map[*gen.Field]*ConstraintArgs{
+		name:   Constraint(NotEmpty(), MaxLen(20)).Constraint,
+		status: Constraint(Range(1, 3)).Constraint,
+	}
+	require.Equal(t, "name: String @constraint(minLength: 1, maxLength: 10)", printer.Print(ex.fieldDefinition(name, gen.EQ)))
+	require.Equal(t, "status: Int @constraint(min: 1, max: 3)", printer.Print(ex.fieldDefinition(status, gen.EQ)))
+}
+
+// Money implements the field.ValueScanner interface.
+type Money int64
+
+func (m *Money) Scan(v interface{}) error {
+	*m = Money(v.(int64))
+	return nil
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return int64(m), nil
+}
+
+func TestGoScalars(t *testing.T) {
+	ex, err := NewExtension(WithWhereFilters(true))
+	require.NoError(t, err)
+	user := &gen.Type{
+		Name: "User",
+		ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+		Fields: []*gen.Field{
+			{Name: "name", Type: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "balance", Type: field.Int64("balance").GoType(Money(0)).Descriptor().Info, Optional: true},
+			{Name: "nick", Type: field.String("nick").GoType(template.HTML("")).Descriptor().Info},
+			{
+				Name: "ip",
+				Type: field.Bytes("ip").GoType(net.IP{}).Descriptor().Info,
+				Annotations: map[string]interface{}{
+					annotationName: map[string]interface{}{"Scalar": "IPAddress"},
+				},
+			},
+		},
+	}
+	scalars, err := ex.goScalars([]*gen.Type{user})
+	require.NoError(t, err)
+	require.Len(t, scalars, 2)
+	require.Equal(t, &goScalar{Name: "IPAddress", Type: "net.IP", PkgPath: "net", Text: true, owner: user}, scalars[0])
+	require.Equal(t, &goScalar{Name: "Money", Type: "entgql.Money", PkgPath: "entgo.io/contrib/entgql", owner: user}, scalars[1])
+	require.Equal(t, "Money", ex.mapScalar(user.Fields[1], gen.GT))
+	require.Equal(t, "Boolean", ex.mapScalar(user.Fields[1], gen.IsNil))
+	require.Equal(t, "String", ex.mapScalar(user.Fields[2], gen.EQ), "types without ValueScanner are not mapped by default")
+	require.Equal(t, "IPAddress", ex.mapScalar(user.Fields[3], gen.EQ))
+
+	defs, owners, err := ex.schemaDefs([]*gen.Type{user})
+	require.NoError(t, err)
+	require.Equal(t, "scalar Money", printer.Print(defs["Money"]))
+	require.Equal(t, user, owners["Money"])
+	require.Contains(t, printer.Print(defs["UserWhereInput"]), "balanceGT: Money")
+
+	user.Fields[0].Annotations = map[string]interface{}{
+		annotationName: map[string]interface{}{"Scalar": "Name"},
+	}
+	_, err = ex.goScalars([]*gen.Type{user})
+	require.EqualError(t, err, "entgql: scalar field name must have a custom Go type")
+
+	user.Fields[0] = &gen.Field{
+		Name: "savings",
+		Type: field.Bytes("savings").GoType(net.IP{}).Descriptor().Info,
+		Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{"Scalar": "Money"},
+		},
+	}
+	_, err = ex.goScalars([]*gen.Type{user})
+	require.EqualError(t, err, "entgql: scalar Money is used for both net.IP and entgql.Money")
+
+	user.Fields[0] = &gen.Field{
+		Name: "month",
+		Type: field.Int("month").GoType(time.Month(0)).Descriptor().Info,
+		Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{"Scalar": "Month"},
+		},
+	}
+	_, err = ex.goScalars([]*gen.Type{user})
+	require.EqualError(t, err, "entgql: Go type time.Month of scalar field month must implement encoding.TextMarshaler and encoding.TextUnmarshaler, or field.ValueScanner")
+}
+
+func TestWriteSchemaDir(t *testing.T) {
+	dir := t.TempDir()
+	err := ioutil.WriteFile(filepath.Join(dir, "user.graphql"), []byte("type User { id: ID! }\n"), 0644)
+	require.NoError(t, err)
+	err = ioutil.WriteFile(filepath.Join(dir, "group.graphql"), []byte(generatedHeader+"input GroupWhereInput { id: ID }\n"), 0644)
+	require.NoError(t, err)
+
+	ex, err := NewExtension(WithWhereFilters(true), WithSchemaDir(dir))
+	require.NoError(t, err)
+	todo := &gen.Type{
+		Name: "Todo",
+		ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+		Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{"QueryField": "todos"},
+		},
+	}
+	defs, owners, err := ex.schemaDefs([]*gen.Type{todo})
+	require.NoError(t, err)
+	require.NoError(t, ex.writeSchemaDir(defs, owners))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.graphql"))
+	require.NoError(t, err)
+	for i := range files {
+		files[i] = filepath.Base(files[i])
+	}
+	require.Equal(t, []string{"query.graphql", "todo.graphql", "user.graphql"}, files, "stale generated files should be removed")
+	buf, err := ioutil.ReadFile(filepath.Join(dir, "todo.graphql"))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(buf), generatedHeader))
+	require.Contains(t, string(buf), "input TodoWhereInput {")
+	buf, err = ioutil.ReadFile(filepath.Join(dir, "query.graphql"))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), "extend type Query {")
+	buf, err = ioutil.ReadFile(filepath.Join(dir, "user.graphql"))
+	require.NoError(t, err)
+	require.Equal(t, "type User { id: ID! }\n", string(buf), "user files should not be changed")
+
+	err = ioutil.WriteFile(filepath.Join(dir, "query.graphql"), []byte("type Query { users: [User] }\n"), 0644)
+	require.NoError(t, err)
+	err = ex.writeSchemaDir(map[string]ast.Node{"Query": defs["Query"]}, nil)
+	require.Error(t, err, "user files should not be overridden")
+
+	_, err = NewExtension(WithSchemaDir(dir), WithSchemaPath(filepath.Join(dir, "ent.graphql")))
+	require.EqualError(t, err, "entgql: WithSchemaPath and WithSchemaDir are mutually exclusive")
+}
+
+func TestIntrospection(t *testing.T) {
+	dir := t.TempDir()
+	err := ioutil.WriteFile(filepath.Join(dir, "gqlgen.yml"), []byte("schema:\n  - '*.graphql'\n"), 0644)
+	require.NoError(t, err)
+	err = ioutil.WriteFile(filepath.Join(dir, "schema.graphql"), []byte(`
+interface Node { id: ID! }
+"A todo item."
+type Todo implements Node {
+  id: ID!
+  text: String! @deprecated(reason: "use body")
+  children(first: Int = 10): [Todo!]
+}
+enum Status { OPEN CLOSED }
+type Query { node(id: ID!): Node }
+`), 0644)
+	require.NoError(t, err)
+
+	_, err = NewExtension(WithIntrospectionPath(filepath.Join(dir, "schema.json")))
+	require.EqualError(t, err, "entgql: WithIntrospectionPath requires WithConfigPath")
+	ex, err := NewExtension(
+		WithConfigPath(filepath.Join(dir, "gqlgen.yml")),
+		WithIntrospectionPath(filepath.Join(dir, "schema.json")),
+	)
+	require.NoError(t, err)
+	require.NoError(t, ex.genIntrospection())
+
+	buf, err := ioutil.ReadFile(filepath.Join(dir, "schema.json"))
+	require.NoError(t, err)
+	var result introspectionResult
+	require.NoError(t, json.Unmarshal(buf, &result))
+	require.Equal(t, "Query", result.Schema.QueryType.Name)
+	require.Nil(t, result.Schema.MutationType)
+	types := make(map[string]*fullType)
+	for _, typ := range result.Schema.Types {
+		types[*typ.Name] = typ
+	}
+	todo := types["Todo"]
+	require.NotNil(t, todo)
+	require.Equal(t, "OBJECT", todo.Kind)
+	require.Equal(t, "A todo item.", *todo.Description)
+	require.Equal(t, "Node", *todo.Interfaces[0].Name)
+	require.Len(t, todo.Fields, 3)
+	require.True(t, todo.Fields[1].IsDeprecated)
+	require.Equal(t, "use body", *todo.Fields[1].DeprecationReason)
+	children := todo.Fields[2]
+	require.Equal(t, "10", *children.Args[0].DefaultValue)
+	require.Equal(t, "LIST", children.Type.Kind)
+	require.Equal(t, "NON_NULL", children.Type.OfType.Kind)
+	require.Equal(t, "Todo", *children.Type.OfType.OfType.Name)
+	require.Equal(t, "Todo", *types["Node"].PossibleTypes[0].Name)
+	require.Len(t, types["Status"].EnumValues, 2)
+	require.NotEmpty(t, result.Schema.Directives)
+}
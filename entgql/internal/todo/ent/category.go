@@ -41,6 +41,8 @@ type Category struct {
 	Duration time.Duration `json:"duration,omitempty"`
 	// Count holds the value of the "count" field.
 	Count uint64 `json:"count,omitempty"`
+	// Budget holds the value of the "budget" field.
+	Budget schematype.Amount `json:"budget,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CategoryQuery when eager-loading is set.
 	Edges CategoryEdges `json:"edges"`
@@ -69,6 +71,8 @@ func (*Category) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case category.FieldBudget:
+			values[i] = new(schematype.Amount)
 		case category.FieldConfig:
 			values[i] = new(schematype.CategoryConfig)
 		case category.FieldID, category.FieldDuration, category.FieldCount:
@@ -126,6 +130,12 @@ func (c *Category) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				c.Count = uint64(value.Int64)
 			}
+		case category.FieldBudget:
+			if value, ok := values[i].(*schematype.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field budget", values[i])
+			} else if value != nil {
+				c.Budget = *value
+			}
 		}
 	}
 	return nil
@@ -169,6 +179,8 @@ func (c *Category) String() string {
 	builder.WriteString(fmt.Sprintf("%v", c.Duration))
 	builder.WriteString(", count=")
 	builder.WriteString(fmt.Sprintf("%v", c.Count))
+	builder.WriteString(", budget=")
+	builder.WriteString(fmt.Sprintf("%v", c.Budget))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -82,6 +82,20 @@ func (cc *CategoryCreate) SetNillableCount(u *uint64) *CategoryCreate {
 	return cc
 }
 
+// SetBudget sets the "budget" field.
+func (cc *CategoryCreate) SetBudget(s schematype.Amount) *CategoryCreate {
+	cc.mutation.SetBudget(s)
+	return cc
+}
+
+// SetNillableBudget sets the "budget" field if the given value is not nil.
+func (cc *CategoryCreate) SetNillableBudget(s *schematype.Amount) *CategoryCreate {
+	if s != nil {
+		cc.SetBudget(*s)
+	}
+	return cc
+}
+
 // AddTodoIDs adds the "todos" edge to the Todo entity by IDs.
 func (cc *CategoryCreate) AddTodoIDs(ids ...int) *CategoryCreate {
 	cc.mutation.AddTodoIDs(ids...)
@@ -250,6 +264,14 @@ func (cc *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		})
 		_node.Count = value
 	}
+	if value, ok := cc.mutation.Budget(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: category.FieldBudget,
+		})
+		_node.Budget = value
+	}
 	if nodes := cc.mutation.TodosIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
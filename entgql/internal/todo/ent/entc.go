@@ -17,8 +17,10 @@ import (
 func main() {
 	ex, err := entgql.NewExtension(
 		entgql.WithWhereFilters(true),
+		entgql.WithConstraints(),
 		entgql.WithSchemaPath("../ent.graphql"),
 		entgql.WithConfigPath("../gqlgen.yml"),
+		entgql.WithFixtures(),
 	)
 	if err != nil {
 		log.Fatalf("creating entgql extension: %v", err)
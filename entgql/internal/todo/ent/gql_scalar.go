@@ -0,0 +1,47 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"entgo.io/contrib/entgql/internal/todo/ent/schema/schematype"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Amount is the Go type of the Amount GraphQL scalar. The gqlgen autobind option
+// binds the scalar to it, and to the MarshalAmount and UnmarshalAmount functions.
+type Amount = schematype.Amount
+
+// MarshalAmount marshals Amount values using their MarshalText method. Like the
+// gqlgen marshalers, it panics on failure, and gqlgen recovers it as a field error.
+func MarshalAmount(v schematype.Amount) graphql.Marshaler {
+	text, err := v.MarshalText()
+	if err != nil {
+		panic(err)
+	}
+	return graphql.MarshalString(string(text))
+}
+
+// UnmarshalAmount unmarshals Amount values using their UnmarshalText method.
+func UnmarshalAmount(v interface{}) (schematype.Amount, error) {
+	var t schematype.Amount
+	s, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return t, err
+	}
+	err = t.UnmarshalText([]byte(s))
+	return t, err
+}
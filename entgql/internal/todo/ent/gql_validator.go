@@ -0,0 +1,70 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todo/ent/category"
+	"entgo.io/contrib/entgql/internal/todo/ent/todo"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// InputValidators holds the ent validators of the generated input fields, keyed by
+// the input type name and the field name. It is used by the entgql.Validator extension
+// for validating arguments before their resolvers are executed.
+//
+//	srv.Use(&entgql.Validator{Funcs: ent.InputValidators})
+var InputValidators = map[string]map[string]entgql.ValidateFunc{
+	"CategoryWhereInput": {
+		"text":   validateCategoryText,
+		"textIn": validateCategoryText,
+	},
+	"CreateCategoryInput": {
+		"text": validateCategoryText,
+	},
+	"UpdateCategoryInput": {
+		"text": validateCategoryText,
+	},
+	"TodoWhereInput": {
+		"text":   validateTodoText,
+		"textIn": validateTodoText,
+	},
+	"CreateTodoInput": {
+		"text": validateTodoText,
+	},
+	"UpdateTodoInput": {
+		"text": validateTodoText,
+	},
+}
+
+// validateCategoryText validates raw input values of the "text" field of Category.
+func validateCategoryText(v interface{}) error {
+	s, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return err
+	}
+	return category.TextValidator(s)
+}
+
+// validateTodoText validates raw input values of the "text" field of Todo.
+func validateTodoText(v interface{}) error {
+	s, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return err
+	}
+	return todo.TextValidator(s)
+}
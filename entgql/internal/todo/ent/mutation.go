@@ -58,6 +58,8 @@ type CategoryMutation struct {
 	addduration   *time.Duration
 	count         *uint64
 	addcount      *uint64
+	budget        *schematype.Amount
+	addbudget     *schematype.Amount
 	clearedFields map[string]struct{}
 	todos         map[int]struct{}
 	removedtodos  map[int]struct{}
@@ -407,6 +409,76 @@ func (m *CategoryMutation) ResetCount() {
 	delete(m.clearedFields, category.FieldCount)
 }
 
+// SetBudget sets the "budget" field.
+func (m *CategoryMutation) SetBudget(s schematype.Amount) {
+	m.budget = &s
+	m.addbudget = nil
+}
+
+// Budget returns the value of the "budget" field in the mutation.
+func (m *CategoryMutation) Budget() (r schematype.Amount, exists bool) {
+	v := m.budget
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBudget returns the old "budget" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldBudget(ctx context.Context) (v schematype.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldBudget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldBudget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBudget: %w", err)
+	}
+	return oldValue.Budget, nil
+}
+
+// AddBudget adds s to the "budget" field.
+func (m *CategoryMutation) AddBudget(s schematype.Amount) {
+	if m.addbudget != nil {
+		*m.addbudget += s
+	} else {
+		m.addbudget = &s
+	}
+}
+
+// AddedBudget returns the value that was added to the "budget" field in this mutation.
+func (m *CategoryMutation) AddedBudget() (r schematype.Amount, exists bool) {
+	v := m.addbudget
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBudget clears the value of the "budget" field.
+func (m *CategoryMutation) ClearBudget() {
+	m.budget = nil
+	m.addbudget = nil
+	m.clearedFields[category.FieldBudget] = struct{}{}
+}
+
+// BudgetCleared returns if the "budget" field was cleared in this mutation.
+func (m *CategoryMutation) BudgetCleared() bool {
+	_, ok := m.clearedFields[category.FieldBudget]
+	return ok
+}
+
+// ResetBudget resets all changes to the "budget" field.
+func (m *CategoryMutation) ResetBudget() {
+	m.budget = nil
+	m.addbudget = nil
+	delete(m.clearedFields, category.FieldBudget)
+}
+
 // AddTodoIDs adds the "todos" edge to the Todo entity by ids.
 func (m *CategoryMutation) AddTodoIDs(ids ...int) {
 	if m.todos == nil {
@@ -480,7 +552,7 @@ func (m *CategoryMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CategoryMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 6)
 	if m.text != nil {
 		fields = append(fields, category.FieldText)
 	}
@@ -496,6 +568,9 @@ func (m *CategoryMutation) Fields() []string {
 	if m.count != nil {
 		fields = append(fields, category.FieldCount)
 	}
+	if m.budget != nil {
+		fields = append(fields, category.FieldBudget)
+	}
 	return fields
 }
 
@@ -514,6 +589,8 @@ func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
 		return m.Duration()
 	case category.FieldCount:
 		return m.Count()
+	case category.FieldBudget:
+		return m.Budget()
 	}
 	return nil, false
 }
@@ -533,6 +610,8 @@ func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldDuration(ctx)
 	case category.FieldCount:
 		return m.OldCount(ctx)
+	case category.FieldBudget:
+		return m.OldBudget(ctx)
 	}
 	return nil, fmt.Errorf("unknown Category field %s", name)
 }
@@ -577,6 +656,13 @@ func (m *CategoryMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCount(v)
 		return nil
+	case category.FieldBudget:
+		v, ok := value.(schematype.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBudget(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Category field %s", name)
 }
@@ -591,6 +677,9 @@ func (m *CategoryMutation) AddedFields() []string {
 	if m.addcount != nil {
 		fields = append(fields, category.FieldCount)
 	}
+	if m.addbudget != nil {
+		fields = append(fields, category.FieldBudget)
+	}
 	return fields
 }
 
@@ -603,6 +692,8 @@ func (m *CategoryMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedDuration()
 	case category.FieldCount:
 		return m.AddedCount()
+	case category.FieldBudget:
+		return m.AddedBudget()
 	}
 	return nil, false
 }
@@ -626,6 +717,13 @@ func (m *CategoryMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddCount(v)
 		return nil
+	case category.FieldBudget:
+		v, ok := value.(schematype.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBudget(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Category numeric field %s", name)
 }
@@ -643,6 +741,9 @@ func (m *CategoryMutation) ClearedFields() []string {
 	if m.FieldCleared(category.FieldCount) {
 		fields = append(fields, category.FieldCount)
 	}
+	if m.FieldCleared(category.FieldBudget) {
+		fields = append(fields, category.FieldBudget)
+	}
 	return fields
 }
 
@@ -666,6 +767,9 @@ func (m *CategoryMutation) ClearField(name string) error {
 	case category.FieldCount:
 		m.ClearCount()
 		return nil
+	case category.FieldBudget:
+		m.ClearBudget()
+		return nil
 	}
 	return fmt.Errorf("unknown Category nullable field %s", name)
 }
@@ -689,6 +793,9 @@ func (m *CategoryMutation) ResetField(name string) error {
 	case category.FieldCount:
 		m.ResetCount()
 		return nil
+	case category.FieldBudget:
+		m.ResetBudget()
+		return nil
 	}
 	return fmt.Errorf("unknown Category field %s", name)
 }
@@ -789,6 +896,7 @@ type TodoMutation struct {
 	addpriority     *int
 	text            *string
 	blob            *[]byte
+	deleted_at      *time.Time
 	clearedFields   map[string]struct{}
 	parent          *int
 	clearedparent   bool
@@ -1096,6 +1204,55 @@ func (m *TodoMutation) ResetBlob() {
 	delete(m.clearedFields, todo.FieldBlob)
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (m *TodoMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *TodoMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Todo entity.
+// If the Todo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TodoMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *TodoMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[todo.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *TodoMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[todo.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *TodoMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, todo.FieldDeletedAt)
+}
+
 // SetParentID sets the "parent" edge to the Todo entity by id.
 func (m *TodoMutation) SetParentID(id int) {
 	m.parent = &id
@@ -1286,7 +1443,7 @@ func (m *TodoMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *TodoMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 6)
 	if m.created_at != nil {
 		fields = append(fields, todo.FieldCreatedAt)
 	}
@@ -1302,6 +1459,9 @@ func (m *TodoMutation) Fields() []string {
 	if m.blob != nil {
 		fields = append(fields, todo.FieldBlob)
 	}
+	if m.deleted_at != nil {
+		fields = append(fields, todo.FieldDeletedAt)
+	}
 	return fields
 }
 
@@ -1320,6 +1480,8 @@ func (m *TodoMutation) Field(name string) (ent.Value, bool) {
 		return m.Text()
 	case todo.FieldBlob:
 		return m.Blob()
+	case todo.FieldDeletedAt:
+		return m.DeletedAt()
 	}
 	return nil, false
 }
@@ -1339,6 +1501,8 @@ func (m *TodoMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldText(ctx)
 	case todo.FieldBlob:
 		return m.OldBlob(ctx)
+	case todo.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown Todo field %s", name)
 }
@@ -1383,6 +1547,13 @@ func (m *TodoMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetBlob(v)
 		return nil
+	case todo.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Todo field %s", name)
 }
@@ -1431,6 +1602,9 @@ func (m *TodoMutation) ClearedFields() []string {
 	if m.FieldCleared(todo.FieldBlob) {
 		fields = append(fields, todo.FieldBlob)
 	}
+	if m.FieldCleared(todo.FieldDeletedAt) {
+		fields = append(fields, todo.FieldDeletedAt)
+	}
 	return fields
 }
 
@@ -1448,6 +1622,9 @@ func (m *TodoMutation) ClearField(name string) error {
 	case todo.FieldBlob:
 		m.ClearBlob()
 		return nil
+	case todo.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown Todo nullable field %s", name)
 }
@@ -1471,6 +1648,9 @@ func (m *TodoMutation) ResetField(name string) error {
 	case todo.FieldBlob:
 		m.ResetBlob()
 		return nil
+	case todo.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown Todo field %s", name)
 }
@@ -20,6 +20,7 @@ import (
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 
@@ -61,6 +62,11 @@ func (Category) Fields() []ent.Field {
 			Annotations(
 				entgql.Type("Uint64"),
 			),
+		// Amount implements the field.ValueScanner interface, and therefore,
+		// the extension maps it to the generated Amount scalar.
+		field.Int64("budget").
+			GoType(schematype.Amount(0)).
+			Optional(),
 	}
 }
 
@@ -70,3 +76,10 @@ func (Category) Edges() []ent.Edge {
 		edge.To("todos", Todo.Type),
 	}
 }
+
+// Annotations of the Category.
+func (Category) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.MutationInputs(),
+	}
+}
@@ -18,6 +18,9 @@ package schematype
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
 )
 
 // CategoryConfig implements the field.ValueScanner interface.
@@ -38,3 +41,51 @@ func (t *CategoryConfig) Scan(v interface{}) (err error) {
 func (t *CategoryConfig) Value() (driver.Value, error) {
 	return json.Marshal(t)
 }
+
+// Amount is a monetary amount in cents. It implements the field.ValueScanner
+// interface, and it is represented as a decimal string (e.g. "12.50") in GraphQL.
+type Amount int64
+
+func (a *Amount) Scan(v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		*a = 0
+	case int64:
+		*a = Amount(v)
+	default:
+		return fmt.Errorf("unexpected amount type %T", v)
+	}
+	return nil
+}
+
+func (a Amount) Value() (driver.Value, error) {
+	return int64(a), nil
+}
+
+func (a Amount) MarshalText() ([]byte, error) {
+	sign, v := "", int64(a)
+	if v < 0 {
+		sign, v = "-", -v
+	}
+	return []byte(fmt.Sprintf("%s%d.%02d", sign, v/100, v%100)), nil
+}
+
+func (a *Amount) UnmarshalText(text []byte) error {
+	s := string(text)
+	units, cents := s, "00"
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		units, cents = s[:i], s[i+1:]+"0"
+	}
+	if len(cents) < 2 || len(cents) > 3 {
+		return fmt.Errorf("invalid amount %q", s)
+	}
+	v, err := strconv.ParseUint(strings.TrimPrefix(units, "-")+cents[:2], 10, 63)
+	if err != nil {
+		return fmt.Errorf("invalid amount %q", s)
+	}
+	*a = Amount(v)
+	if strings.HasPrefix(units, "-") {
+		*a = -*a
+	}
+	return nil
+}
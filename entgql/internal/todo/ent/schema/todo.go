@@ -19,6 +19,7 @@ import (
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -57,6 +58,11 @@ func (Todo) Fields() []ent.Field {
 			),
 		field.Bytes("blob").
 			Optional(),
+		field.Time("deleted_at").
+			Optional().
+			Annotations(
+				entgql.SoftDelete(entgql.AllowIncludeDeleted()),
+			),
 	}
 }
 
@@ -75,3 +81,12 @@ func (Todo) Edges() []ent.Edge {
 			Unique(),
 	}
 }
+
+// Annotations returns todo annotations.
+func (Todo) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField("todos"),
+		entgql.RelayMutations(entgql.RelayCreate),
+		entgql.MutationInputs(),
+	}
+}
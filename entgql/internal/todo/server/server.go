@@ -16,11 +16,14 @@ package main
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo"
 	"entgo.io/contrib/entgql/internal/todo/ent"
 	"entgo.io/contrib/entgql/internal/todo/ent/migrate"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/debug"
 	"github.com/99designs/gqlgen/graphql/playground"
@@ -39,13 +42,19 @@ func main() {
 	kong.Parse(&cli)
 
 	log, _ := zap.NewDevelopment()
-	client, err := ent.Open(
-		"sqlite3",
+	tr := &entgql.Tracing{
+		Logger:        log,
+		SlowOperation: time.Second,
+		SlowQuery:     100 * time.Millisecond,
+	}
+	drv, err := sql.Open(
+		dialect.SQLite,
 		"file:ent?mode=memory&cache=shared&_fk=1",
 	)
 	if err != nil {
 		log.Fatal("opening ent client", zap.Error(err))
 	}
+	client := ent.NewClient(ent.Driver(tr.Driver(drv)))
 	if err := client.Schema.Create(
 		context.Background(),
 		migrate.WithGlobalUniqueID(true),
@@ -55,6 +64,10 @@ func main() {
 
 	srv := handler.NewDefaultServer(todo.NewSchema(client))
 	srv.Use(entgql.Transactioner{TxOpener: client})
+	srv.Use(&entgql.Validator{Funcs: ent.InputValidators})
+	srv.Use(entgql.NodeCacher{})
+	srv.Use(entgql.PrivacyErrors{})
+	srv.Use(tr)
 	if cli.Debug {
 		srv.Use(&debug.Tracer{})
 	}
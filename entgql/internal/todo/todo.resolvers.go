@@ -34,6 +34,34 @@ func (r *mutationResolver) CreateTodo(ctx context.Context, todo TodoInput) (*ent
 		Save(ctx)
 }
 
+func (r *mutationResolver) AddTodo(ctx context.Context, input ent.CreateTodoInput) (*ent.CreateTodoPayload, error) {
+	c := ent.FromContext(ctx).Todo.Create()
+	if err := input.Mutate(ctx, c.Mutation()); err != nil {
+		return nil, err
+	}
+	t, err := c.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return input.Payload(t, nil), nil
+}
+
+func (r *mutationResolver) UpdateTodo(ctx context.Context, id int, input ent.UpdateTodoInput) (*ent.Todo, error) {
+	u := ent.FromContext(ctx).Todo.UpdateOneID(id)
+	if err := input.Mutate(ctx, u.Mutation()); err != nil {
+		return nil, err
+	}
+	return u.Save(ctx)
+}
+
+func (r *mutationResolver) CreateCategory(ctx context.Context, input ent.CreateCategoryInput) (*ent.Category, error) {
+	c := ent.FromContext(ctx).Category.Create()
+	if err := input.Mutate(ctx, c.Mutation()); err != nil {
+		return nil, err
+	}
+	return c.Save(ctx)
+}
+
 func (r *mutationResolver) ClearTodos(ctx context.Context) (int, error) {
 	client := ent.FromContext(ctx)
 	return client.Todo.
@@ -49,14 +77,6 @@ func (r *queryResolver) Nodes(ctx context.Context, ids []int) ([]ent.Noder, erro
 	return r.client.Noders(ctx, ids)
 }
 
-func (r *queryResolver) Todos(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoConnection, error) {
-	return r.client.Todo.Query().
-		Paginate(ctx, after, first, before, last,
-			ent.WithTodoOrder(orderBy),
-			ent.WithTodoFilter(where.Filter),
-		)
-}
-
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
@@ -19,23 +19,33 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/entgqltest"
 	gen "entgo.io/contrib/entgql/internal/todo"
 	"entgo.io/contrib/entgql/internal/todo/ent"
 	"entgo.io/contrib/entgql/internal/todo/ent/category"
-	"entgo.io/contrib/entgql/internal/todo/ent/enttest"
+	"entgo.io/contrib/entgql/internal/todo/ent/fixture"
+	"entgo.io/contrib/entgql/internal/todo/ent/hook"
 	"entgo.io/contrib/entgql/internal/todo/ent/migrate"
 	"entgo.io/contrib/entgql/internal/todo/ent/todo"
 	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/privacy"
 	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/AlekSi/pointer"
 	"github.com/stretchr/testify/suite"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -45,7 +55,7 @@ import (
 
 type todoTestSuite struct {
 	suite.Suite
-	*client.Client
+	*entgqltest.Server
 	ent *ent.Client
 }
 
@@ -73,17 +83,15 @@ const (
 )
 
 func (s *todoTestSuite) SetupTest() {
-	s.ent = enttest.Open(s.T(), dialect.SQLite,
-		fmt.Sprintf("file:%s-%d?mode=memory&cache=shared&_fk=1",
-			s.T().Name(), time.Now().UnixNano(),
-		),
-		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	s.ent = ent.NewClient(ent.Driver(entgqltest.Open(s.T())))
+	err := s.ent.Schema.Create(context.Background(), migrate.WithGlobalUniqueID(true))
+	s.Require().NoError(err)
+	s.Server = entgqltest.NewServer(gen.NewSchema(s.ent), s.ent,
+		&entgql.Validator{Funcs: ent.InputValidators},
+		entgql.NodeCacher{},
+		entgql.PrivacyErrors{},
 	)
 
-	srv := handler.NewDefaultServer(gen.NewSchema(s.ent))
-	srv.Use(entgql.Transactioner{TxOpener: s.ent})
-	s.Client = client.New(srv)
-
 	const mutation = `mutation($priority: Int, $text: String!, $parent: ID) {
 		createTodo(todo: {status: COMPLETED, priority: $priority, text: $text, parent: $parent}) {
 			id
@@ -617,6 +625,39 @@ func (s *todoTestSuite) TestPaginationFiltering() {
 	})
 }
 
+func (s *todoTestSuite) TestValidation() {
+	const query = `query($text: String, $texts: [String!]) {
+		todos(where: {text: $text, or: [{textIn: $texts}]}) {
+			totalCount
+		}
+	}`
+	var rsp response
+	err := s.Post(query, &rsp, client.Var("text", "1"), client.Var("texts", []string{"1", "2"}))
+	s.Require().NoError(err)
+
+	// Predicates that do not match stored values accept any value.
+	err = s.Post(`query {
+		todos(where: {textNEQ: "", textNotIn: [""]}) {
+			totalCount
+		}
+	}`, &rsp)
+	s.Require().NoError(err)
+
+	err = s.Post(query, &rsp, client.Var("text", ""), client.Var("texts", []string{"1", ""}))
+	var jerr client.RawJsonError
+	s.Require().True(errors.As(err, &jerr))
+	var errs gqlerror.List
+	err = json.Unmarshal(jerr.RawMessage, &errs)
+	s.Require().NoError(err)
+	s.Require().Len(errs, 2)
+	for i, arg := range []string{"where.or[0].textIn[1]", "where.text"} {
+		s.Require().Equal(fmt.Sprintf("invalid value for argument %q: value is less than the required length 1", arg), errs[i].Message)
+		s.Require().Equal("todos", errs[i].Path.String())
+		s.Require().Equal(entgql.ErrBadUserInput, errs[i].Extensions["code"])
+		s.Require().Equal(arg, errs[i].Extensions["argument"])
+	}
+}
+
 func (s *todoTestSuite) TestNode() {
 	const (
 		query = `query($id: ID!) {
@@ -879,3 +920,542 @@ func (s *todoTestSuite) TestMutationFieldCollection() {
 	s.Require().Equal(strconv.Itoa(idOffset+1), rsp.CreateTodo.Parent.ID)
 	s.Require().Equal(strconv.Itoa(idOffset+1), rsp.CreateTodo.Parent.Text)
 }
+
+func (s *todoTestSuite) TestRelayMutation() {
+	var rsp struct {
+		AddTodo struct {
+			ClientMutationID string
+			TodoEdge         struct {
+				Cursor string
+				Node   struct {
+					ID   string
+					Text string
+				}
+			}
+		}
+	}
+	err := s.Post(`mutation($input: CreateTodoInput!) {
+		addTodo(input: $input) {
+			clientMutationId
+			todoEdge {
+				cursor
+				node {
+					id
+					text
+				}
+			}
+		}
+	}`, &rsp, client.Var("input", map[string]interface{}{
+		"clientMutationId": "mutation-1",
+		"status":           "IN_PROGRESS",
+		"text":             "relay",
+	}))
+	s.Require().NoError(err)
+	s.Require().Equal("mutation-1", rsp.AddTodo.ClientMutationID)
+	s.Require().Equal("relay", rsp.AddTodo.TodoEdge.Node.Text)
+
+	// The cursor of the payload edge matches the one used in pagination.
+	var conn response
+	err = s.Post(`query {
+		todos(last: 1) {
+			edges {
+				cursor
+				node {
+					id
+				}
+			}
+		}
+	}`, &conn)
+	s.Require().NoError(err)
+	s.Require().Len(conn.Todos.Edges, 1)
+	s.Require().Equal(rsp.AddTodo.TodoEdge.Node.ID, conn.Todos.Edges[0].Node.ID)
+	s.Require().Equal(rsp.AddTodo.TodoEdge.Cursor, conn.Todos.Edges[0].Cursor)
+}
+
+func (s *todoTestSuite) TestPrivacyErrors() {
+	s.ent.Todo.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.TodoFunc(func(ctx context.Context, m *ent.TodoMutation) (ent.Value, error) {
+			if text, _ := m.Text(); text == "denied" {
+				return nil, fmt.Errorf("creating denied todo: %w", privacy.Deny)
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	var rsp struct {
+		CreateTodo struct {
+			Text string
+		}
+	}
+	err := s.Post(`mutation { createTodo(todo: { text: "allowed" }) { text } }`, &rsp)
+	s.Require().NoError(err)
+	s.Require().Equal("allowed", rsp.CreateTodo.Text)
+
+	err = s.Post(`mutation { createTodo(todo: { text: "denied" }) { text } }`, &rsp)
+	s.Require().Error(err)
+	var errs []*gqlerror.Error
+	s.Require().NoError(json.Unmarshal([]byte(err.Error()), &errs))
+	s.Require().Len(errs, 1)
+	s.Require().Equal("FORBIDDEN", errs[0].Extensions["code"])
+	s.Require().Equal("createTodo", errs[0].Path.String())
+}
+
+func (s *todoTestSuite) TestMutationInputs() {
+	s.ent.Todo.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.TodoFunc(func(ctx context.Context, m *ent.TodoMutation) (ent.Value, error) {
+			if text, _ := m.Text(); text == "denied" {
+				return nil, fmt.Errorf("creating denied todo: %w", privacy.Deny)
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	var (
+		ids = make([]string, 3)
+		rsp struct {
+			CreateTodo struct {
+				ID string
+			}
+		}
+	)
+	for i := range ids {
+		err := s.Post(`mutation($text: String!) { createTodo(todo: { text: $text }) { id } }`, &rsp, client.Var("text", strconv.Itoa(i)))
+		s.Require().NoError(err)
+		ids[i] = rsp.CreateTodo.ID
+	}
+	const mutation = `mutation($id: ID!, $input: UpdateTodoInput!) {
+		updateTodo(id: $id, input: $input) {
+			text
+			children {
+				text
+			}
+		}
+	}`
+	update := func(input map[string]interface{}) ([]string, error) {
+		var rsp struct {
+			UpdateTodo struct {
+				Text     string
+				Children []struct {
+					Text string
+				}
+			}
+		}
+		if err := s.Post(mutation, &rsp, client.Var("id", ids[0]), client.Var("input", input)); err != nil {
+			return nil, err
+		}
+		texts := make([]string, 0, len(rsp.UpdateTodo.Children))
+		for _, c := range rsp.UpdateTodo.Children {
+			texts = append(texts, c.Text)
+		}
+		sort.Strings(texts)
+		return texts, nil
+	}
+
+	children, err := update(map[string]interface{}{"addChildIDs": ids[1:]})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"1", "2"}, children)
+
+	children, err = update(map[string]interface{}{
+		"removeChildIDs": ids[1:2],
+		"createChildren": []interface{}{
+			map[string]interface{}{"text": "3", "status": todo.StatusInProgress},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"2", "3"}, children)
+
+	// Nested inputs are created in the transaction of the mutation,
+	// and a failure rolls back the whole mutation.
+	_, err = update(map[string]interface{}{
+		"text":          "updated",
+		"clearChildren": true,
+		"createChildren": []interface{}{
+			map[string]interface{}{"text": "denied", "status": todo.StatusInProgress},
+		},
+	})
+	s.Require().Error(err)
+	children, err = update(map[string]interface{}{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"2", "3"}, children)
+
+	children, err = update(map[string]interface{}{"clearChildren": true})
+	s.Require().NoError(err)
+	s.Require().Empty(children)
+
+	var cat struct {
+		CreateCategory struct {
+			ID   string
+			Text string
+		}
+	}
+	err = s.Post(`mutation($todo: ID!) {
+		createCategory(input: { text: "work", status: ENABLED, todoIDs: [$todo], createTodos: [{ text: "4", status: COMPLETED }] }) {
+			id
+			text
+		}
+	}`, &cat, client.Var("todo", ids[0]))
+	s.Require().NoError(err)
+	s.Require().Equal("work", cat.CreateCategory.Text)
+	id, err := strconv.Atoi(cat.CreateCategory.ID)
+	s.Require().NoError(err)
+	n, err := s.ent.Category.Query().Where(category.ID(id)).QueryTodos().Count(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal(2, n)
+}
+
+func (s *todoTestSuite) TestGoTypeScalar() {
+	var cat struct {
+		CreateCategory struct {
+			Budget string
+		}
+	}
+	for _, budget := range []string{"12.5", "3.05"} {
+		err := s.Post(`mutation($budget: Amount) {
+			createCategory(input: { text: "work", status: ENABLED, budget: $budget, createTodos: [{ text: "todo", status: COMPLETED }] }) {
+				budget
+			}
+		}`, &cat, client.Var("budget", budget))
+		s.Require().NoError(err)
+	}
+	s.Require().Equal("3.05", cat.CreateCategory.Budget)
+	n, err := s.ent.Category.Query().Where(category.Budget(305)).Count(context.Background())
+	s.Require().NoError(err)
+	s.Require().Equal(1, n)
+
+	var rsp struct {
+		Todos struct {
+			TotalCount int
+			Edges      []struct {
+				Node struct {
+					Category struct {
+						Budget string
+					}
+				}
+			}
+		}
+	}
+	err = s.Post(`query {
+		todos(where: { hasCategoryWith: { budgetGT: "10" } }) {
+			totalCount
+			edges { node { category { budget } } }
+		}
+	}`, &rsp)
+	s.Require().NoError(err)
+	s.Require().Equal(1, rsp.Todos.TotalCount)
+	s.Require().Equal("12.50", rsp.Todos.Edges[0].Node.Category.Budget)
+
+	err = s.Post(`query { todos(where: { hasCategoryWith: { budget: "1.234" } }) { totalCount } }`, &rsp)
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), `invalid amount \"1.234\"`)
+}
+
+func (s *todoTestSuite) TestSoftDelete() {
+	deleted := idOffset + 2
+	err := s.ent.Todo.UpdateOneID(deleted).SetDeletedAt(time.Now()).Exec(context.Background())
+	s.Require().NoError(err)
+
+	var rsp struct {
+		Todos struct {
+			TotalCount int
+		}
+	}
+	err = s.Post(`query { todos { totalCount } }`, &rsp)
+	s.Require().NoError(err)
+	s.Require().Equal(maxTodos-1, rsp.Todos.TotalCount)
+	err = s.Post(`query { todos(includeDeleted: true) { totalCount } }`, &rsp)
+	s.Require().NoError(err)
+	s.Require().Equal(maxTodos, rsp.Todos.TotalCount)
+
+	var node struct {
+		Todo *struct {
+			Children []struct {
+				ID string
+			}
+		}
+	}
+	err = s.Post(`query($id: ID!) { todo: node(id: $id) { id } }`, &node, client.Var("id", deleted))
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "NOT_FOUND")
+	err = s.Post(`query($id: ID!) { todo: node(id: $id) { ... on Todo { children { id } } } }`, &node, client.Var("id", idOffset+1))
+	s.Require().NoError(err)
+	s.Require().NotEmpty(node.Todo.Children)
+	for _, c := range node.Todo.Children {
+		s.Require().NotEqual(strconv.Itoa(deleted), c.ID)
+	}
+	n := len(node.Todo.Children)
+	err = s.Post(`query($id: ID!) { todo: node(id: $id) { ... on Todo { children(includeDeleted: true) { id } } } }`, &node, client.Var("id", idOffset+1))
+	s.Require().NoError(err)
+	s.Require().Len(node.Todo.Children, n+1)
+	parent, err := s.ent.Todo.Get(context.Background(), idOffset+1)
+	s.Require().NoError(err)
+	children, err := parent.Children(context.Background(), nil)
+	s.Require().NoError(err)
+	s.Require().Len(children, n)
+	include := true
+	children, err = parent.Children(context.Background(), &include)
+	s.Require().NoError(err)
+	s.Require().Len(children, n+1)
+
+	srv := entgqltest.NewServer(gen.NewSchema(s.ent), s.ent, entgql.IncludeDeleted{
+		Authorize: func(context.Context, *graphql.FieldContext) error {
+			return errors.New("not an admin")
+		},
+	})
+	err = srv.Post(`query { todos { totalCount } }`, &rsp)
+	s.Require().NoError(err)
+	err = srv.Post(`query { todos(includeDeleted: true) { totalCount } }`, &rsp)
+	s.Require().Error(err)
+	var errs []*gqlerror.Error
+	s.Require().NoError(json.Unmarshal([]byte(err.Error()), &errs))
+	s.Require().Len(errs, 1)
+	s.Require().Equal("FORBIDDEN", errs[0].Extensions["code"])
+	s.Require().Equal("todos", errs[0].Path.String())
+	err = srv.Post(`query($id: ID!) { todo: node(id: $id) { ... on Todo { children(includeDeleted: true) { id } } } }`, &node, client.Var("id", idOffset+1))
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "FORBIDDEN")
+}
+
+func (s *todoTestSuite) TestPersistedOperations() {
+	ops, err := entgql.NewPersistedOperations("testdata/operations")
+	s.Require().NoError(err)
+	s.Require().Len(ops.Operations(), 2)
+	s.Require().Equal("node.graphql", ops.Operations()[0].Path)
+	s.Require().Equal("todos.graphql", ops.Operations()[1].Path)
+	query := ops.Operations()[1]
+
+	srv := handler.New(gen.NewSchema(s.ent))
+	srv.AddTransport(transport.POST{})
+	srv.Use(ops)
+	var rsp struct {
+		Todos struct {
+			TotalCount int
+			Edges      []struct {
+				Node struct {
+					ID   string
+					Text string
+				}
+			}
+		}
+	}
+	err = client.New(srv).Post(query.Query, &rsp, client.Var("first", 1))
+	s.Require().NoError(err)
+	s.Require().Equal(maxTodos, rsp.Todos.TotalCount)
+	s.Require().Len(rsp.Todos.Edges, 1)
+
+	err = client.New(srv).Post(`query { todos { totalCount } }`, &rsp)
+	s.Require().Error(err)
+	var errs []*gqlerror.Error
+	s.Require().NoError(json.Unmarshal([]byte(err.Error()), &errs))
+	s.Require().Len(errs, 1)
+	s.Require().Equal("OPERATION_NOT_ALLOWED", errs[0].Extensions["code"])
+
+	body := fmt.Sprintf(`{"variables": {"first": 2}, "extensions": {"persistedQuery": {"version": 1, "sha256Hash": %q}}}`, query.Hash)
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	srv.ServeHTTP(rec, req)
+	var hashed struct {
+		Data json.RawMessage
+	}
+	s.Require().NoError(json.Unmarshal(rec.Body.Bytes(), &hashed))
+	s.Require().NoError(json.Unmarshal(hashed.Data, &rsp))
+	s.Require().Len(rsp.Todos.Edges, 2)
+
+	used := ops.Used()
+	s.Require().Equal([]string{"edges", "totalCount"}, used["TodoConnection"])
+	s.Require().Equal([]string{"id", "parent", "priority", "text"}, used["Todo"])
+	s.Require().Contains(ops.Unused()["Todo"], "children")
+	s.Require().NotContains(ops.Unused()["Todo"], "text")
+
+	dir := s.T().TempDir()
+	s.Require().NoError(ioutil.WriteFile(filepath.Join(dir, "unknown.graphql"), []byte(`query { unknown }`), 0644))
+	ops, err = entgql.NewPersistedOperations(dir)
+	s.Require().NoError(err)
+	s.Require().Error(ops.Validate(gen.NewSchema(s.ent)))
+}
+
+func (s *todoTestSuite) TestNodersOptions() {
+	ctx := context.Background()
+	ids := make([]int, 0, maxTodos+2)
+	for i := 1; i <= maxTodos; i++ {
+		ids = append(ids, idOffset+i)
+	}
+	ids = append(ids, idOffset+1, idOffset+maxTodos)
+	noders, err := s.ent.Noders(ctx, ids, ent.WithNodeBatchSize(5), ent.WithNodeConcurrency(3))
+	s.Require().NoError(err)
+	s.Require().Len(noders, len(ids))
+	for i, nr := range noders {
+		s.Require().IsType(nr, (*ent.Todo)(nil))
+		s.Require().Equal(ids[i], nr.(*ent.Todo).ID)
+	}
+
+	_, err = s.ent.Noders(ctx, ids, ent.WithNodeLimit(maxTodos))
+	var gqlerr *gqlerror.Error
+	s.Require().True(errors.As(err, &gqlerr))
+	s.Require().Equal("TOO_MANY_NODES", gqlerr.Extensions["code"])
+	noders, err = s.ent.Noders(ctx, ids[:maxTodos], ent.WithNodeLimit(maxTodos))
+	s.Require().NoError(err)
+	s.Require().Len(noders, maxTodos)
+}
+
+func (s *todoTestSuite) TestNodeCache() {
+	ctx := entgql.NewNodeCacheContext(context.Background(), entgql.NewNodeCache())
+	td := s.ent.Todo.Create().SetText("text").SetStatus(todo.StatusInProgress).SaveX(ctx)
+	nr, err := s.ent.Noder(ctx, td.ID)
+	s.Require().NoError(err)
+	s.ent.Todo.DeleteOneID(td.ID).ExecX(ctx)
+
+	// Deleted nodes are still served from the cache of the operation.
+	cached, err := s.ent.Noder(ctx, td.ID)
+	s.Require().NoError(err)
+	s.Require().Same(nr, cached)
+	noders, err := s.ent.Noders(ctx, []int{td.ID, idOffset + 1})
+	s.Require().NoError(err)
+	s.Require().Same(nr, noders[0])
+	s.Require().Equal(idOffset+1, noders[1].(*ent.Todo).ID)
+	_, err = s.ent.Noder(context.Background(), td.ID)
+	s.Require().True(ent.IsNotFound(err))
+
+	missing := idOffset + maxTodos + 10
+	_, err = s.ent.Noder(ctx, missing)
+	s.Require().True(ent.IsNotFound(err))
+	_, err = s.ent.Noder(ctx, missing)
+	s.Require().True(ent.IsNotFound(err))
+}
+
+func (s *todoTestSuite) TestNodeCacheSelection() {
+	deleted := idOffset + 2
+	err := s.ent.Todo.UpdateOneID(deleted).SetDeletedAt(time.Now()).Exec(context.Background())
+	s.Require().NoError(err)
+
+	// Nodes are cached per selection, because their edges are eager-loaded.
+	var rsp struct {
+		A, B struct {
+			Children []struct {
+				ID string
+			}
+		}
+	}
+	err = s.Post(`query($id: ID!) {
+		a: node(id: $id) { ... on Todo { children { id } } }
+		b: node(id: $id) { ... on Todo { children(includeDeleted: true) { id } } }
+	}`, &rsp, client.Var("id", idOffset+1))
+	s.Require().NoError(err)
+	s.Require().NotEmpty(rsp.A.Children)
+	s.Require().Len(rsp.B.Children, len(rsp.A.Children)+1)
+}
+
+func (s *todoTestSuite) TestQueriesCount() {
+	const query = `query($first: Int) {
+		todos(first: $first) {
+			edges {
+				node {
+					text
+					parent {
+						text
+					}
+					children {
+						text
+						children {
+							text
+						}
+					}
+				}
+			}
+		}
+	}`
+	var rsp struct {
+		Todos struct {
+			Edges []struct{ Node map[string]interface{} }
+		}
+	}
+	few, err := s.Queries(query, &rsp, client.Var("first", 2))
+	s.Require().NoError(err)
+	s.Require().Len(rsp.Todos.Edges, 2)
+	s.Require().NotZero(few)
+	all, err := s.Queries(query, &rsp, client.Var("first", maxTodos))
+	s.Require().NoError(err)
+	s.Require().Len(rsp.Todos.Edges, maxTodos)
+	s.Require().Equal(few, all, "number of queries should not depend on the number of nodes")
+}
+
+func (s *todoTestSuite) TestFixtureSnapshot() {
+	ctx := context.Background()
+	f := fixture.New(s.ent)
+	parent := f.Todo().SaveX(ctx)
+	f.Todo().SetParent(parent).SetPriority(2).SaveX(ctx)
+	s.MatchSnapshot(s.T(), "node", `query($id: ID!) {
+		node(id: $id) {
+			... on Todo {
+				text
+				status
+				priority
+				children {
+					text
+					priority
+				}
+			}
+		}
+	}`, client.Var("id", parent.ID))
+	s.MatchSnapshot(s.T(), "not-found", `query { node(id: -1) { id } }`)
+}
+
+func (s *todoTestSuite) TestTracing() {
+	ctx := context.Background()
+	drv, err := entsql.Open(dialect.SQLite, fmt.Sprintf("file:%s-%d?mode=memory&cache=shared&_fk=1", s.T().Name(), time.Now().UnixNano()))
+	s.Require().NoError(err)
+	rec := &recorder{}
+	tr := &entgql.Tracing{Tracer: rec}
+	ec := ent.NewClient(ent.Driver(tr.Driver(drv)))
+	defer ec.Close()
+	s.Require().NoError(ec.Schema.Create(ctx, migrate.WithGlobalUniqueID(true)))
+	ec.Todo.Create().SetText("text").SetStatus(todo.StatusInProgress).SaveX(ctx)
+
+	srv := handler.NewDefaultServer(gen.NewSchema(ec))
+	srv.Use(tr)
+	rec.spans = nil
+	var rsp struct{ Todos struct{ TotalCount int } }
+	err = client.New(srv).Post(`query Todos { todos { totalCount } }`, &rsp)
+	s.Require().NoError(err)
+	s.Require().Equal(1, rsp.Todos.TotalCount)
+
+	var queries int
+	for _, span := range rec.spans {
+		if span.name != entgql.SpanQuery {
+			continue
+		}
+		queries++
+		s.Require().NotNil(span.parent)
+		s.Require().Equal(entgql.SpanResolver, span.parent.name)
+		s.Require().Equal("todos", span.parent.tags[entgql.TagFieldPath])
+		s.Require().NotNil(span.parent.parent)
+		s.Require().Equal(entgql.SpanOperation, span.parent.parent.name)
+		s.Require().Equal("Todos", span.parent.parent.tags[entgql.TagOperationName])
+	}
+	s.Require().NotZero(queries)
+}
+
+// recorder is an in-memory entgql.Tracer.
+type recorder struct {
+	mu    sync.Mutex
+	spans []*span
+}
+
+type spanKey struct{}
+
+func (r *recorder) StartSpan(ctx context.Context, name string) (context.Context, entgql.Span) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s := &span{name: name, tags: make(map[string]interface{})}
+	s.parent, _ = ctx.Value(spanKey{}).(*span)
+	r.spans = append(r.spans, s)
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+type span struct {
+	name   string
+	parent *span
+	tags   map[string]interface{}
+}
+
+func (s *span) SetTag(key string, value interface{}) { s.tags[key] = value }
+
+func (*span) Finish(error) {}
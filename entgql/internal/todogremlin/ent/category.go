@@ -0,0 +1,180 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/contrib/entgql/internal/todo/ent/schema/schematype"
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/category"
+	"entgo.io/ent/dialect/gremlin"
+)
+
+// Category is the model entity for the Category schema.
+type Category struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Status holds the value of the "status" field.
+	Status category.Status `json:"status,omitempty"`
+	// Config holds the value of the "config" field.
+	Config *schematype.CategoryConfig `json:"config,omitempty"`
+	// Duration holds the value of the "duration" field.
+	Duration time.Duration `json:"duration,omitempty"`
+	// Count holds the value of the "count" field.
+	Count uint64 `json:"count,omitempty"`
+	// Budget holds the value of the "budget" field.
+	Budget schematype.Amount `json:"budget,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CategoryQuery when eager-loading is set.
+	Edges CategoryEdges `json:"edges"`
+}
+
+// CategoryEdges holds the relations/edges for other nodes in the graph.
+type CategoryEdges struct {
+	// Todos holds the value of the todos edge.
+	Todos []*Todo `json:"todos,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TodosOrErr returns the Todos value or an error if the edge
+// was not loaded in eager-loading.
+func (e CategoryEdges) TodosOrErr() ([]*Todo, error) {
+	if e.loadedTypes[0] {
+		return e.Todos, nil
+	}
+	return nil, &NotLoadedError{edge: "todos"}
+}
+
+// FromResponse scans the gremlin response data into Category.
+func (c *Category) FromResponse(res *gremlin.Response) error {
+	vmap, err := res.ReadValueMap()
+	if err != nil {
+		return err
+	}
+	var scanc struct {
+		ID       string                     `json:"id,omitempty"`
+		Text     string                     `json:"text,omitempty"`
+		Status   category.Status            `json:"status,omitempty"`
+		Config   *schematype.CategoryConfig `json:"config,omitempty"`
+		Duration time.Duration              `json:"duration,omitempty"`
+		Count    uint64                     `json:"count,omitempty"`
+		Budget   schematype.Amount          `json:"budget,omitempty"`
+	}
+	if err := vmap.Decode(&scanc); err != nil {
+		return err
+	}
+	c.ID = scanc.ID
+	c.Text = scanc.Text
+	c.Status = scanc.Status
+	c.Config = scanc.Config
+	c.Duration = scanc.Duration
+	c.Count = scanc.Count
+	c.Budget = scanc.Budget
+	return nil
+}
+
+// QueryTodos queries the "todos" edge of the Category entity.
+func (c *Category) QueryTodos() *TodoQuery {
+	return (&CategoryClient{config: c.config}).QueryTodos(c)
+}
+
+// Update returns a builder for updating this Category.
+// Note that you need to call Category.Unwrap() before calling this method if this Category
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Category) Update() *CategoryUpdateOne {
+	return (&CategoryClient{config: c.config}).UpdateOne(c)
+}
+
+// Unwrap unwraps the Category entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Category) Unwrap() *Category {
+	tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Category is not a transactional entity")
+	}
+	c.config.driver = tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Category) String() string {
+	var builder strings.Builder
+	builder.WriteString("Category(")
+	builder.WriteString(fmt.Sprintf("id=%v", c.ID))
+	builder.WriteString(", text=")
+	builder.WriteString(c.Text)
+	builder.WriteString(", status=")
+	builder.WriteString(fmt.Sprintf("%v", c.Status))
+	builder.WriteString(", config=")
+	builder.WriteString(fmt.Sprintf("%v", c.Config))
+	builder.WriteString(", duration=")
+	builder.WriteString(fmt.Sprintf("%v", c.Duration))
+	builder.WriteString(", count=")
+	builder.WriteString(fmt.Sprintf("%v", c.Count))
+	builder.WriteString(", budget=")
+	builder.WriteString(fmt.Sprintf("%v", c.Budget))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Categories is a parsable slice of Category.
+type Categories []*Category
+
+// FromResponse scans the gremlin response data into Categories.
+func (c *Categories) FromResponse(res *gremlin.Response) error {
+	vmap, err := res.ReadValueMap()
+	if err != nil {
+		return err
+	}
+	var scanc []struct {
+		ID       string                     `json:"id,omitempty"`
+		Text     string                     `json:"text,omitempty"`
+		Status   category.Status            `json:"status,omitempty"`
+		Config   *schematype.CategoryConfig `json:"config,omitempty"`
+		Duration time.Duration              `json:"duration,omitempty"`
+		Count    uint64                     `json:"count,omitempty"`
+		Budget   schematype.Amount          `json:"budget,omitempty"`
+	}
+	if err := vmap.Decode(&scanc); err != nil {
+		return err
+	}
+	for _, v := range scanc {
+		*c = append(*c, &Category{
+			ID:       v.ID,
+			Text:     v.Text,
+			Status:   v.Status,
+			Config:   v.Config,
+			Duration: v.Duration,
+			Count:    v.Count,
+			Budget:   v.Budget,
+		})
+	}
+	return nil
+}
+
+func (c Categories) config(cfg config) {
+	for _i := range c {
+		c[_i].config = cfg
+	}
+}
@@ -0,0 +1,626 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package category
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql/internal/todo/ent/schema/schematype"
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/predicate"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.HasID(id)
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.HasID(p.EQ(id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.HasID(p.NEQ(id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		t.HasID(p.Within(v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		t.HasID(p.Without(v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.HasID(p.GT(id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.HasID(p.GTE(id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.HasID(p.LT(id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.HasID(p.LTE(id))
+	})
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.EQ(v))
+	})
+}
+
+// Config applies equality check predicate on the "config" field. It's identical to ConfigEQ.
+func Config(v *schematype.CategoryConfig) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldConfig, p.EQ(v))
+	})
+}
+
+// Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
+func Duration(v time.Duration) predicate.Category {
+	vc := int64(v)
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDuration, p.EQ(vc))
+	})
+}
+
+// Count applies equality check predicate on the "count" field. It's identical to CountEQ.
+func Count(v uint64) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCount, p.EQ(v))
+	})
+}
+
+// Budget applies equality check predicate on the "budget" field. It's identical to BudgetEQ.
+func Budget(v schematype.Amount) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBudget, p.EQ(v))
+	})
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.EQ(v))
+	})
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.NEQ(v))
+	})
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.Category {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.Within(v...))
+	})
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.Category {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.Without(v...))
+	})
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.GT(v))
+	})
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.GTE(v))
+	})
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.LT(v))
+	})
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.LTE(v))
+	})
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.Containing(v))
+	})
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.StartingWith(v))
+	})
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.EndingWith(v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldStatus, p.EQ(v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldStatus, p.NEQ(v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Category {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldStatus, p.Within(v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Category {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldStatus, p.Without(v...))
+	})
+}
+
+// ConfigEQ applies the EQ predicate on the "config" field.
+func ConfigEQ(v *schematype.CategoryConfig) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldConfig, p.EQ(v))
+	})
+}
+
+// ConfigNEQ applies the NEQ predicate on the "config" field.
+func ConfigNEQ(v *schematype.CategoryConfig) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldConfig, p.NEQ(v))
+	})
+}
+
+// ConfigIn applies the In predicate on the "config" field.
+func ConfigIn(vs ...*schematype.CategoryConfig) predicate.Category {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldConfig, p.Within(v...))
+	})
+}
+
+// ConfigNotIn applies the NotIn predicate on the "config" field.
+func ConfigNotIn(vs ...*schematype.CategoryConfig) predicate.Category {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldConfig, p.Without(v...))
+	})
+}
+
+// ConfigGT applies the GT predicate on the "config" field.
+func ConfigGT(v *schematype.CategoryConfig) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldConfig, p.GT(v))
+	})
+}
+
+// ConfigGTE applies the GTE predicate on the "config" field.
+func ConfigGTE(v *schematype.CategoryConfig) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldConfig, p.GTE(v))
+	})
+}
+
+// ConfigLT applies the LT predicate on the "config" field.
+func ConfigLT(v *schematype.CategoryConfig) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldConfig, p.LT(v))
+	})
+}
+
+// ConfigLTE applies the LTE predicate on the "config" field.
+func ConfigLTE(v *schematype.CategoryConfig) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldConfig, p.LTE(v))
+	})
+}
+
+// ConfigIsNil applies the IsNil predicate on the "config" field.
+func ConfigIsNil() predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.HasLabel(Label).HasNot(FieldConfig)
+	})
+}
+
+// ConfigNotNil applies the NotNil predicate on the "config" field.
+func ConfigNotNil() predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.HasLabel(Label).Has(FieldConfig)
+	})
+}
+
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v time.Duration) predicate.Category {
+	vc := int64(v)
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDuration, p.EQ(vc))
+	})
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v time.Duration) predicate.Category {
+	vc := int64(v)
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDuration, p.NEQ(vc))
+	})
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...time.Duration) predicate.Category {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDuration, p.Within(v...))
+	})
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...time.Duration) predicate.Category {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDuration, p.Without(v...))
+	})
+}
+
+// DurationGT applies the GT predicate on the "duration" field.
+func DurationGT(v time.Duration) predicate.Category {
+	vc := int64(v)
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDuration, p.GT(vc))
+	})
+}
+
+// DurationGTE applies the GTE predicate on the "duration" field.
+func DurationGTE(v time.Duration) predicate.Category {
+	vc := int64(v)
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDuration, p.GTE(vc))
+	})
+}
+
+// DurationLT applies the LT predicate on the "duration" field.
+func DurationLT(v time.Duration) predicate.Category {
+	vc := int64(v)
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDuration, p.LT(vc))
+	})
+}
+
+// DurationLTE applies the LTE predicate on the "duration" field.
+func DurationLTE(v time.Duration) predicate.Category {
+	vc := int64(v)
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDuration, p.LTE(vc))
+	})
+}
+
+// DurationIsNil applies the IsNil predicate on the "duration" field.
+func DurationIsNil() predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.HasLabel(Label).HasNot(FieldDuration)
+	})
+}
+
+// DurationNotNil applies the NotNil predicate on the "duration" field.
+func DurationNotNil() predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.HasLabel(Label).Has(FieldDuration)
+	})
+}
+
+// CountEQ applies the EQ predicate on the "count" field.
+func CountEQ(v uint64) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCount, p.EQ(v))
+	})
+}
+
+// CountNEQ applies the NEQ predicate on the "count" field.
+func CountNEQ(v uint64) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCount, p.NEQ(v))
+	})
+}
+
+// CountIn applies the In predicate on the "count" field.
+func CountIn(vs ...uint64) predicate.Category {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCount, p.Within(v...))
+	})
+}
+
+// CountNotIn applies the NotIn predicate on the "count" field.
+func CountNotIn(vs ...uint64) predicate.Category {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCount, p.Without(v...))
+	})
+}
+
+// CountGT applies the GT predicate on the "count" field.
+func CountGT(v uint64) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCount, p.GT(v))
+	})
+}
+
+// CountGTE applies the GTE predicate on the "count" field.
+func CountGTE(v uint64) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCount, p.GTE(v))
+	})
+}
+
+// CountLT applies the LT predicate on the "count" field.
+func CountLT(v uint64) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCount, p.LT(v))
+	})
+}
+
+// CountLTE applies the LTE predicate on the "count" field.
+func CountLTE(v uint64) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCount, p.LTE(v))
+	})
+}
+
+// CountIsNil applies the IsNil predicate on the "count" field.
+func CountIsNil() predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.HasLabel(Label).HasNot(FieldCount)
+	})
+}
+
+// CountNotNil applies the NotNil predicate on the "count" field.
+func CountNotNil() predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.HasLabel(Label).Has(FieldCount)
+	})
+}
+
+// BudgetEQ applies the EQ predicate on the "budget" field.
+func BudgetEQ(v schematype.Amount) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBudget, p.EQ(v))
+	})
+}
+
+// BudgetNEQ applies the NEQ predicate on the "budget" field.
+func BudgetNEQ(v schematype.Amount) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBudget, p.NEQ(v))
+	})
+}
+
+// BudgetIn applies the In predicate on the "budget" field.
+func BudgetIn(vs ...schematype.Amount) predicate.Category {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBudget, p.Within(v...))
+	})
+}
+
+// BudgetNotIn applies the NotIn predicate on the "budget" field.
+func BudgetNotIn(vs ...schematype.Amount) predicate.Category {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBudget, p.Without(v...))
+	})
+}
+
+// BudgetGT applies the GT predicate on the "budget" field.
+func BudgetGT(v schematype.Amount) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBudget, p.GT(v))
+	})
+}
+
+// BudgetGTE applies the GTE predicate on the "budget" field.
+func BudgetGTE(v schematype.Amount) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBudget, p.GTE(v))
+	})
+}
+
+// BudgetLT applies the LT predicate on the "budget" field.
+func BudgetLT(v schematype.Amount) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBudget, p.LT(v))
+	})
+}
+
+// BudgetLTE applies the LTE predicate on the "budget" field.
+func BudgetLTE(v schematype.Amount) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBudget, p.LTE(v))
+	})
+}
+
+// BudgetIsNil applies the IsNil predicate on the "budget" field.
+func BudgetIsNil() predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.HasLabel(Label).HasNot(FieldBudget)
+	})
+}
+
+// BudgetNotNil applies the NotNil predicate on the "budget" field.
+func BudgetNotNil() predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.HasLabel(Label).Has(FieldBudget)
+	})
+}
+
+// HasTodos applies the HasEdge predicate on the "todos" edge.
+func HasTodos() predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		t.OutE(TodosLabel).OutV()
+	})
+}
+
+// HasTodosWith applies the HasEdge predicate on the "todos" edge with a given conditions (other predicates).
+func HasTodosWith(preds ...predicate.Todo) predicate.Category {
+	return predicate.Category(func(t *dsl.Traversal) {
+		tr := __.InV()
+		for _, p := range preds {
+			p(tr)
+		}
+		t.OutE(TodosLabel).Where(tr).OutV()
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Category) predicate.Category {
+	return predicate.Category(func(tr *dsl.Traversal) {
+		trs := make([]interface{}, 0, len(predicates))
+		for _, p := range predicates {
+			t := __.New()
+			p(t)
+			trs = append(trs, t)
+		}
+		tr.Where(__.And(trs...))
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Category) predicate.Category {
+	return predicate.Category(func(tr *dsl.Traversal) {
+		trs := make([]interface{}, 0, len(predicates))
+		for _, p := range predicates {
+			t := __.New()
+			p(t)
+			trs = append(trs, t)
+		}
+		tr.Where(__.Or(trs...))
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Category) predicate.Category {
+	return predicate.Category(func(tr *dsl.Traversal) {
+		t := __.New()
+		p(t)
+		tr.Where(__.Not(t))
+	})
+}
@@ -0,0 +1,267 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entgql/internal/todo/ent/schema/schematype"
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/category"
+	"entgo.io/ent/dialect/gremlin"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
+)
+
+// CategoryCreate is the builder for creating a Category entity.
+type CategoryCreate struct {
+	config
+	mutation *CategoryMutation
+	hooks    []Hook
+}
+
+// SetText sets the "text" field.
+func (cc *CategoryCreate) SetText(s string) *CategoryCreate {
+	cc.mutation.SetText(s)
+	return cc
+}
+
+// SetStatus sets the "status" field.
+func (cc *CategoryCreate) SetStatus(c category.Status) *CategoryCreate {
+	cc.mutation.SetStatus(c)
+	return cc
+}
+
+// SetConfig sets the "config" field.
+func (cc *CategoryCreate) SetConfig(sc *schematype.CategoryConfig) *CategoryCreate {
+	cc.mutation.SetConfig(sc)
+	return cc
+}
+
+// SetDuration sets the "duration" field.
+func (cc *CategoryCreate) SetDuration(t time.Duration) *CategoryCreate {
+	cc.mutation.SetDuration(t)
+	return cc
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (cc *CategoryCreate) SetNillableDuration(t *time.Duration) *CategoryCreate {
+	if t != nil {
+		cc.SetDuration(*t)
+	}
+	return cc
+}
+
+// SetCount sets the "count" field.
+func (cc *CategoryCreate) SetCount(u uint64) *CategoryCreate {
+	cc.mutation.SetCount(u)
+	return cc
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (cc *CategoryCreate) SetNillableCount(u *uint64) *CategoryCreate {
+	if u != nil {
+		cc.SetCount(*u)
+	}
+	return cc
+}
+
+// SetBudget sets the "budget" field.
+func (cc *CategoryCreate) SetBudget(s schematype.Amount) *CategoryCreate {
+	cc.mutation.SetBudget(s)
+	return cc
+}
+
+// SetNillableBudget sets the "budget" field if the given value is not nil.
+func (cc *CategoryCreate) SetNillableBudget(s *schematype.Amount) *CategoryCreate {
+	if s != nil {
+		cc.SetBudget(*s)
+	}
+	return cc
+}
+
+// AddTodoIDs adds the "todos" edge to the Todo entity by IDs.
+func (cc *CategoryCreate) AddTodoIDs(ids ...string) *CategoryCreate {
+	cc.mutation.AddTodoIDs(ids...)
+	return cc
+}
+
+// AddTodos adds the "todos" edges to the Todo entity.
+func (cc *CategoryCreate) AddTodos(t ...*Todo) *CategoryCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cc.AddTodoIDs(ids...)
+}
+
+// Mutation returns the CategoryMutation object of the builder.
+func (cc *CategoryCreate) Mutation() *CategoryMutation {
+	return cc.mutation
+}
+
+// Save creates the Category in the database.
+func (cc *CategoryCreate) Save(ctx context.Context) (*Category, error) {
+	var (
+		err  error
+		node *Category
+	)
+	if len(cc.hooks) == 0 {
+		if err = cc.check(); err != nil {
+			return nil, err
+		}
+		node, err = cc.gremlinSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CategoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cc.check(); err != nil {
+				return nil, err
+			}
+			cc.mutation = mutation
+			if node, err = cc.gremlinSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cc.hooks) - 1; i >= 0; i-- {
+			if cc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CategoryCreate) SaveX(ctx context.Context) *Category {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CategoryCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CategoryCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CategoryCreate) check() error {
+	if _, ok := cc.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "text"`)}
+	}
+	if v, ok := cc.mutation.Text(); ok {
+		if err := category.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "text": %w`, err)}
+		}
+	}
+	if _, ok := cc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "status"`)}
+	}
+	if v, ok := cc.mutation.Status(); ok {
+		if err := category.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cc *CategoryCreate) gremlinSave(ctx context.Context) (*Category, error) {
+	res := &gremlin.Response{}
+	query, bindings := cc.gremlin().Query()
+	if err := cc.driver.Exec(ctx, query, bindings, res); err != nil {
+		return nil, err
+	}
+	if err, ok := isConstantError(res); ok {
+		return nil, err
+	}
+	c := &Category{config: cc.config}
+	if err := c.FromResponse(res); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (cc *CategoryCreate) gremlin() *dsl.Traversal {
+	type constraint struct {
+		pred *dsl.Traversal // constraint predicate.
+		test *dsl.Traversal // test matches and its constant.
+	}
+	constraints := make([]*constraint, 0, 1)
+	v := g.AddV(category.Label)
+	if value, ok := cc.mutation.Text(); ok {
+		v.Property(dsl.Single, category.FieldText, value)
+	}
+	if value, ok := cc.mutation.Status(); ok {
+		v.Property(dsl.Single, category.FieldStatus, value)
+	}
+	if value, ok := cc.mutation.Config(); ok {
+		v.Property(dsl.Single, category.FieldConfig, value)
+	}
+	if value, ok := cc.mutation.Duration(); ok {
+		v.Property(dsl.Single, category.FieldDuration, value)
+	}
+	if value, ok := cc.mutation.Count(); ok {
+		v.Property(dsl.Single, category.FieldCount, value)
+	}
+	if value, ok := cc.mutation.Budget(); ok {
+		v.Property(dsl.Single, category.FieldBudget, value)
+	}
+	for _, id := range cc.mutation.TodosIDs() {
+		v.AddE(category.TodosLabel).To(g.V(id)).OutV()
+		constraints = append(constraints, &constraint{
+			pred: g.E().HasLabel(category.TodosLabel).InV().HasID(id).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(category.Label, category.TodosLabel, id)),
+		})
+	}
+	if len(constraints) == 0 {
+		return v.ValueMap(true)
+	}
+	tr := constraints[0].pred.Coalesce(constraints[0].test, v.ValueMap(true))
+	for _, cr := range constraints[1:] {
+		tr = cr.pred.Coalesce(cr.test, tr)
+	}
+	return tr
+}
+
+// CategoryCreateBulk is the builder for creating many Category entities in bulk.
+type CategoryCreateBulk struct {
+	config
+	builders []*CategoryCreate
+}
@@ -0,0 +1,706 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entgql/internal/todo/ent/schema/schematype"
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/category"
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/predicate"
+	"entgo.io/ent/dialect/gremlin"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
+)
+
+// CategoryUpdate is the builder for updating Category entities.
+type CategoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CategoryMutation
+}
+
+// Where appends a list predicates to the CategoryUpdate builder.
+func (cu *CategoryUpdate) Where(ps ...predicate.Category) *CategoryUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetText sets the "text" field.
+func (cu *CategoryUpdate) SetText(s string) *CategoryUpdate {
+	cu.mutation.SetText(s)
+	return cu
+}
+
+// SetStatus sets the "status" field.
+func (cu *CategoryUpdate) SetStatus(c category.Status) *CategoryUpdate {
+	cu.mutation.SetStatus(c)
+	return cu
+}
+
+// SetConfig sets the "config" field.
+func (cu *CategoryUpdate) SetConfig(sc *schematype.CategoryConfig) *CategoryUpdate {
+	cu.mutation.SetConfig(sc)
+	return cu
+}
+
+// ClearConfig clears the value of the "config" field.
+func (cu *CategoryUpdate) ClearConfig() *CategoryUpdate {
+	cu.mutation.ClearConfig()
+	return cu
+}
+
+// SetDuration sets the "duration" field.
+func (cu *CategoryUpdate) SetDuration(t time.Duration) *CategoryUpdate {
+	cu.mutation.ResetDuration()
+	cu.mutation.SetDuration(t)
+	return cu
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableDuration(t *time.Duration) *CategoryUpdate {
+	if t != nil {
+		cu.SetDuration(*t)
+	}
+	return cu
+}
+
+// AddDuration adds t to the "duration" field.
+func (cu *CategoryUpdate) AddDuration(t time.Duration) *CategoryUpdate {
+	cu.mutation.AddDuration(t)
+	return cu
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (cu *CategoryUpdate) ClearDuration() *CategoryUpdate {
+	cu.mutation.ClearDuration()
+	return cu
+}
+
+// SetCount sets the "count" field.
+func (cu *CategoryUpdate) SetCount(u uint64) *CategoryUpdate {
+	cu.mutation.ResetCount()
+	cu.mutation.SetCount(u)
+	return cu
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableCount(u *uint64) *CategoryUpdate {
+	if u != nil {
+		cu.SetCount(*u)
+	}
+	return cu
+}
+
+// AddCount adds u to the "count" field.
+func (cu *CategoryUpdate) AddCount(u uint64) *CategoryUpdate {
+	cu.mutation.AddCount(u)
+	return cu
+}
+
+// ClearCount clears the value of the "count" field.
+func (cu *CategoryUpdate) ClearCount() *CategoryUpdate {
+	cu.mutation.ClearCount()
+	return cu
+}
+
+// SetBudget sets the "budget" field.
+func (cu *CategoryUpdate) SetBudget(s schematype.Amount) *CategoryUpdate {
+	cu.mutation.ResetBudget()
+	cu.mutation.SetBudget(s)
+	return cu
+}
+
+// SetNillableBudget sets the "budget" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableBudget(s *schematype.Amount) *CategoryUpdate {
+	if s != nil {
+		cu.SetBudget(*s)
+	}
+	return cu
+}
+
+// AddBudget adds s to the "budget" field.
+func (cu *CategoryUpdate) AddBudget(s schematype.Amount) *CategoryUpdate {
+	cu.mutation.AddBudget(s)
+	return cu
+}
+
+// ClearBudget clears the value of the "budget" field.
+func (cu *CategoryUpdate) ClearBudget() *CategoryUpdate {
+	cu.mutation.ClearBudget()
+	return cu
+}
+
+// AddTodoIDs adds the "todos" edge to the Todo entity by IDs.
+func (cu *CategoryUpdate) AddTodoIDs(ids ...string) *CategoryUpdate {
+	cu.mutation.AddTodoIDs(ids...)
+	return cu
+}
+
+// AddTodos adds the "todos" edges to the Todo entity.
+func (cu *CategoryUpdate) AddTodos(t ...*Todo) *CategoryUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cu.AddTodoIDs(ids...)
+}
+
+// Mutation returns the CategoryMutation object of the builder.
+func (cu *CategoryUpdate) Mutation() *CategoryMutation {
+	return cu.mutation
+}
+
+// ClearTodos clears all "todos" edges to the Todo entity.
+func (cu *CategoryUpdate) ClearTodos() *CategoryUpdate {
+	cu.mutation.ClearTodos()
+	return cu
+}
+
+// RemoveTodoIDs removes the "todos" edge to Todo entities by IDs.
+func (cu *CategoryUpdate) RemoveTodoIDs(ids ...string) *CategoryUpdate {
+	cu.mutation.RemoveTodoIDs(ids...)
+	return cu
+}
+
+// RemoveTodos removes "todos" edges to Todo entities.
+func (cu *CategoryUpdate) RemoveTodos(t ...*Todo) *CategoryUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cu.RemoveTodoIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CategoryUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(cu.hooks) == 0 {
+		if err = cu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = cu.gremlinSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CategoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cu.check(); err != nil {
+				return 0, err
+			}
+			cu.mutation = mutation
+			affected, err = cu.gremlinSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(cu.hooks) - 1; i >= 0; i-- {
+			if cu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CategoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CategoryUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CategoryUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CategoryUpdate) check() error {
+	if v, ok := cu.mutation.Text(); ok {
+		if err := category.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf("ent: validator failed for field \"text\": %w", err)}
+		}
+	}
+	if v, ok := cu.mutation.Status(); ok {
+		if err := category.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf("ent: validator failed for field \"status\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (cu *CategoryUpdate) gremlinSave(ctx context.Context) (int, error) {
+	res := &gremlin.Response{}
+	query, bindings := cu.gremlin().Query()
+	if err := cu.driver.Exec(ctx, query, bindings, res); err != nil {
+		return 0, err
+	}
+	if err, ok := isConstantError(res); ok {
+		return 0, err
+	}
+	return res.ReadInt()
+}
+
+func (cu *CategoryUpdate) gremlin() *dsl.Traversal {
+	type constraint struct {
+		pred *dsl.Traversal // constraint predicate.
+		test *dsl.Traversal // test matches and its constant.
+	}
+	constraints := make([]*constraint, 0, 1)
+	v := g.V().HasLabel(category.Label)
+	for _, p := range cu.mutation.predicates {
+		p(v)
+	}
+	var (
+		rv = v.Clone()
+		_  = rv
+
+		trs []*dsl.Traversal
+	)
+	if value, ok := cu.mutation.Text(); ok {
+		v.Property(dsl.Single, category.FieldText, value)
+	}
+	if value, ok := cu.mutation.Status(); ok {
+		v.Property(dsl.Single, category.FieldStatus, value)
+	}
+	if value, ok := cu.mutation.Config(); ok {
+		v.Property(dsl.Single, category.FieldConfig, value)
+	}
+	if value, ok := cu.mutation.Duration(); ok {
+		v.Property(dsl.Single, category.FieldDuration, value)
+	}
+	if value, ok := cu.mutation.AddedDuration(); ok {
+		v.Property(dsl.Single, category.FieldDuration, __.Union(__.Values(category.FieldDuration), __.Constant(value)).Sum())
+	}
+	if value, ok := cu.mutation.Count(); ok {
+		v.Property(dsl.Single, category.FieldCount, value)
+	}
+	if value, ok := cu.mutation.AddedCount(); ok {
+		v.Property(dsl.Single, category.FieldCount, __.Union(__.Values(category.FieldCount), __.Constant(value)).Sum())
+	}
+	if value, ok := cu.mutation.Budget(); ok {
+		v.Property(dsl.Single, category.FieldBudget, value)
+	}
+	if value, ok := cu.mutation.AddedBudget(); ok {
+		v.Property(dsl.Single, category.FieldBudget, __.Union(__.Values(category.FieldBudget), __.Constant(value)).Sum())
+	}
+	var properties []interface{}
+	if cu.mutation.ConfigCleared() {
+		properties = append(properties, category.FieldConfig)
+	}
+	if cu.mutation.DurationCleared() {
+		properties = append(properties, category.FieldDuration)
+	}
+	if cu.mutation.CountCleared() {
+		properties = append(properties, category.FieldCount)
+	}
+	if cu.mutation.BudgetCleared() {
+		properties = append(properties, category.FieldBudget)
+	}
+	if len(properties) > 0 {
+		v.SideEffect(__.Properties(properties...).Drop())
+	}
+	for _, id := range cu.mutation.RemovedTodosIDs() {
+		tr := rv.Clone().OutE(category.TodosLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range cu.mutation.TodosIDs() {
+		v.AddE(category.TodosLabel).To(g.V(id)).OutV()
+		constraints = append(constraints, &constraint{
+			pred: g.E().HasLabel(category.TodosLabel).InV().HasID(id).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(category.Label, category.TodosLabel, id)),
+		})
+	}
+	v.Count()
+	if len(constraints) > 0 {
+		constraints = append(constraints, &constraint{
+			pred: rv.Count(),
+			test: __.Is(p.GT(1)).Constant(&ConstraintError{msg: "update traversal contains more than one vertex"}),
+		})
+		v = constraints[0].pred.Coalesce(constraints[0].test, v)
+		for _, cr := range constraints[1:] {
+			v = cr.pred.Coalesce(cr.test, v)
+		}
+	}
+	trs = append(trs, v)
+	return dsl.Join(trs...)
+}
+
+// CategoryUpdateOne is the builder for updating a single Category entity.
+type CategoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CategoryMutation
+}
+
+// SetText sets the "text" field.
+func (cuo *CategoryUpdateOne) SetText(s string) *CategoryUpdateOne {
+	cuo.mutation.SetText(s)
+	return cuo
+}
+
+// SetStatus sets the "status" field.
+func (cuo *CategoryUpdateOne) SetStatus(c category.Status) *CategoryUpdateOne {
+	cuo.mutation.SetStatus(c)
+	return cuo
+}
+
+// SetConfig sets the "config" field.
+func (cuo *CategoryUpdateOne) SetConfig(sc *schematype.CategoryConfig) *CategoryUpdateOne {
+	cuo.mutation.SetConfig(sc)
+	return cuo
+}
+
+// ClearConfig clears the value of the "config" field.
+func (cuo *CategoryUpdateOne) ClearConfig() *CategoryUpdateOne {
+	cuo.mutation.ClearConfig()
+	return cuo
+}
+
+// SetDuration sets the "duration" field.
+func (cuo *CategoryUpdateOne) SetDuration(t time.Duration) *CategoryUpdateOne {
+	cuo.mutation.ResetDuration()
+	cuo.mutation.SetDuration(t)
+	return cuo
+}
+
+// SetNillableDuration sets the "duration" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableDuration(t *time.Duration) *CategoryUpdateOne {
+	if t != nil {
+		cuo.SetDuration(*t)
+	}
+	return cuo
+}
+
+// AddDuration adds t to the "duration" field.
+func (cuo *CategoryUpdateOne) AddDuration(t time.Duration) *CategoryUpdateOne {
+	cuo.mutation.AddDuration(t)
+	return cuo
+}
+
+// ClearDuration clears the value of the "duration" field.
+func (cuo *CategoryUpdateOne) ClearDuration() *CategoryUpdateOne {
+	cuo.mutation.ClearDuration()
+	return cuo
+}
+
+// SetCount sets the "count" field.
+func (cuo *CategoryUpdateOne) SetCount(u uint64) *CategoryUpdateOne {
+	cuo.mutation.ResetCount()
+	cuo.mutation.SetCount(u)
+	return cuo
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableCount(u *uint64) *CategoryUpdateOne {
+	if u != nil {
+		cuo.SetCount(*u)
+	}
+	return cuo
+}
+
+// AddCount adds u to the "count" field.
+func (cuo *CategoryUpdateOne) AddCount(u uint64) *CategoryUpdateOne {
+	cuo.mutation.AddCount(u)
+	return cuo
+}
+
+// ClearCount clears the value of the "count" field.
+func (cuo *CategoryUpdateOne) ClearCount() *CategoryUpdateOne {
+	cuo.mutation.ClearCount()
+	return cuo
+}
+
+// SetBudget sets the "budget" field.
+func (cuo *CategoryUpdateOne) SetBudget(s schematype.Amount) *CategoryUpdateOne {
+	cuo.mutation.ResetBudget()
+	cuo.mutation.SetBudget(s)
+	return cuo
+}
+
+// SetNillableBudget sets the "budget" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableBudget(s *schematype.Amount) *CategoryUpdateOne {
+	if s != nil {
+		cuo.SetBudget(*s)
+	}
+	return cuo
+}
+
+// AddBudget adds s to the "budget" field.
+func (cuo *CategoryUpdateOne) AddBudget(s schematype.Amount) *CategoryUpdateOne {
+	cuo.mutation.AddBudget(s)
+	return cuo
+}
+
+// ClearBudget clears the value of the "budget" field.
+func (cuo *CategoryUpdateOne) ClearBudget() *CategoryUpdateOne {
+	cuo.mutation.ClearBudget()
+	return cuo
+}
+
+// AddTodoIDs adds the "todos" edge to the Todo entity by IDs.
+func (cuo *CategoryUpdateOne) AddTodoIDs(ids ...string) *CategoryUpdateOne {
+	cuo.mutation.AddTodoIDs(ids...)
+	return cuo
+}
+
+// AddTodos adds the "todos" edges to the Todo entity.
+func (cuo *CategoryUpdateOne) AddTodos(t ...*Todo) *CategoryUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cuo.AddTodoIDs(ids...)
+}
+
+// Mutation returns the CategoryMutation object of the builder.
+func (cuo *CategoryUpdateOne) Mutation() *CategoryMutation {
+	return cuo.mutation
+}
+
+// ClearTodos clears all "todos" edges to the Todo entity.
+func (cuo *CategoryUpdateOne) ClearTodos() *CategoryUpdateOne {
+	cuo.mutation.ClearTodos()
+	return cuo
+}
+
+// RemoveTodoIDs removes the "todos" edge to Todo entities by IDs.
+func (cuo *CategoryUpdateOne) RemoveTodoIDs(ids ...string) *CategoryUpdateOne {
+	cuo.mutation.RemoveTodoIDs(ids...)
+	return cuo
+}
+
+// RemoveTodos removes "todos" edges to Todo entities.
+func (cuo *CategoryUpdateOne) RemoveTodos(t ...*Todo) *CategoryUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return cuo.RemoveTodoIDs(ids...)
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CategoryUpdateOne) Select(field string, fields ...string) *CategoryUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Category entity.
+func (cuo *CategoryUpdateOne) Save(ctx context.Context) (*Category, error) {
+	var (
+		err  error
+		node *Category
+	)
+	if len(cuo.hooks) == 0 {
+		if err = cuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = cuo.gremlinSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*CategoryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = cuo.check(); err != nil {
+				return nil, err
+			}
+			cuo.mutation = mutation
+			node, err = cuo.gremlinSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(cuo.hooks) - 1; i >= 0; i-- {
+			if cuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = cuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, cuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CategoryUpdateOne) SaveX(ctx context.Context) *Category {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CategoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CategoryUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CategoryUpdateOne) check() error {
+	if v, ok := cuo.mutation.Text(); ok {
+		if err := category.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf("ent: validator failed for field \"text\": %w", err)}
+		}
+	}
+	if v, ok := cuo.mutation.Status(); ok {
+		if err := category.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf("ent: validator failed for field \"status\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (cuo *CategoryUpdateOne) gremlinSave(ctx context.Context) (*Category, error) {
+	res := &gremlin.Response{}
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Category.ID for update")}
+	}
+	query, bindings := cuo.gremlin(id).Query()
+	if err := cuo.driver.Exec(ctx, query, bindings, res); err != nil {
+		return nil, err
+	}
+	if err, ok := isConstantError(res); ok {
+		return nil, err
+	}
+	c := &Category{config: cuo.config}
+	if err := c.FromResponse(res); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (cuo *CategoryUpdateOne) gremlin(id string) *dsl.Traversal {
+	type constraint struct {
+		pred *dsl.Traversal // constraint predicate.
+		test *dsl.Traversal // test matches and its constant.
+	}
+	constraints := make([]*constraint, 0, 1)
+	v := g.V(id)
+	var (
+		rv = v.Clone()
+		_  = rv
+
+		trs []*dsl.Traversal
+	)
+	if value, ok := cuo.mutation.Text(); ok {
+		v.Property(dsl.Single, category.FieldText, value)
+	}
+	if value, ok := cuo.mutation.Status(); ok {
+		v.Property(dsl.Single, category.FieldStatus, value)
+	}
+	if value, ok := cuo.mutation.Config(); ok {
+		v.Property(dsl.Single, category.FieldConfig, value)
+	}
+	if value, ok := cuo.mutation.Duration(); ok {
+		v.Property(dsl.Single, category.FieldDuration, value)
+	}
+	if value, ok := cuo.mutation.AddedDuration(); ok {
+		v.Property(dsl.Single, category.FieldDuration, __.Union(__.Values(category.FieldDuration), __.Constant(value)).Sum())
+	}
+	if value, ok := cuo.mutation.Count(); ok {
+		v.Property(dsl.Single, category.FieldCount, value)
+	}
+	if value, ok := cuo.mutation.AddedCount(); ok {
+		v.Property(dsl.Single, category.FieldCount, __.Union(__.Values(category.FieldCount), __.Constant(value)).Sum())
+	}
+	if value, ok := cuo.mutation.Budget(); ok {
+		v.Property(dsl.Single, category.FieldBudget, value)
+	}
+	if value, ok := cuo.mutation.AddedBudget(); ok {
+		v.Property(dsl.Single, category.FieldBudget, __.Union(__.Values(category.FieldBudget), __.Constant(value)).Sum())
+	}
+	var properties []interface{}
+	if cuo.mutation.ConfigCleared() {
+		properties = append(properties, category.FieldConfig)
+	}
+	if cuo.mutation.DurationCleared() {
+		properties = append(properties, category.FieldDuration)
+	}
+	if cuo.mutation.CountCleared() {
+		properties = append(properties, category.FieldCount)
+	}
+	if cuo.mutation.BudgetCleared() {
+		properties = append(properties, category.FieldBudget)
+	}
+	if len(properties) > 0 {
+		v.SideEffect(__.Properties(properties...).Drop())
+	}
+	for _, id := range cuo.mutation.RemovedTodosIDs() {
+		tr := rv.Clone().OutE(category.TodosLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range cuo.mutation.TodosIDs() {
+		v.AddE(category.TodosLabel).To(g.V(id)).OutV()
+		constraints = append(constraints, &constraint{
+			pred: g.E().HasLabel(category.TodosLabel).InV().HasID(id).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(category.Label, category.TodosLabel, id)),
+		})
+	}
+	if len(cuo.fields) > 0 {
+		fields := make([]interface{}, 0, len(cuo.fields)+1)
+		fields = append(fields, true)
+		for _, f := range cuo.fields {
+			fields = append(fields, f)
+		}
+		v.ValueMap(fields...)
+	} else {
+		v.ValueMap(true)
+	}
+	if len(constraints) > 0 {
+		v = constraints[0].pred.Coalesce(constraints[0].test, v)
+		for _, cr := range constraints[1:] {
+			v = cr.pred.Coalesce(cr.test, v)
+		}
+	}
+	trs = append(trs, v)
+	return dsl.Join(trs...)
+}
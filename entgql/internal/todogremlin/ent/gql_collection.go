@@ -0,0 +1,66 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/todo"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (c *CategoryQuery) CollectFields(ctx context.Context, satisfies ...string) *CategoryQuery {
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		c = c.collectField(graphql.GetOperationContext(ctx), fc.Field, satisfies...)
+	}
+	return c
+}
+
+func (c *CategoryQuery) collectField(ctx *graphql.OperationContext, field graphql.CollectedField, satisfies ...string) *CategoryQuery {
+	return c
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (t *TodoQuery) CollectFields(ctx context.Context, satisfies ...string) *TodoQuery {
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		t = t.collectField(graphql.GetOperationContext(ctx), fc.Field, satisfies...)
+	}
+	return t
+}
+
+func (t *TodoQuery) collectField(ctx *graphql.OperationContext, field graphql.CollectedField, satisfies ...string) *TodoQuery {
+	for _, field := range graphql.CollectFields(ctx, field.Selections, satisfies) {
+		switch field.Name {
+		case "children":
+			t = t.WithChildren(func(query *TodoQuery) {
+				if include, _ := field.ArgumentMap(ctx.Variables)["includeDeleted"].(bool); !include {
+					query.Where(todo.DeletedAtIsNil())
+				}
+				query.collectField(ctx, field)
+			})
+		case "parent":
+			t = t.WithParent(func(query *TodoQuery) {
+				if include, _ := field.ArgumentMap(ctx.Variables)["includeDeleted"].(bool); !include {
+					query.Where(todo.DeletedAtIsNil())
+				}
+				query.collectField(ctx, field)
+			})
+		}
+	}
+	return t
+}
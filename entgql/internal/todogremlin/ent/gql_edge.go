@@ -0,0 +1,67 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/todo"
+)
+
+func (c *Category) Todos(ctx context.Context, includeDeleted *bool) ([]*Todo, error) {
+	result, err := c.Edges.TodosOrErr()
+	if IsNotLoaded(err) {
+		query := c.QueryTodos()
+		if includeDeleted == nil || !*includeDeleted {
+			query = query.Where(todo.DeletedAtIsNil())
+		}
+		result, err = query.All(ctx)
+	}
+	return result, err
+}
+
+func (t *Todo) Parent(ctx context.Context, includeDeleted *bool) (*Todo, error) {
+	result, err := t.Edges.ParentOrErr()
+	if IsNotLoaded(err) {
+		query := t.QueryParent()
+		if includeDeleted == nil || !*includeDeleted {
+			query = query.Where(todo.DeletedAtIsNil())
+		}
+		result, err = query.Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (t *Todo) Children(ctx context.Context, includeDeleted *bool) ([]*Todo, error) {
+	result, err := t.Edges.ChildrenOrErr()
+	if IsNotLoaded(err) {
+		query := t.QueryChildren()
+		if includeDeleted == nil || !*includeDeleted {
+			query = query.Where(todo.DeletedAtIsNil())
+		}
+		result, err = query.All(ctx)
+	}
+	return result, err
+}
+
+func (t *Todo) Category(ctx context.Context) (*Category, error) {
+	result, err := t.Edges.CategoryOrErr()
+	if IsNotLoaded(err) {
+		result, err = t.QueryCategory().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
@@ -0,0 +1,46 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import "context"
+
+// QueryResolver implements the root query fields of the types that were
+// annotated with entgql.QueryField. The signatures of its methods match
+// the ones generated by gqlgen, and it can be embedded in the query
+// resolver, or called from its methods:
+//
+//	func (r *queryResolver) Todos(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoConnection, error) {
+//		return r.QueryResolver.Todos(ctx, after, first, before, last, orderBy, where)
+//	}
+type QueryResolver struct {
+	client *Client
+}
+
+// NewQueryResolver returns a new QueryResolver that executes its queries using the given client.
+func NewQueryResolver(c *Client) *QueryResolver {
+	return &QueryResolver{client: c}
+}
+
+// Todos resolves the "todos" query field.
+func (r *QueryResolver) Todos(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *TodoOrder, where *TodoWhereInput, includeDeleted *bool) (*TodoConnection, error) {
+	return r.client.Todo.Query().
+		Paginate(ctx, after, first, before, last,
+			WithTodoOrder(orderBy),
+			WithTodoFilter(where.Filter),
+			WithTodoIncludeDeleted(includeDeleted),
+		)
+}
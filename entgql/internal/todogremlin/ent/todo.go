@@ -0,0 +1,244 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/category"
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/todo"
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/verysecret"
+	"entgo.io/ent/dialect/gremlin"
+)
+
+// Todo is the model entity for the Todo schema.
+type Todo struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Status holds the value of the "status" field.
+	Status todo.Status `json:"status,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Blob holds the value of the "blob" field.
+	Blob []byte `json:"blob,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt time.Time `json:"deleted_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TodoQuery when eager-loading is set.
+	Edges TodoEdges `json:"edges"`
+}
+
+// TodoEdges holds the relations/edges for other nodes in the graph.
+type TodoEdges struct {
+	// Parent holds the value of the parent edge.
+	Parent *Todo `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Todo `json:"children,omitempty"`
+	// Category holds the value of the category edge.
+	Category *Category `json:"category,omitempty"`
+	// Secret holds the value of the secret edge.
+	Secret *VerySecret `json:"secret,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) ParentOrErr() (*Todo, error) {
+	if e.loadedTypes[0] {
+		if e.Parent == nil {
+			// The edge parent was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: todo.Label}
+		}
+		return e.Parent, nil
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e TodoEdges) ChildrenOrErr() ([]*Todo, error) {
+	if e.loadedTypes[1] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// CategoryOrErr returns the Category value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) CategoryOrErr() (*Category, error) {
+	if e.loadedTypes[2] {
+		if e.Category == nil {
+			// The edge category was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: category.Label}
+		}
+		return e.Category, nil
+	}
+	return nil, &NotLoadedError{edge: "category"}
+}
+
+// SecretOrErr returns the Secret value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TodoEdges) SecretOrErr() (*VerySecret, error) {
+	if e.loadedTypes[3] {
+		if e.Secret == nil {
+			// The edge secret was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: verysecret.Label}
+		}
+		return e.Secret, nil
+	}
+	return nil, &NotLoadedError{edge: "secret"}
+}
+
+// FromResponse scans the gremlin response data into Todo.
+func (t *Todo) FromResponse(res *gremlin.Response) error {
+	vmap, err := res.ReadValueMap()
+	if err != nil {
+		return err
+	}
+	var scant struct {
+		ID        string      `json:"id,omitempty"`
+		CreatedAt int64       `json:"created_at,omitempty"`
+		Status    todo.Status `json:"status,omitempty"`
+		Priority  int         `json:"priority,omitempty"`
+		Text      string      `json:"text,omitempty"`
+		Blob      []byte      `json:"blob,omitempty"`
+		DeletedAt int64       `json:"deleted_at,omitempty"`
+	}
+	if err := vmap.Decode(&scant); err != nil {
+		return err
+	}
+	t.ID = scant.ID
+	t.CreatedAt = time.Unix(0, scant.CreatedAt)
+	t.Status = scant.Status
+	t.Priority = scant.Priority
+	t.Text = scant.Text
+	t.Blob = scant.Blob
+	t.DeletedAt = time.Unix(0, scant.DeletedAt)
+	return nil
+}
+
+// QueryParent queries the "parent" edge of the Todo entity.
+func (t *Todo) QueryParent() *TodoQuery {
+	return (&TodoClient{config: t.config}).QueryParent(t)
+}
+
+// QueryChildren queries the "children" edge of the Todo entity.
+func (t *Todo) QueryChildren() *TodoQuery {
+	return (&TodoClient{config: t.config}).QueryChildren(t)
+}
+
+// QueryCategory queries the "category" edge of the Todo entity.
+func (t *Todo) QueryCategory() *CategoryQuery {
+	return (&TodoClient{config: t.config}).QueryCategory(t)
+}
+
+// QuerySecret queries the "secret" edge of the Todo entity.
+func (t *Todo) QuerySecret() *VerySecretQuery {
+	return (&TodoClient{config: t.config}).QuerySecret(t)
+}
+
+// Update returns a builder for updating this Todo.
+// Note that you need to call Todo.Unwrap() before calling this method if this Todo
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Todo) Update() *TodoUpdateOne {
+	return (&TodoClient{config: t.config}).UpdateOne(t)
+}
+
+// Unwrap unwraps the Todo entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (t *Todo) Unwrap() *Todo {
+	tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Todo is not a transactional entity")
+	}
+	t.config.driver = tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Todo) String() string {
+	var builder strings.Builder
+	builder.WriteString("Todo(")
+	builder.WriteString(fmt.Sprintf("id=%v", t.ID))
+	builder.WriteString(", created_at=")
+	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", status=")
+	builder.WriteString(fmt.Sprintf("%v", t.Status))
+	builder.WriteString(", priority=")
+	builder.WriteString(fmt.Sprintf("%v", t.Priority))
+	builder.WriteString(", text=")
+	builder.WriteString(t.Text)
+	builder.WriteString(", blob=")
+	builder.WriteString(fmt.Sprintf("%v", t.Blob))
+	builder.WriteString(", deleted_at=")
+	builder.WriteString(t.DeletedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Todos is a parsable slice of Todo.
+type Todos []*Todo
+
+// FromResponse scans the gremlin response data into Todos.
+func (t *Todos) FromResponse(res *gremlin.Response) error {
+	vmap, err := res.ReadValueMap()
+	if err != nil {
+		return err
+	}
+	var scant []struct {
+		ID        string      `json:"id,omitempty"`
+		CreatedAt int64       `json:"created_at,omitempty"`
+		Status    todo.Status `json:"status,omitempty"`
+		Priority  int         `json:"priority,omitempty"`
+		Text      string      `json:"text,omitempty"`
+		Blob      []byte      `json:"blob,omitempty"`
+		DeletedAt int64       `json:"deleted_at,omitempty"`
+	}
+	if err := vmap.Decode(&scant); err != nil {
+		return err
+	}
+	for _, v := range scant {
+		*t = append(*t, &Todo{
+			ID:        v.ID,
+			CreatedAt: time.Unix(0, v.CreatedAt),
+			Status:    v.Status,
+			Priority:  v.Priority,
+			Text:      v.Text,
+			Blob:      v.Blob,
+			DeletedAt: time.Unix(0, v.DeletedAt),
+		})
+	}
+	return nil
+}
+
+func (t Todos) config(cfg config) {
+	for _i := range t {
+		t[_i].config = cfg
+	}
+}
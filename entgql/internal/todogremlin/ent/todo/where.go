@@ -0,0 +1,644 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package todo
+
+import (
+	"time"
+
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/predicate"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.HasID(id)
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.HasID(p.EQ(id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.HasID(p.NEQ(id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		t.HasID(p.Within(v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		t.HasID(p.Without(v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.HasID(p.GT(id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.HasID(p.GTE(id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.HasID(p.LT(id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.HasID(p.LTE(id))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCreatedAt, p.EQ(v))
+	})
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPriority, p.EQ(v))
+	})
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.EQ(v))
+	})
+}
+
+// Blob applies equality check predicate on the "blob" field. It's identical to BlobEQ.
+func Blob(v []byte) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBlob, p.EQ(v))
+	})
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDeletedAt, p.EQ(v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCreatedAt, p.EQ(v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCreatedAt, p.NEQ(v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCreatedAt, p.Within(v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCreatedAt, p.Without(v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCreatedAt, p.GT(v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCreatedAt, p.GTE(v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCreatedAt, p.LT(v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldCreatedAt, p.LTE(v))
+	})
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldStatus, p.EQ(v))
+	})
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldStatus, p.NEQ(v))
+	})
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldStatus, p.Within(v...))
+	})
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldStatus, p.Without(v...))
+	})
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPriority, p.EQ(v))
+	})
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPriority, p.NEQ(v))
+	})
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPriority, p.Within(v...))
+	})
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPriority, p.Without(v...))
+	})
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPriority, p.GT(v))
+	})
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPriority, p.GTE(v))
+	})
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPriority, p.LT(v))
+	})
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPriority, p.LTE(v))
+	})
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.EQ(v))
+	})
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.NEQ(v))
+	})
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.Within(v...))
+	})
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.Without(v...))
+	})
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.GT(v))
+	})
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.GTE(v))
+	})
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.LT(v))
+	})
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.LTE(v))
+	})
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.Containing(v))
+	})
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.StartingWith(v))
+	})
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldText, p.EndingWith(v))
+	})
+}
+
+// BlobEQ applies the EQ predicate on the "blob" field.
+func BlobEQ(v []byte) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBlob, p.EQ(v))
+	})
+}
+
+// BlobNEQ applies the NEQ predicate on the "blob" field.
+func BlobNEQ(v []byte) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBlob, p.NEQ(v))
+	})
+}
+
+// BlobIn applies the In predicate on the "blob" field.
+func BlobIn(vs ...[]byte) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBlob, p.Within(v...))
+	})
+}
+
+// BlobNotIn applies the NotIn predicate on the "blob" field.
+func BlobNotIn(vs ...[]byte) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBlob, p.Without(v...))
+	})
+}
+
+// BlobGT applies the GT predicate on the "blob" field.
+func BlobGT(v []byte) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBlob, p.GT(v))
+	})
+}
+
+// BlobGTE applies the GTE predicate on the "blob" field.
+func BlobGTE(v []byte) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBlob, p.GTE(v))
+	})
+}
+
+// BlobLT applies the LT predicate on the "blob" field.
+func BlobLT(v []byte) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBlob, p.LT(v))
+	})
+}
+
+// BlobLTE applies the LTE predicate on the "blob" field.
+func BlobLTE(v []byte) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldBlob, p.LTE(v))
+	})
+}
+
+// BlobIsNil applies the IsNil predicate on the "blob" field.
+func BlobIsNil() predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.HasLabel(Label).HasNot(FieldBlob)
+	})
+}
+
+// BlobNotNil applies the NotNil predicate on the "blob" field.
+func BlobNotNil() predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.HasLabel(Label).Has(FieldBlob)
+	})
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDeletedAt, p.EQ(v))
+	})
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDeletedAt, p.NEQ(v))
+	})
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDeletedAt, p.Within(v...))
+	})
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Todo {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDeletedAt, p.Without(v...))
+	})
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDeletedAt, p.GT(v))
+	})
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDeletedAt, p.GTE(v))
+	})
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDeletedAt, p.LT(v))
+	})
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDeletedAt, p.LTE(v))
+	})
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.HasLabel(Label).HasNot(FieldDeletedAt)
+	})
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.HasLabel(Label).Has(FieldDeletedAt)
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.InE(ParentInverseLabel).InV()
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Todo) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		tr := __.OutV()
+		for _, p := range preds {
+			p(tr)
+		}
+		t.InE(ParentInverseLabel).Where(tr).InV()
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.OutE(ChildrenLabel).OutV()
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.Todo) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		tr := __.InV()
+		for _, p := range preds {
+			p(tr)
+		}
+		t.OutE(ChildrenLabel).Where(tr).OutV()
+	})
+}
+
+// HasCategory applies the HasEdge predicate on the "category" edge.
+func HasCategory() predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.InE(CategoryInverseLabel).InV()
+	})
+}
+
+// HasCategoryWith applies the HasEdge predicate on the "category" edge with a given conditions (other predicates).
+func HasCategoryWith(preds ...predicate.Category) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		tr := __.OutV()
+		for _, p := range preds {
+			p(tr)
+		}
+		t.InE(CategoryInverseLabel).Where(tr).InV()
+	})
+}
+
+// HasSecret applies the HasEdge predicate on the "secret" edge.
+func HasSecret() predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		t.OutE(SecretLabel).OutV()
+	})
+}
+
+// HasSecretWith applies the HasEdge predicate on the "secret" edge with a given conditions (other predicates).
+func HasSecretWith(preds ...predicate.VerySecret) predicate.Todo {
+	return predicate.Todo(func(t *dsl.Traversal) {
+		tr := __.InV()
+		for _, p := range preds {
+			p(tr)
+		}
+		t.OutE(SecretLabel).Where(tr).OutV()
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Todo) predicate.Todo {
+	return predicate.Todo(func(tr *dsl.Traversal) {
+		trs := make([]interface{}, 0, len(predicates))
+		for _, p := range predicates {
+			t := __.New()
+			p(t)
+			trs = append(trs, t)
+		}
+		tr.Where(__.And(trs...))
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Todo) predicate.Todo {
+	return predicate.Todo(func(tr *dsl.Traversal) {
+		trs := make([]interface{}, 0, len(predicates))
+		for _, p := range predicates {
+			t := __.New()
+			p(t)
+			trs = append(trs, t)
+		}
+		tr.Where(__.Or(trs...))
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Todo) predicate.Todo {
+	return predicate.Todo(func(tr *dsl.Traversal) {
+		t := __.New()
+		p(t)
+		tr.Where(__.Not(t))
+	})
+}
@@ -0,0 +1,352 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/category"
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/todo"
+	"entgo.io/ent/dialect/gremlin"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
+)
+
+// TodoCreate is the builder for creating a Todo entity.
+type TodoCreate struct {
+	config
+	mutation *TodoMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tc *TodoCreate) SetCreatedAt(t time.Time) *TodoCreate {
+	tc.mutation.SetCreatedAt(t)
+	return tc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableCreatedAt(t *time.Time) *TodoCreate {
+	if t != nil {
+		tc.SetCreatedAt(*t)
+	}
+	return tc
+}
+
+// SetStatus sets the "status" field.
+func (tc *TodoCreate) SetStatus(t todo.Status) *TodoCreate {
+	tc.mutation.SetStatus(t)
+	return tc
+}
+
+// SetPriority sets the "priority" field.
+func (tc *TodoCreate) SetPriority(i int) *TodoCreate {
+	tc.mutation.SetPriority(i)
+	return tc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tc *TodoCreate) SetNillablePriority(i *int) *TodoCreate {
+	if i != nil {
+		tc.SetPriority(*i)
+	}
+	return tc
+}
+
+// SetText sets the "text" field.
+func (tc *TodoCreate) SetText(s string) *TodoCreate {
+	tc.mutation.SetText(s)
+	return tc
+}
+
+// SetBlob sets the "blob" field.
+func (tc *TodoCreate) SetBlob(b []byte) *TodoCreate {
+	tc.mutation.SetBlob(b)
+	return tc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tc *TodoCreate) SetDeletedAt(t time.Time) *TodoCreate {
+	tc.mutation.SetDeletedAt(t)
+	return tc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tc *TodoCreate) SetNillableDeletedAt(t *time.Time) *TodoCreate {
+	if t != nil {
+		tc.SetDeletedAt(*t)
+	}
+	return tc
+}
+
+// SetParentID sets the "parent" edge to the Todo entity by ID.
+func (tc *TodoCreate) SetParentID(id string) *TodoCreate {
+	tc.mutation.SetParentID(id)
+	return tc
+}
+
+// SetNillableParentID sets the "parent" edge to the Todo entity by ID if the given value is not nil.
+func (tc *TodoCreate) SetNillableParentID(id *string) *TodoCreate {
+	if id != nil {
+		tc = tc.SetParentID(*id)
+	}
+	return tc
+}
+
+// SetParent sets the "parent" edge to the Todo entity.
+func (tc *TodoCreate) SetParent(t *Todo) *TodoCreate {
+	return tc.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Todo entity by IDs.
+func (tc *TodoCreate) AddChildIDs(ids ...string) *TodoCreate {
+	tc.mutation.AddChildIDs(ids...)
+	return tc
+}
+
+// AddChildren adds the "children" edges to the Todo entity.
+func (tc *TodoCreate) AddChildren(t ...*Todo) *TodoCreate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddChildIDs(ids...)
+}
+
+// SetCategoryID sets the "category" edge to the Category entity by ID.
+func (tc *TodoCreate) SetCategoryID(id string) *TodoCreate {
+	tc.mutation.SetCategoryID(id)
+	return tc
+}
+
+// SetNillableCategoryID sets the "category" edge to the Category entity by ID if the given value is not nil.
+func (tc *TodoCreate) SetNillableCategoryID(id *string) *TodoCreate {
+	if id != nil {
+		tc = tc.SetCategoryID(*id)
+	}
+	return tc
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (tc *TodoCreate) SetCategory(c *Category) *TodoCreate {
+	return tc.SetCategoryID(c.ID)
+}
+
+// SetSecretID sets the "secret" edge to the VerySecret entity by ID.
+func (tc *TodoCreate) SetSecretID(id string) *TodoCreate {
+	tc.mutation.SetSecretID(id)
+	return tc
+}
+
+// SetNillableSecretID sets the "secret" edge to the VerySecret entity by ID if the given value is not nil.
+func (tc *TodoCreate) SetNillableSecretID(id *string) *TodoCreate {
+	if id != nil {
+		tc = tc.SetSecretID(*id)
+	}
+	return tc
+}
+
+// SetSecret sets the "secret" edge to the VerySecret entity.
+func (tc *TodoCreate) SetSecret(v *VerySecret) *TodoCreate {
+	return tc.SetSecretID(v.ID)
+}
+
+// Mutation returns the TodoMutation object of the builder.
+func (tc *TodoCreate) Mutation() *TodoMutation {
+	return tc.mutation
+}
+
+// Save creates the Todo in the database.
+func (tc *TodoCreate) Save(ctx context.Context) (*Todo, error) {
+	var (
+		err  error
+		node *Todo
+	)
+	tc.defaults()
+	if len(tc.hooks) == 0 {
+		if err = tc.check(); err != nil {
+			return nil, err
+		}
+		node, err = tc.gremlinSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TodoMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tc.check(); err != nil {
+				return nil, err
+			}
+			tc.mutation = mutation
+			if node, err = tc.gremlinSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tc.hooks) - 1; i >= 0; i-- {
+			if tc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *TodoCreate) SaveX(ctx context.Context) *Todo {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tc *TodoCreate) Exec(ctx context.Context) error {
+	_, err := tc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tc *TodoCreate) ExecX(ctx context.Context) {
+	if err := tc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tc *TodoCreate) defaults() {
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		v := todo.DefaultCreatedAt()
+		tc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tc.mutation.Priority(); !ok {
+		v := todo.DefaultPriority
+		tc.mutation.SetPriority(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tc *TodoCreate) check() error {
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "created_at"`)}
+	}
+	if _, ok := tc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "status"`)}
+	}
+	if v, ok := tc.mutation.Status(); ok {
+		if err := todo.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "status": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "priority"`)}
+	}
+	if _, ok := tc.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "text"`)}
+	}
+	if v, ok := tc.mutation.Text(); ok {
+		if err := todo.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "text": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tc *TodoCreate) gremlinSave(ctx context.Context) (*Todo, error) {
+	res := &gremlin.Response{}
+	query, bindings := tc.gremlin().Query()
+	if err := tc.driver.Exec(ctx, query, bindings, res); err != nil {
+		return nil, err
+	}
+	if err, ok := isConstantError(res); ok {
+		return nil, err
+	}
+	t := &Todo{config: tc.config}
+	if err := t.FromResponse(res); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (tc *TodoCreate) gremlin() *dsl.Traversal {
+	type constraint struct {
+		pred *dsl.Traversal // constraint predicate.
+		test *dsl.Traversal // test matches and its constant.
+	}
+	constraints := make([]*constraint, 0, 1)
+	v := g.AddV(todo.Label)
+	if value, ok := tc.mutation.CreatedAt(); ok {
+		v.Property(dsl.Single, todo.FieldCreatedAt, value)
+	}
+	if value, ok := tc.mutation.Status(); ok {
+		v.Property(dsl.Single, todo.FieldStatus, value)
+	}
+	if value, ok := tc.mutation.Priority(); ok {
+		v.Property(dsl.Single, todo.FieldPriority, value)
+	}
+	if value, ok := tc.mutation.Text(); ok {
+		v.Property(dsl.Single, todo.FieldText, value)
+	}
+	if value, ok := tc.mutation.Blob(); ok {
+		v.Property(dsl.Single, todo.FieldBlob, value)
+	}
+	if value, ok := tc.mutation.DeletedAt(); ok {
+		v.Property(dsl.Single, todo.FieldDeletedAt, value)
+	}
+	for _, id := range tc.mutation.ParentIDs() {
+		v.AddE(todo.ChildrenLabel).From(g.V(id)).InV()
+	}
+	for _, id := range tc.mutation.ChildrenIDs() {
+		v.AddE(todo.ChildrenLabel).To(g.V(id)).OutV()
+		constraints = append(constraints, &constraint{
+			pred: g.E().HasLabel(todo.ChildrenLabel).InV().HasID(id).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(todo.Label, todo.ChildrenLabel, id)),
+		})
+	}
+	for _, id := range tc.mutation.CategoryIDs() {
+		v.AddE(category.TodosLabel).From(g.V(id)).InV()
+	}
+	for _, id := range tc.mutation.SecretIDs() {
+		v.AddE(todo.SecretLabel).To(g.V(id)).OutV()
+	}
+	if len(constraints) == 0 {
+		return v.ValueMap(true)
+	}
+	tr := constraints[0].pred.Coalesce(constraints[0].test, v.ValueMap(true))
+	for _, cr := range constraints[1:] {
+		tr = cr.pred.Coalesce(cr.test, tr)
+	}
+	return tr
+}
+
+// TodoCreateBulk is the builder for creating many Todo entities in bulk.
+type TodoCreateBulk struct {
+	config
+	builders []*TodoCreate
+}
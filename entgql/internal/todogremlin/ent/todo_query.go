@@ -0,0 +1,1008 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/category"
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/predicate"
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/todo"
+	"entgo.io/ent/dialect/gremlin"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+)
+
+// TodoQuery is the builder for querying Todo entities.
+type TodoQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.Todo
+	// eager-loading edges.
+	withParent   *TodoQuery
+	withChildren *TodoQuery
+	withCategory *CategoryQuery
+	withSecret   *VerySecretQuery
+	// intermediate query (i.e. traversal path).
+	gremlin *dsl.Traversal
+	path    func(context.Context) (*dsl.Traversal, error)
+}
+
+// Where adds a new predicate for the TodoQuery builder.
+func (tq *TodoQuery) Where(ps ...predicate.Todo) *TodoQuery {
+	tq.predicates = append(tq.predicates, ps...)
+	return tq
+}
+
+// Limit adds a limit step to the query.
+func (tq *TodoQuery) Limit(limit int) *TodoQuery {
+	tq.limit = &limit
+	return tq
+}
+
+// Offset adds an offset step to the query.
+func (tq *TodoQuery) Offset(offset int) *TodoQuery {
+	tq.offset = &offset
+	return tq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tq *TodoQuery) Unique(unique bool) *TodoQuery {
+	tq.unique = &unique
+	return tq
+}
+
+// Order adds an order step to the query.
+func (tq *TodoQuery) Order(o ...OrderFunc) *TodoQuery {
+	tq.order = append(tq.order, o...)
+	return tq
+}
+
+// QueryParent chains the current query on the "parent" edge.
+func (tq *TodoQuery) QueryParent() *TodoQuery {
+	query := &TodoQuery{config: tq.config}
+	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		gremlin := tq.gremlinQuery(ctx)
+		fromU = gremlin.InE(todo.ChildrenLabel).OutV()
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the "children" edge.
+func (tq *TodoQuery) QueryChildren() *TodoQuery {
+	query := &TodoQuery{config: tq.config}
+	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		gremlin := tq.gremlinQuery(ctx)
+		fromU = gremlin.OutE(todo.ChildrenLabel).InV()
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCategory chains the current query on the "category" edge.
+func (tq *TodoQuery) QueryCategory() *CategoryQuery {
+	query := &CategoryQuery{config: tq.config}
+	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		gremlin := tq.gremlinQuery(ctx)
+		fromU = gremlin.InE(category.TodosLabel).OutV()
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySecret chains the current query on the "secret" edge.
+func (tq *TodoQuery) QuerySecret() *VerySecretQuery {
+	query := &VerySecretQuery{config: tq.config}
+	query.path = func(ctx context.Context) (fromU *dsl.Traversal, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		gremlin := tq.gremlinQuery(ctx)
+		fromU = gremlin.OutE(todo.SecretLabel).InV()
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Todo entity from the query.
+// Returns a *NotFoundError when no Todo was found.
+func (tq *TodoQuery) First(ctx context.Context) (*Todo, error) {
+	nodes, err := tq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{todo.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tq *TodoQuery) FirstX(ctx context.Context) *Todo {
+	node, err := tq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Todo ID from the query.
+// Returns a *NotFoundError when no Todo ID was found.
+func (tq *TodoQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = tq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{todo.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tq *TodoQuery) FirstIDX(ctx context.Context) string {
+	id, err := tq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Todo entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one Todo entity is not found.
+// Returns a *NotFoundError when no Todo entities are found.
+func (tq *TodoQuery) Only(ctx context.Context) (*Todo, error) {
+	nodes, err := tq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{todo.Label}
+	default:
+		return nil, &NotSingularError{todo.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tq *TodoQuery) OnlyX(ctx context.Context) *Todo {
+	node, err := tq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Todo ID in the query.
+// Returns a *NotSingularError when exactly one Todo ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (tq *TodoQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = tq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{todo.Label}
+	default:
+		err = &NotSingularError{todo.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tq *TodoQuery) OnlyIDX(ctx context.Context) string {
+	id, err := tq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Todos.
+func (tq *TodoQuery) All(ctx context.Context) ([]*Todo, error) {
+	if err := tq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return tq.gremlinAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tq *TodoQuery) AllX(ctx context.Context) []*Todo {
+	nodes, err := tq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Todo IDs.
+func (tq *TodoQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := tq.Select(todo.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tq *TodoQuery) IDsX(ctx context.Context) []string {
+	ids, err := tq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tq *TodoQuery) Count(ctx context.Context) (int, error) {
+	if err := tq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return tq.gremlinCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tq *TodoQuery) CountX(ctx context.Context) int {
+	count, err := tq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tq *TodoQuery) Exist(ctx context.Context) (bool, error) {
+	if err := tq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return tq.gremlinExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tq *TodoQuery) ExistX(ctx context.Context) bool {
+	exist, err := tq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TodoQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tq *TodoQuery) Clone() *TodoQuery {
+	if tq == nil {
+		return nil
+	}
+	return &TodoQuery{
+		config:       tq.config,
+		limit:        tq.limit,
+		offset:       tq.offset,
+		order:        append([]OrderFunc{}, tq.order...),
+		predicates:   append([]predicate.Todo{}, tq.predicates...),
+		withParent:   tq.withParent.Clone(),
+		withChildren: tq.withChildren.Clone(),
+		withCategory: tq.withCategory.Clone(),
+		withSecret:   tq.withSecret.Clone(),
+		// clone intermediate query.
+		gremlin: tq.gremlin.Clone(),
+		path:    tq.path,
+	}
+}
+
+// WithParent tells the query-builder to eager-load the nodes that are connected to
+// the "parent" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TodoQuery) WithParent(opts ...func(*TodoQuery)) *TodoQuery {
+	query := &TodoQuery{config: tq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withParent = query
+	return tq
+}
+
+// WithChildren tells the query-builder to eager-load the nodes that are connected to
+// the "children" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TodoQuery) WithChildren(opts ...func(*TodoQuery)) *TodoQuery {
+	query := &TodoQuery{config: tq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withChildren = query
+	return tq
+}
+
+// WithCategory tells the query-builder to eager-load the nodes that are connected to
+// the "category" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TodoQuery) WithCategory(opts ...func(*CategoryQuery)) *TodoQuery {
+	query := &CategoryQuery{config: tq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withCategory = query
+	return tq
+}
+
+// WithSecret tells the query-builder to eager-load the nodes that are connected to
+// the "secret" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TodoQuery) WithSecret(opts ...func(*VerySecretQuery)) *TodoQuery {
+	query := &VerySecretQuery{config: tq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withSecret = query
+	return tq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Todo.Query().
+//		GroupBy(todo.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (tq *TodoQuery) GroupBy(field string, fields ...string) *TodoGroupBy {
+	group := &TodoGroupBy{config: tq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *dsl.Traversal, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return tq.gremlinQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.Todo.Query().
+//		Select(todo.FieldCreatedAt).
+//		Scan(ctx, &v)
+//
+func (tq *TodoQuery) Select(fields ...string) *TodoSelect {
+	tq.fields = append(tq.fields, fields...)
+	return &TodoSelect{TodoQuery: tq}
+}
+
+func (tq *TodoQuery) prepareQuery(ctx context.Context) error {
+	if tq.path != nil {
+		prev, err := tq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tq.gremlin = prev
+	}
+	return nil
+}
+
+func (tq *TodoQuery) gremlinAll(ctx context.Context) ([]*Todo, error) {
+	res := &gremlin.Response{}
+	traversal := tq.gremlinQuery(ctx)
+	if len(tq.fields) > 0 {
+		fields := make([]interface{}, len(tq.fields))
+		for i, f := range tq.fields {
+			fields[i] = f
+		}
+		traversal.ValueMap(fields...)
+	} else {
+		traversal.ValueMap(true)
+	}
+	query, bindings := traversal.Query()
+	if err := tq.driver.Exec(ctx, query, bindings, res); err != nil {
+		return nil, err
+	}
+	var ts Todos
+	if err := ts.FromResponse(res); err != nil {
+		return nil, err
+	}
+	ts.config(tq.config)
+	return ts, nil
+}
+
+func (tq *TodoQuery) gremlinCount(ctx context.Context) (int, error) {
+	res := &gremlin.Response{}
+	query, bindings := tq.gremlinQuery(ctx).Count().Query()
+	if err := tq.driver.Exec(ctx, query, bindings, res); err != nil {
+		return 0, err
+	}
+	return res.ReadInt()
+}
+
+func (tq *TodoQuery) gremlinExist(ctx context.Context) (bool, error) {
+	res := &gremlin.Response{}
+	query, bindings := tq.gremlinQuery(ctx).HasNext().Query()
+	if err := tq.driver.Exec(ctx, query, bindings, res); err != nil {
+		return false, err
+	}
+	return res.ReadBool()
+}
+
+func (tq *TodoQuery) gremlinQuery(context.Context) *dsl.Traversal {
+	v := g.V().HasLabel(todo.Label)
+	if tq.gremlin != nil {
+		v = tq.gremlin.Clone()
+	}
+	for _, p := range tq.predicates {
+		p(v)
+	}
+	if len(tq.order) > 0 {
+		v.Order()
+		for _, p := range tq.order {
+			p(v)
+		}
+	}
+	switch limit, offset := tq.limit, tq.offset; {
+	case limit != nil && offset != nil:
+		v.Range(*offset, *offset+*limit)
+	case offset != nil:
+		v.Range(*offset, math.MaxInt32)
+	case limit != nil:
+		v.Limit(*limit)
+	}
+	if unique := tq.unique; unique == nil || *unique {
+		v.Dedup()
+	}
+	return v
+}
+
+// TodoGroupBy is the group-by builder for Todo entities.
+type TodoGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	gremlin *dsl.Traversal
+	path    func(context.Context) (*dsl.Traversal, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tgb *TodoGroupBy) Aggregate(fns ...AggregateFunc) *TodoGroupBy {
+	tgb.fns = append(tgb.fns, fns...)
+	return tgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (tgb *TodoGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := tgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	tgb.gremlin = query
+	return tgb.gremlinScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (tgb *TodoGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := tgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TodoGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(tgb.fields) > 1 {
+		return nil, errors.New("ent: TodoGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := tgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (tgb *TodoGroupBy) StringsX(ctx context.Context) []string {
+	v, err := tgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TodoGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = tgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todo.Label}
+	default:
+		err = fmt.Errorf("ent: TodoGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (tgb *TodoGroupBy) StringX(ctx context.Context) string {
+	v, err := tgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TodoGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(tgb.fields) > 1 {
+		return nil, errors.New("ent: TodoGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := tgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (tgb *TodoGroupBy) IntsX(ctx context.Context) []int {
+	v, err := tgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TodoGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = tgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todo.Label}
+	default:
+		err = fmt.Errorf("ent: TodoGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (tgb *TodoGroupBy) IntX(ctx context.Context) int {
+	v, err := tgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TodoGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(tgb.fields) > 1 {
+		return nil, errors.New("ent: TodoGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := tgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (tgb *TodoGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := tgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TodoGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = tgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todo.Label}
+	default:
+		err = fmt.Errorf("ent: TodoGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (tgb *TodoGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := tgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TodoGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(tgb.fields) > 1 {
+		return nil, errors.New("ent: TodoGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := tgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (tgb *TodoGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := tgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (tgb *TodoGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = tgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todo.Label}
+	default:
+		err = fmt.Errorf("ent: TodoGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (tgb *TodoGroupBy) BoolX(ctx context.Context) bool {
+	v, err := tgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (tgb *TodoGroupBy) gremlinScan(ctx context.Context, v interface{}) error {
+	res := &gremlin.Response{}
+	query, bindings := tgb.gremlinQuery().Query()
+	if err := tgb.driver.Exec(ctx, query, bindings, res); err != nil {
+		return err
+	}
+	if len(tgb.fields)+len(tgb.fns) == 1 {
+		return res.ReadVal(v)
+	}
+	vm, err := res.ReadValueMap()
+	if err != nil {
+		return err
+	}
+	return vm.Decode(v)
+}
+
+func (tgb *TodoGroupBy) gremlinQuery() *dsl.Traversal {
+	var (
+		trs   []interface{}
+		names []interface{}
+	)
+	for _, fn := range tgb.fns {
+		name, tr := fn("p", "")
+		trs = append(trs, tr)
+		names = append(names, name)
+	}
+	for _, f := range tgb.fields {
+		names = append(names, f)
+		trs = append(trs, __.As("p").Unfold().Values(f).As(f))
+	}
+	return tgb.gremlin.Group().
+		By(__.Values(tgb.fields...).Fold()).
+		By(__.Fold().Match(trs...).Select(names...)).
+		Select(dsl.Values).
+		Next()
+}
+
+// TodoSelect is the builder for selecting fields of Todo entities.
+type TodoSelect struct {
+	*TodoQuery
+	// intermediate query (i.e. traversal path).
+	gremlin *dsl.Traversal
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ts *TodoSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := ts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	ts.gremlin = ts.TodoQuery.gremlinQuery(ctx)
+	return ts.gremlinScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ts *TodoSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ts.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (ts *TodoSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ts.fields) > 1 {
+		return nil, errors.New("ent: TodoSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ts *TodoSelect) StringsX(ctx context.Context) []string {
+	v, err := ts.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (ts *TodoSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = ts.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todo.Label}
+	default:
+		err = fmt.Errorf("ent: TodoSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (ts *TodoSelect) StringX(ctx context.Context) string {
+	v, err := ts.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (ts *TodoSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ts.fields) > 1 {
+		return nil, errors.New("ent: TodoSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ts *TodoSelect) IntsX(ctx context.Context) []int {
+	v, err := ts.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (ts *TodoSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = ts.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todo.Label}
+	default:
+		err = fmt.Errorf("ent: TodoSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (ts *TodoSelect) IntX(ctx context.Context) int {
+	v, err := ts.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (ts *TodoSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ts.fields) > 1 {
+		return nil, errors.New("ent: TodoSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ts *TodoSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ts.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (ts *TodoSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = ts.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todo.Label}
+	default:
+		err = fmt.Errorf("ent: TodoSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (ts *TodoSelect) Float64X(ctx context.Context) float64 {
+	v, err := ts.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (ts *TodoSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ts.fields) > 1 {
+		return nil, errors.New("ent: TodoSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ts *TodoSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ts.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (ts *TodoSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = ts.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{todo.Label}
+	default:
+		err = fmt.Errorf("ent: TodoSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (ts *TodoSelect) BoolX(ctx context.Context) bool {
+	v, err := ts.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ts *TodoSelect) gremlinScan(ctx context.Context, v interface{}) error {
+	var (
+		traversal *dsl.Traversal
+		res       = &gremlin.Response{}
+	)
+	if len(ts.fields) == 1 {
+		if ts.fields[0] != todo.FieldID {
+			traversal = ts.gremlin.Values(ts.fields...)
+		} else {
+			traversal = ts.gremlin.ID()
+		}
+	} else {
+		fields := make([]interface{}, len(ts.fields))
+		for i, f := range ts.fields {
+			fields[i] = f
+		}
+		traversal = ts.gremlin.ValueMap(fields...)
+	}
+	query, bindings := traversal.Query()
+	if err := ts.driver.Exec(ctx, query, bindings, res); err != nil {
+		return err
+	}
+	if len(ts.fields) == 1 {
+		return res.ReadVal(v)
+	}
+	vm, err := res.ReadValueMap()
+	if err != nil {
+		return err
+	}
+	return vm.Decode(v)
+}
@@ -0,0 +1,788 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/category"
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/predicate"
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/todo"
+	"entgo.io/ent/dialect/gremlin"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
+)
+
+// TodoUpdate is the builder for updating Todo entities.
+type TodoUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TodoMutation
+}
+
+// Where appends a list predicates to the TodoUpdate builder.
+func (tu *TodoUpdate) Where(ps ...predicate.Todo) *TodoUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetStatus sets the "status" field.
+func (tu *TodoUpdate) SetStatus(t todo.Status) *TodoUpdate {
+	tu.mutation.SetStatus(t)
+	return tu
+}
+
+// SetPriority sets the "priority" field.
+func (tu *TodoUpdate) SetPriority(i int) *TodoUpdate {
+	tu.mutation.ResetPriority()
+	tu.mutation.SetPriority(i)
+	return tu
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillablePriority(i *int) *TodoUpdate {
+	if i != nil {
+		tu.SetPriority(*i)
+	}
+	return tu
+}
+
+// AddPriority adds i to the "priority" field.
+func (tu *TodoUpdate) AddPriority(i int) *TodoUpdate {
+	tu.mutation.AddPriority(i)
+	return tu
+}
+
+// SetText sets the "text" field.
+func (tu *TodoUpdate) SetText(s string) *TodoUpdate {
+	tu.mutation.SetText(s)
+	return tu
+}
+
+// SetBlob sets the "blob" field.
+func (tu *TodoUpdate) SetBlob(b []byte) *TodoUpdate {
+	tu.mutation.SetBlob(b)
+	return tu
+}
+
+// ClearBlob clears the value of the "blob" field.
+func (tu *TodoUpdate) ClearBlob() *TodoUpdate {
+	tu.mutation.ClearBlob()
+	return tu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tu *TodoUpdate) SetDeletedAt(t time.Time) *TodoUpdate {
+	tu.mutation.SetDeletedAt(t)
+	return tu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tu *TodoUpdate) SetNillableDeletedAt(t *time.Time) *TodoUpdate {
+	if t != nil {
+		tu.SetDeletedAt(*t)
+	}
+	return tu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tu *TodoUpdate) ClearDeletedAt() *TodoUpdate {
+	tu.mutation.ClearDeletedAt()
+	return tu
+}
+
+// SetParentID sets the "parent" edge to the Todo entity by ID.
+func (tu *TodoUpdate) SetParentID(id string) *TodoUpdate {
+	tu.mutation.SetParentID(id)
+	return tu
+}
+
+// SetNillableParentID sets the "parent" edge to the Todo entity by ID if the given value is not nil.
+func (tu *TodoUpdate) SetNillableParentID(id *string) *TodoUpdate {
+	if id != nil {
+		tu = tu.SetParentID(*id)
+	}
+	return tu
+}
+
+// SetParent sets the "parent" edge to the Todo entity.
+func (tu *TodoUpdate) SetParent(t *Todo) *TodoUpdate {
+	return tu.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Todo entity by IDs.
+func (tu *TodoUpdate) AddChildIDs(ids ...string) *TodoUpdate {
+	tu.mutation.AddChildIDs(ids...)
+	return tu
+}
+
+// AddChildren adds the "children" edges to the Todo entity.
+func (tu *TodoUpdate) AddChildren(t ...*Todo) *TodoUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddChildIDs(ids...)
+}
+
+// SetCategoryID sets the "category" edge to the Category entity by ID.
+func (tu *TodoUpdate) SetCategoryID(id string) *TodoUpdate {
+	tu.mutation.SetCategoryID(id)
+	return tu
+}
+
+// SetNillableCategoryID sets the "category" edge to the Category entity by ID if the given value is not nil.
+func (tu *TodoUpdate) SetNillableCategoryID(id *string) *TodoUpdate {
+	if id != nil {
+		tu = tu.SetCategoryID(*id)
+	}
+	return tu
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (tu *TodoUpdate) SetCategory(c *Category) *TodoUpdate {
+	return tu.SetCategoryID(c.ID)
+}
+
+// SetSecretID sets the "secret" edge to the VerySecret entity by ID.
+func (tu *TodoUpdate) SetSecretID(id string) *TodoUpdate {
+	tu.mutation.SetSecretID(id)
+	return tu
+}
+
+// SetNillableSecretID sets the "secret" edge to the VerySecret entity by ID if the given value is not nil.
+func (tu *TodoUpdate) SetNillableSecretID(id *string) *TodoUpdate {
+	if id != nil {
+		tu = tu.SetSecretID(*id)
+	}
+	return tu
+}
+
+// SetSecret sets the "secret" edge to the VerySecret entity.
+func (tu *TodoUpdate) SetSecret(v *VerySecret) *TodoUpdate {
+	return tu.SetSecretID(v.ID)
+}
+
+// Mutation returns the TodoMutation object of the builder.
+func (tu *TodoUpdate) Mutation() *TodoMutation {
+	return tu.mutation
+}
+
+// ClearParent clears the "parent" edge to the Todo entity.
+func (tu *TodoUpdate) ClearParent() *TodoUpdate {
+	tu.mutation.ClearParent()
+	return tu
+}
+
+// ClearChildren clears all "children" edges to the Todo entity.
+func (tu *TodoUpdate) ClearChildren() *TodoUpdate {
+	tu.mutation.ClearChildren()
+	return tu
+}
+
+// RemoveChildIDs removes the "children" edge to Todo entities by IDs.
+func (tu *TodoUpdate) RemoveChildIDs(ids ...string) *TodoUpdate {
+	tu.mutation.RemoveChildIDs(ids...)
+	return tu
+}
+
+// RemoveChildren removes "children" edges to Todo entities.
+func (tu *TodoUpdate) RemoveChildren(t ...*Todo) *TodoUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveChildIDs(ids...)
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (tu *TodoUpdate) ClearCategory() *TodoUpdate {
+	tu.mutation.ClearCategory()
+	return tu
+}
+
+// ClearSecret clears the "secret" edge to the VerySecret entity.
+func (tu *TodoUpdate) ClearSecret() *TodoUpdate {
+	tu.mutation.ClearSecret()
+	return tu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TodoUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(tu.hooks) == 0 {
+		if err = tu.check(); err != nil {
+			return 0, err
+		}
+		affected, err = tu.gremlinSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TodoMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tu.check(); err != nil {
+				return 0, err
+			}
+			tu.mutation = mutation
+			affected, err = tu.gremlinSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(tu.hooks) - 1; i >= 0; i-- {
+			if tu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TodoUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TodoUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TodoUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TodoUpdate) check() error {
+	if v, ok := tu.mutation.Status(); ok {
+		if err := todo.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf("ent: validator failed for field \"status\": %w", err)}
+		}
+	}
+	if v, ok := tu.mutation.Text(); ok {
+		if err := todo.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf("ent: validator failed for field \"text\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (tu *TodoUpdate) gremlinSave(ctx context.Context) (int, error) {
+	res := &gremlin.Response{}
+	query, bindings := tu.gremlin().Query()
+	if err := tu.driver.Exec(ctx, query, bindings, res); err != nil {
+		return 0, err
+	}
+	if err, ok := isConstantError(res); ok {
+		return 0, err
+	}
+	return res.ReadInt()
+}
+
+func (tu *TodoUpdate) gremlin() *dsl.Traversal {
+	type constraint struct {
+		pred *dsl.Traversal // constraint predicate.
+		test *dsl.Traversal // test matches and its constant.
+	}
+	constraints := make([]*constraint, 0, 1)
+	v := g.V().HasLabel(todo.Label)
+	for _, p := range tu.mutation.predicates {
+		p(v)
+	}
+	var (
+		rv = v.Clone()
+		_  = rv
+
+		trs []*dsl.Traversal
+	)
+	if value, ok := tu.mutation.Status(); ok {
+		v.Property(dsl.Single, todo.FieldStatus, value)
+	}
+	if value, ok := tu.mutation.Priority(); ok {
+		v.Property(dsl.Single, todo.FieldPriority, value)
+	}
+	if value, ok := tu.mutation.AddedPriority(); ok {
+		v.Property(dsl.Single, todo.FieldPriority, __.Union(__.Values(todo.FieldPriority), __.Constant(value)).Sum())
+	}
+	if value, ok := tu.mutation.Text(); ok {
+		v.Property(dsl.Single, todo.FieldText, value)
+	}
+	if value, ok := tu.mutation.Blob(); ok {
+		v.Property(dsl.Single, todo.FieldBlob, value)
+	}
+	if value, ok := tu.mutation.DeletedAt(); ok {
+		v.Property(dsl.Single, todo.FieldDeletedAt, value)
+	}
+	var properties []interface{}
+	if tu.mutation.BlobCleared() {
+		properties = append(properties, todo.FieldBlob)
+	}
+	if tu.mutation.DeletedAtCleared() {
+		properties = append(properties, todo.FieldDeletedAt)
+	}
+	if len(properties) > 0 {
+		v.SideEffect(__.Properties(properties...).Drop())
+	}
+	if tu.mutation.ParentCleared() {
+		tr := rv.Clone().InE(todo.ChildrenLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range tu.mutation.ParentIDs() {
+		v.AddE(todo.ChildrenLabel).From(g.V(id)).InV()
+	}
+	for _, id := range tu.mutation.RemovedChildrenIDs() {
+		tr := rv.Clone().OutE(todo.ChildrenLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range tu.mutation.ChildrenIDs() {
+		v.AddE(todo.ChildrenLabel).To(g.V(id)).OutV()
+		constraints = append(constraints, &constraint{
+			pred: g.E().HasLabel(todo.ChildrenLabel).InV().HasID(id).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(todo.Label, todo.ChildrenLabel, id)),
+		})
+	}
+	if tu.mutation.CategoryCleared() {
+		tr := rv.Clone().InE(category.TodosLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range tu.mutation.CategoryIDs() {
+		v.AddE(category.TodosLabel).From(g.V(id)).InV()
+	}
+	if tu.mutation.SecretCleared() {
+		tr := rv.Clone().OutE(todo.SecretLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range tu.mutation.SecretIDs() {
+		v.AddE(todo.SecretLabel).To(g.V(id)).OutV()
+	}
+	v.Count()
+	if len(constraints) > 0 {
+		constraints = append(constraints, &constraint{
+			pred: rv.Count(),
+			test: __.Is(p.GT(1)).Constant(&ConstraintError{msg: "update traversal contains more than one vertex"}),
+		})
+		v = constraints[0].pred.Coalesce(constraints[0].test, v)
+		for _, cr := range constraints[1:] {
+			v = cr.pred.Coalesce(cr.test, v)
+		}
+	}
+	trs = append(trs, v)
+	return dsl.Join(trs...)
+}
+
+// TodoUpdateOne is the builder for updating a single Todo entity.
+type TodoUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TodoMutation
+}
+
+// SetStatus sets the "status" field.
+func (tuo *TodoUpdateOne) SetStatus(t todo.Status) *TodoUpdateOne {
+	tuo.mutation.SetStatus(t)
+	return tuo
+}
+
+// SetPriority sets the "priority" field.
+func (tuo *TodoUpdateOne) SetPriority(i int) *TodoUpdateOne {
+	tuo.mutation.ResetPriority()
+	tuo.mutation.SetPriority(i)
+	return tuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillablePriority(i *int) *TodoUpdateOne {
+	if i != nil {
+		tuo.SetPriority(*i)
+	}
+	return tuo
+}
+
+// AddPriority adds i to the "priority" field.
+func (tuo *TodoUpdateOne) AddPriority(i int) *TodoUpdateOne {
+	tuo.mutation.AddPriority(i)
+	return tuo
+}
+
+// SetText sets the "text" field.
+func (tuo *TodoUpdateOne) SetText(s string) *TodoUpdateOne {
+	tuo.mutation.SetText(s)
+	return tuo
+}
+
+// SetBlob sets the "blob" field.
+func (tuo *TodoUpdateOne) SetBlob(b []byte) *TodoUpdateOne {
+	tuo.mutation.SetBlob(b)
+	return tuo
+}
+
+// ClearBlob clears the value of the "blob" field.
+func (tuo *TodoUpdateOne) ClearBlob() *TodoUpdateOne {
+	tuo.mutation.ClearBlob()
+	return tuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tuo *TodoUpdateOne) SetDeletedAt(t time.Time) *TodoUpdateOne {
+	tuo.mutation.SetDeletedAt(t)
+	return tuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableDeletedAt(t *time.Time) *TodoUpdateOne {
+	if t != nil {
+		tuo.SetDeletedAt(*t)
+	}
+	return tuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (tuo *TodoUpdateOne) ClearDeletedAt() *TodoUpdateOne {
+	tuo.mutation.ClearDeletedAt()
+	return tuo
+}
+
+// SetParentID sets the "parent" edge to the Todo entity by ID.
+func (tuo *TodoUpdateOne) SetParentID(id string) *TodoUpdateOne {
+	tuo.mutation.SetParentID(id)
+	return tuo
+}
+
+// SetNillableParentID sets the "parent" edge to the Todo entity by ID if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableParentID(id *string) *TodoUpdateOne {
+	if id != nil {
+		tuo = tuo.SetParentID(*id)
+	}
+	return tuo
+}
+
+// SetParent sets the "parent" edge to the Todo entity.
+func (tuo *TodoUpdateOne) SetParent(t *Todo) *TodoUpdateOne {
+	return tuo.SetParentID(t.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Todo entity by IDs.
+func (tuo *TodoUpdateOne) AddChildIDs(ids ...string) *TodoUpdateOne {
+	tuo.mutation.AddChildIDs(ids...)
+	return tuo
+}
+
+// AddChildren adds the "children" edges to the Todo entity.
+func (tuo *TodoUpdateOne) AddChildren(t ...*Todo) *TodoUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddChildIDs(ids...)
+}
+
+// SetCategoryID sets the "category" edge to the Category entity by ID.
+func (tuo *TodoUpdateOne) SetCategoryID(id string) *TodoUpdateOne {
+	tuo.mutation.SetCategoryID(id)
+	return tuo
+}
+
+// SetNillableCategoryID sets the "category" edge to the Category entity by ID if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableCategoryID(id *string) *TodoUpdateOne {
+	if id != nil {
+		tuo = tuo.SetCategoryID(*id)
+	}
+	return tuo
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (tuo *TodoUpdateOne) SetCategory(c *Category) *TodoUpdateOne {
+	return tuo.SetCategoryID(c.ID)
+}
+
+// SetSecretID sets the "secret" edge to the VerySecret entity by ID.
+func (tuo *TodoUpdateOne) SetSecretID(id string) *TodoUpdateOne {
+	tuo.mutation.SetSecretID(id)
+	return tuo
+}
+
+// SetNillableSecretID sets the "secret" edge to the VerySecret entity by ID if the given value is not nil.
+func (tuo *TodoUpdateOne) SetNillableSecretID(id *string) *TodoUpdateOne {
+	if id != nil {
+		tuo = tuo.SetSecretID(*id)
+	}
+	return tuo
+}
+
+// SetSecret sets the "secret" edge to the VerySecret entity.
+func (tuo *TodoUpdateOne) SetSecret(v *VerySecret) *TodoUpdateOne {
+	return tuo.SetSecretID(v.ID)
+}
+
+// Mutation returns the TodoMutation object of the builder.
+func (tuo *TodoUpdateOne) Mutation() *TodoMutation {
+	return tuo.mutation
+}
+
+// ClearParent clears the "parent" edge to the Todo entity.
+func (tuo *TodoUpdateOne) ClearParent() *TodoUpdateOne {
+	tuo.mutation.ClearParent()
+	return tuo
+}
+
+// ClearChildren clears all "children" edges to the Todo entity.
+func (tuo *TodoUpdateOne) ClearChildren() *TodoUpdateOne {
+	tuo.mutation.ClearChildren()
+	return tuo
+}
+
+// RemoveChildIDs removes the "children" edge to Todo entities by IDs.
+func (tuo *TodoUpdateOne) RemoveChildIDs(ids ...string) *TodoUpdateOne {
+	tuo.mutation.RemoveChildIDs(ids...)
+	return tuo
+}
+
+// RemoveChildren removes "children" edges to Todo entities.
+func (tuo *TodoUpdateOne) RemoveChildren(t ...*Todo) *TodoUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveChildIDs(ids...)
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (tuo *TodoUpdateOne) ClearCategory() *TodoUpdateOne {
+	tuo.mutation.ClearCategory()
+	return tuo
+}
+
+// ClearSecret clears the "secret" edge to the VerySecret entity.
+func (tuo *TodoUpdateOne) ClearSecret() *TodoUpdateOne {
+	tuo.mutation.ClearSecret()
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TodoUpdateOne) Select(field string, fields ...string) *TodoUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Todo entity.
+func (tuo *TodoUpdateOne) Save(ctx context.Context) (*Todo, error) {
+	var (
+		err  error
+		node *Todo
+	)
+	if len(tuo.hooks) == 0 {
+		if err = tuo.check(); err != nil {
+			return nil, err
+		}
+		node, err = tuo.gremlinSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*TodoMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = tuo.check(); err != nil {
+				return nil, err
+			}
+			tuo.mutation = mutation
+			node, err = tuo.gremlinSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(tuo.hooks) - 1; i >= 0; i-- {
+			if tuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = tuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TodoUpdateOne) SaveX(ctx context.Context) *Todo {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TodoUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TodoUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TodoUpdateOne) check() error {
+	if v, ok := tuo.mutation.Status(); ok {
+		if err := todo.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf("ent: validator failed for field \"status\": %w", err)}
+		}
+	}
+	if v, ok := tuo.mutation.Text(); ok {
+		if err := todo.TextValidator(v); err != nil {
+			return &ValidationError{Name: "text", err: fmt.Errorf("ent: validator failed for field \"text\": %w", err)}
+		}
+	}
+	return nil
+}
+
+func (tuo *TodoUpdateOne) gremlinSave(ctx context.Context) (*Todo, error) {
+	res := &gremlin.Response{}
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing Todo.ID for update")}
+	}
+	query, bindings := tuo.gremlin(id).Query()
+	if err := tuo.driver.Exec(ctx, query, bindings, res); err != nil {
+		return nil, err
+	}
+	if err, ok := isConstantError(res); ok {
+		return nil, err
+	}
+	t := &Todo{config: tuo.config}
+	if err := t.FromResponse(res); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (tuo *TodoUpdateOne) gremlin(id string) *dsl.Traversal {
+	type constraint struct {
+		pred *dsl.Traversal // constraint predicate.
+		test *dsl.Traversal // test matches and its constant.
+	}
+	constraints := make([]*constraint, 0, 1)
+	v := g.V(id)
+	var (
+		rv = v.Clone()
+		_  = rv
+
+		trs []*dsl.Traversal
+	)
+	if value, ok := tuo.mutation.Status(); ok {
+		v.Property(dsl.Single, todo.FieldStatus, value)
+	}
+	if value, ok := tuo.mutation.Priority(); ok {
+		v.Property(dsl.Single, todo.FieldPriority, value)
+	}
+	if value, ok := tuo.mutation.AddedPriority(); ok {
+		v.Property(dsl.Single, todo.FieldPriority, __.Union(__.Values(todo.FieldPriority), __.Constant(value)).Sum())
+	}
+	if value, ok := tuo.mutation.Text(); ok {
+		v.Property(dsl.Single, todo.FieldText, value)
+	}
+	if value, ok := tuo.mutation.Blob(); ok {
+		v.Property(dsl.Single, todo.FieldBlob, value)
+	}
+	if value, ok := tuo.mutation.DeletedAt(); ok {
+		v.Property(dsl.Single, todo.FieldDeletedAt, value)
+	}
+	var properties []interface{}
+	if tuo.mutation.BlobCleared() {
+		properties = append(properties, todo.FieldBlob)
+	}
+	if tuo.mutation.DeletedAtCleared() {
+		properties = append(properties, todo.FieldDeletedAt)
+	}
+	if len(properties) > 0 {
+		v.SideEffect(__.Properties(properties...).Drop())
+	}
+	if tuo.mutation.ParentCleared() {
+		tr := rv.Clone().InE(todo.ChildrenLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range tuo.mutation.ParentIDs() {
+		v.AddE(todo.ChildrenLabel).From(g.V(id)).InV()
+	}
+	for _, id := range tuo.mutation.RemovedChildrenIDs() {
+		tr := rv.Clone().OutE(todo.ChildrenLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range tuo.mutation.ChildrenIDs() {
+		v.AddE(todo.ChildrenLabel).To(g.V(id)).OutV()
+		constraints = append(constraints, &constraint{
+			pred: g.E().HasLabel(todo.ChildrenLabel).InV().HasID(id).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueEdge(todo.Label, todo.ChildrenLabel, id)),
+		})
+	}
+	if tuo.mutation.CategoryCleared() {
+		tr := rv.Clone().InE(category.TodosLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range tuo.mutation.CategoryIDs() {
+		v.AddE(category.TodosLabel).From(g.V(id)).InV()
+	}
+	if tuo.mutation.SecretCleared() {
+		tr := rv.Clone().OutE(todo.SecretLabel).Drop().Iterate()
+		trs = append(trs, tr)
+	}
+	for _, id := range tuo.mutation.SecretIDs() {
+		v.AddE(todo.SecretLabel).To(g.V(id)).OutV()
+	}
+	if len(tuo.fields) > 0 {
+		fields := make([]interface{}, 0, len(tuo.fields)+1)
+		fields = append(fields, true)
+		for _, f := range tuo.fields {
+			fields = append(fields, f)
+		}
+		v.ValueMap(fields...)
+	} else {
+		v.ValueMap(true)
+	}
+	if len(constraints) > 0 {
+		v = constraints[0].pred.Coalesce(constraints[0].test, v)
+		for _, cr := range constraints[1:] {
+			v = cr.pred.Coalesce(cr.test, v)
+		}
+	}
+	trs = append(trs, v)
+	return dsl.Join(trs...)
+}
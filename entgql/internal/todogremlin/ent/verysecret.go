@@ -0,0 +1,111 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect/gremlin"
+)
+
+// VerySecret is the model entity for the VerySecret schema.
+type VerySecret struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Password holds the value of the "password" field.
+	Password string `json:"password,omitempty"`
+}
+
+// FromResponse scans the gremlin response data into VerySecret.
+func (vs *VerySecret) FromResponse(res *gremlin.Response) error {
+	vmap, err := res.ReadValueMap()
+	if err != nil {
+		return err
+	}
+	var scanvs struct {
+		ID       string `json:"id,omitempty"`
+		Password string `json:"password,omitempty"`
+	}
+	if err := vmap.Decode(&scanvs); err != nil {
+		return err
+	}
+	vs.ID = scanvs.ID
+	vs.Password = scanvs.Password
+	return nil
+}
+
+// Update returns a builder for updating this VerySecret.
+// Note that you need to call VerySecret.Unwrap() before calling this method if this VerySecret
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (vs *VerySecret) Update() *VerySecretUpdateOne {
+	return (&VerySecretClient{config: vs.config}).UpdateOne(vs)
+}
+
+// Unwrap unwraps the VerySecret entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (vs *VerySecret) Unwrap() *VerySecret {
+	tx, ok := vs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: VerySecret is not a transactional entity")
+	}
+	vs.config.driver = tx.drv
+	return vs
+}
+
+// String implements the fmt.Stringer.
+func (vs *VerySecret) String() string {
+	var builder strings.Builder
+	builder.WriteString("VerySecret(")
+	builder.WriteString(fmt.Sprintf("id=%v", vs.ID))
+	builder.WriteString(", password=")
+	builder.WriteString(vs.Password)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// VerySecrets is a parsable slice of VerySecret.
+type VerySecrets []*VerySecret
+
+// FromResponse scans the gremlin response data into VerySecrets.
+func (vs *VerySecrets) FromResponse(res *gremlin.Response) error {
+	vmap, err := res.ReadValueMap()
+	if err != nil {
+		return err
+	}
+	var scanvs []struct {
+		ID       string `json:"id,omitempty"`
+		Password string `json:"password,omitempty"`
+	}
+	if err := vmap.Decode(&scanvs); err != nil {
+		return err
+	}
+	for _, v := range scanvs {
+		*vs = append(*vs, &VerySecret{
+			ID:       v.ID,
+			Password: v.Password,
+		})
+	}
+	return nil
+}
+
+func (vs VerySecrets) config(cfg config) {
+	for _i := range vs {
+		vs[_i].config = cfg
+	}
+}
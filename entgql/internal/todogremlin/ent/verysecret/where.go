@@ -0,0 +1,222 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package verysecret
+
+import (
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/predicate"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/__"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/p"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.HasID(id)
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.HasID(p.EQ(id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.HasID(p.NEQ(id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		t.HasID(p.Within(v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		t.HasID(p.Without(v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.HasID(p.GT(id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.HasID(p.GTE(id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.HasID(p.LT(id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.HasID(p.LTE(id))
+	})
+}
+
+// Password applies equality check predicate on the "password" field. It's identical to PasswordEQ.
+func Password(v string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPassword, p.EQ(v))
+	})
+}
+
+// PasswordEQ applies the EQ predicate on the "password" field.
+func PasswordEQ(v string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPassword, p.EQ(v))
+	})
+}
+
+// PasswordNEQ applies the NEQ predicate on the "password" field.
+func PasswordNEQ(v string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPassword, p.NEQ(v))
+	})
+}
+
+// PasswordIn applies the In predicate on the "password" field.
+func PasswordIn(vs ...string) predicate.VerySecret {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPassword, p.Within(v...))
+	})
+}
+
+// PasswordNotIn applies the NotIn predicate on the "password" field.
+func PasswordNotIn(vs ...string) predicate.VerySecret {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPassword, p.Without(v...))
+	})
+}
+
+// PasswordGT applies the GT predicate on the "password" field.
+func PasswordGT(v string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPassword, p.GT(v))
+	})
+}
+
+// PasswordGTE applies the GTE predicate on the "password" field.
+func PasswordGTE(v string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPassword, p.GTE(v))
+	})
+}
+
+// PasswordLT applies the LT predicate on the "password" field.
+func PasswordLT(v string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPassword, p.LT(v))
+	})
+}
+
+// PasswordLTE applies the LTE predicate on the "password" field.
+func PasswordLTE(v string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPassword, p.LTE(v))
+	})
+}
+
+// PasswordContains applies the Contains predicate on the "password" field.
+func PasswordContains(v string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPassword, p.Containing(v))
+	})
+}
+
+// PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
+func PasswordHasPrefix(v string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPassword, p.StartingWith(v))
+	})
+}
+
+// PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
+func PasswordHasSuffix(v string) predicate.VerySecret {
+	return predicate.VerySecret(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPassword, p.EndingWith(v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.VerySecret) predicate.VerySecret {
+	return predicate.VerySecret(func(tr *dsl.Traversal) {
+		trs := make([]interface{}, 0, len(predicates))
+		for _, p := range predicates {
+			t := __.New()
+			p(t)
+			trs = append(trs, t)
+		}
+		tr.Where(__.And(trs...))
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.VerySecret) predicate.VerySecret {
+	return predicate.VerySecret(func(tr *dsl.Traversal) {
+		trs := make([]interface{}, 0, len(predicates))
+		for _, p := range predicates {
+			t := __.New()
+			p(t)
+			trs = append(trs, t)
+		}
+		tr.Where(__.Or(trs...))
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.VerySecret) predicate.VerySecret {
+	return predicate.VerySecret(func(tr *dsl.Traversal) {
+		t := __.New()
+		p(t)
+		tr.Where(__.Not(t))
+	})
+}
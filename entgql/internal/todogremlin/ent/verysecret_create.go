@@ -0,0 +1,147 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/verysecret"
+	"entgo.io/ent/dialect/gremlin"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+)
+
+// VerySecretCreate is the builder for creating a VerySecret entity.
+type VerySecretCreate struct {
+	config
+	mutation *VerySecretMutation
+	hooks    []Hook
+}
+
+// SetPassword sets the "password" field.
+func (vsc *VerySecretCreate) SetPassword(s string) *VerySecretCreate {
+	vsc.mutation.SetPassword(s)
+	return vsc
+}
+
+// Mutation returns the VerySecretMutation object of the builder.
+func (vsc *VerySecretCreate) Mutation() *VerySecretMutation {
+	return vsc.mutation
+}
+
+// Save creates the VerySecret in the database.
+func (vsc *VerySecretCreate) Save(ctx context.Context) (*VerySecret, error) {
+	var (
+		err  error
+		node *VerySecret
+	)
+	if len(vsc.hooks) == 0 {
+		if err = vsc.check(); err != nil {
+			return nil, err
+		}
+		node, err = vsc.gremlinSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*VerySecretMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = vsc.check(); err != nil {
+				return nil, err
+			}
+			vsc.mutation = mutation
+			if node, err = vsc.gremlinSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(vsc.hooks) - 1; i >= 0; i-- {
+			if vsc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = vsc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, vsc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (vsc *VerySecretCreate) SaveX(ctx context.Context) *VerySecret {
+	v, err := vsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (vsc *VerySecretCreate) Exec(ctx context.Context) error {
+	_, err := vsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vsc *VerySecretCreate) ExecX(ctx context.Context) {
+	if err := vsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (vsc *VerySecretCreate) check() error {
+	if _, ok := vsc.mutation.Password(); !ok {
+		return &ValidationError{Name: "password", err: errors.New(`ent: missing required field "password"`)}
+	}
+	return nil
+}
+
+func (vsc *VerySecretCreate) gremlinSave(ctx context.Context) (*VerySecret, error) {
+	res := &gremlin.Response{}
+	query, bindings := vsc.gremlin().Query()
+	if err := vsc.driver.Exec(ctx, query, bindings, res); err != nil {
+		return nil, err
+	}
+	if err, ok := isConstantError(res); ok {
+		return nil, err
+	}
+	vs := &VerySecret{config: vsc.config}
+	if err := vs.FromResponse(res); err != nil {
+		return nil, err
+	}
+	return vs, nil
+}
+
+func (vsc *VerySecretCreate) gremlin() *dsl.Traversal {
+	v := g.AddV(verysecret.Label)
+	if value, ok := vsc.mutation.Password(); ok {
+		v.Property(dsl.Single, verysecret.FieldPassword, value)
+	}
+	return v.ValueMap(true)
+}
+
+// VerySecretCreateBulk is the builder for creating many VerySecret entities in bulk.
+type VerySecretCreateBulk struct {
+	config
+	builders []*VerySecretCreate
+}
@@ -0,0 +1,256 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/predicate"
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/verysecret"
+	"entgo.io/ent/dialect/gremlin"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"entgo.io/ent/dialect/gremlin/graph/dsl/g"
+)
+
+// VerySecretUpdate is the builder for updating VerySecret entities.
+type VerySecretUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VerySecretMutation
+}
+
+// Where appends a list predicates to the VerySecretUpdate builder.
+func (vsu *VerySecretUpdate) Where(ps ...predicate.VerySecret) *VerySecretUpdate {
+	vsu.mutation.Where(ps...)
+	return vsu
+}
+
+// SetPassword sets the "password" field.
+func (vsu *VerySecretUpdate) SetPassword(s string) *VerySecretUpdate {
+	vsu.mutation.SetPassword(s)
+	return vsu
+}
+
+// Mutation returns the VerySecretMutation object of the builder.
+func (vsu *VerySecretUpdate) Mutation() *VerySecretMutation {
+	return vsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (vsu *VerySecretUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(vsu.hooks) == 0 {
+		affected, err = vsu.gremlinSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*VerySecretMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			vsu.mutation = mutation
+			affected, err = vsu.gremlinSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(vsu.hooks) - 1; i >= 0; i-- {
+			if vsu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = vsu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, vsu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vsu *VerySecretUpdate) SaveX(ctx context.Context) int {
+	affected, err := vsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (vsu *VerySecretUpdate) Exec(ctx context.Context) error {
+	_, err := vsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vsu *VerySecretUpdate) ExecX(ctx context.Context) {
+	if err := vsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (vsu *VerySecretUpdate) gremlinSave(ctx context.Context) (int, error) {
+	res := &gremlin.Response{}
+	query, bindings := vsu.gremlin().Query()
+	if err := vsu.driver.Exec(ctx, query, bindings, res); err != nil {
+		return 0, err
+	}
+	if err, ok := isConstantError(res); ok {
+		return 0, err
+	}
+	return res.ReadInt()
+}
+
+func (vsu *VerySecretUpdate) gremlin() *dsl.Traversal {
+	v := g.V().HasLabel(verysecret.Label)
+	for _, p := range vsu.mutation.predicates {
+		p(v)
+	}
+	var (
+		trs []*dsl.Traversal
+	)
+	if value, ok := vsu.mutation.Password(); ok {
+		v.Property(dsl.Single, verysecret.FieldPassword, value)
+	}
+	v.Count()
+	trs = append(trs, v)
+	return dsl.Join(trs...)
+}
+
+// VerySecretUpdateOne is the builder for updating a single VerySecret entity.
+type VerySecretUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VerySecretMutation
+}
+
+// SetPassword sets the "password" field.
+func (vsuo *VerySecretUpdateOne) SetPassword(s string) *VerySecretUpdateOne {
+	vsuo.mutation.SetPassword(s)
+	return vsuo
+}
+
+// Mutation returns the VerySecretMutation object of the builder.
+func (vsuo *VerySecretUpdateOne) Mutation() *VerySecretMutation {
+	return vsuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (vsuo *VerySecretUpdateOne) Select(field string, fields ...string) *VerySecretUpdateOne {
+	vsuo.fields = append([]string{field}, fields...)
+	return vsuo
+}
+
+// Save executes the query and returns the updated VerySecret entity.
+func (vsuo *VerySecretUpdateOne) Save(ctx context.Context) (*VerySecret, error) {
+	var (
+		err  error
+		node *VerySecret
+	)
+	if len(vsuo.hooks) == 0 {
+		node, err = vsuo.gremlinSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*VerySecretMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			vsuo.mutation = mutation
+			node, err = vsuo.gremlinSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(vsuo.hooks) - 1; i >= 0; i-- {
+			if vsuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = vsuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, vsuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (vsuo *VerySecretUpdateOne) SaveX(ctx context.Context) *VerySecret {
+	node, err := vsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (vsuo *VerySecretUpdateOne) Exec(ctx context.Context) error {
+	_, err := vsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (vsuo *VerySecretUpdateOne) ExecX(ctx context.Context) {
+	if err := vsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (vsuo *VerySecretUpdateOne) gremlinSave(ctx context.Context) (*VerySecret, error) {
+	res := &gremlin.Response{}
+	id, ok := vsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing VerySecret.ID for update")}
+	}
+	query, bindings := vsuo.gremlin(id).Query()
+	if err := vsuo.driver.Exec(ctx, query, bindings, res); err != nil {
+		return nil, err
+	}
+	if err, ok := isConstantError(res); ok {
+		return nil, err
+	}
+	vs := &VerySecret{config: vsuo.config}
+	if err := vs.FromResponse(res); err != nil {
+		return nil, err
+	}
+	return vs, nil
+}
+
+func (vsuo *VerySecretUpdateOne) gremlin(id string) *dsl.Traversal {
+	v := g.V(id)
+	var (
+		trs []*dsl.Traversal
+	)
+	if value, ok := vsuo.mutation.Password(); ok {
+		v.Property(dsl.Single, verysecret.FieldPassword, value)
+	}
+	if len(vsuo.fields) > 0 {
+		fields := make([]interface{}, 0, len(vsuo.fields)+1)
+		fields = append(fields, true)
+		for _, f := range vsuo.fields {
+			fields = append(fields, f)
+		}
+		v.ValueMap(fields...)
+	} else {
+		v.ValueMap(true)
+	}
+	trs = append(trs, v)
+	return dsl.Join(trs...)
+}
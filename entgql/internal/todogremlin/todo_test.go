@@ -0,0 +1,138 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package todogremlin_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"entgo.io/contrib/entgql/internal/todogremlin/ent"
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/todo"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/gremlin"
+	"entgo.io/ent/dialect/gremlin/encoding/graphson"
+	"entgo.io/ent/dialect/gremlin/graph/dsl"
+	"github.com/stretchr/testify/require"
+)
+
+// driver is an in-memory stand-in for a Gremlin server. It records the executed
+// traversals and replies with the queued GraphSON responses, in order.
+type driver struct {
+	dialect.Driver
+	t         *testing.T
+	queries   []string
+	bindings  []dsl.Bindings
+	responses []string
+}
+
+func (d *driver) Exec(_ context.Context, query string, args, v interface{}) error {
+	d.queries = append(d.queries, query)
+	d.bindings = append(d.bindings, args.(dsl.Bindings))
+	require.NotEmpty(d.t, d.responses, "unexpected query: %s", query)
+	res := v.(*gremlin.Response)
+	res.Status.Code = gremlin.StatusSuccess
+	res.Result.Data = graphson.RawMessage(d.responses[0])
+	d.responses = d.responses[1:]
+	return nil
+}
+
+func (d *driver) Dialect() string { return dialect.Gremlin }
+
+// list returns a GraphSON list of the given GraphSON values.
+func list(values ...string) string {
+	return fmt.Sprintf(`{"@type":"g:List","@value":[%s]}`, strings.Join(values, ","))
+}
+
+// vertex returns the GraphSON value map of a todo vertex.
+func vertex(id, text string, priority int) string {
+	return fmt.Sprintf(`{"@type":"g:Map","@value":[
+		"id", %q,
+		"text", %s,
+		"priority", %s,
+		"status", %s
+	]}`, id, list(strconv.Quote(text)), list(fmt.Sprintf(`{"@type":"g:Int64","@value":%d}`, priority)), list(strconv.Quote(todo.StatusInProgress.String())))
+}
+
+func TestPaginate(t *testing.T) {
+	drv := &driver{t: t, responses: []string{
+		// Total count and the first page (limit + 1).
+		list(`{"@type":"g:Int64","@value":3}`),
+		list(vertex("a", "a", 1), vertex("b", "b", 2), vertex("c", "c", 3)),
+	}}
+	client := ent.NewClient(ent.Driver(drv))
+	first := 2
+	status := todo.StatusInProgress
+	after := &ent.Cursor{ID: "z", Value: "0"}
+	conn, err := client.Todo.Query().
+		Paginate(context.Background(), after, &first, nil, nil,
+			ent.WithTodoOrder(&ent.TodoOrder{
+				Direction: ent.OrderDirectionAsc,
+				Field:     ent.TodoOrderFieldText,
+			}),
+			ent.WithTodoFilter((&ent.TodoWhereInput{Status: &status}).Filter),
+		)
+	require.NoError(t, err)
+	require.Equal(t, 3, conn.TotalCount)
+	require.True(t, conn.PageInfo.HasNextPage)
+	require.Len(t, conn.Edges, 2)
+	for i, id := range []string{"a", "b"} {
+		require.Equal(t, id, conn.Edges[i].Node.ID)
+		require.Equal(t, ent.Cursor{ID: id, Value: id}, conn.Edges[i].Cursor)
+	}
+
+	require.Len(t, drv.queries, 2)
+	// Soft-deleted todos are excluded by default.
+	require.Equal(t, "g.V().hasLabel($0).hasLabel($1).hasNot($2).has($3, $4, eq($5)).dedup().count()", drv.queries[0])
+	require.Equal(t, "g.V().hasLabel($0).hasLabel($1).hasNot($2).has($3, $4, eq($5))"+
+		".or(__.has($6, gt($7)), __.and(__.has($8, eq($9)), __.hasId(gt($a))))"+
+		".order().by($b, incr).by(id, incr).limit($c).dedup().valueMap($d)", drv.queries[1])
+	require.Equal(t, dsl.Bindings{
+		"$0": todo.Label,
+		"$1": todo.Label,
+		"$2": todo.FieldDeletedAt,
+		"$3": todo.Label,
+		"$4": todo.FieldStatus,
+		"$5": status,
+		"$6": todo.FieldText,
+		"$7": after.Value,
+		"$8": todo.FieldText,
+		"$9": after.Value,
+		"$a": after.ID,
+		"$b": todo.FieldText,
+		"$c": first + 1,
+		"$d": true,
+	}, drv.bindings[1])
+}
+
+func TestNoder(t *testing.T) {
+	drv := &driver{t: t, responses: []string{
+		list(strconv.Quote(todo.Label)),
+		list(vertex("a", "a", 1)),
+	}}
+	client := ent.NewClient(ent.Driver(drv))
+	n, err := client.Noder(context.Background(), "a")
+	require.NoError(t, err)
+	require.IsType(t, (*ent.Todo)(nil), n)
+	require.Equal(t, "a", n.(*ent.Todo).ID)
+	require.Equal(t, "g.V($0).label()", drv.queries[0])
+	require.Equal(t, dsl.Bindings{"$0": "a"}, drv.bindings[0])
+
+	drv.responses = []string{list()}
+	_, err = client.Noder(context.Background(), "b")
+	require.True(t, ent.IsNotFound(err))
+}
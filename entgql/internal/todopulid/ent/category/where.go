@@ -138,6 +138,13 @@ func Count(v uint64) predicate.Category {
 	})
 }
 
+// Budget applies equality check predicate on the "budget" field. It's identical to BudgetEQ.
+func Budget(v schematype.Amount) predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBudget), v))
+	})
+}
+
 // TextEQ applies the EQ predicate on the "text" field.
 func TextEQ(v string) predicate.Category {
 	return predicate.Category(func(s *sql.Selector) {
@@ -573,6 +580,96 @@ func CountNotNil() predicate.Category {
 	})
 }
 
+// BudgetEQ applies the EQ predicate on the "budget" field.
+func BudgetEQ(v schematype.Amount) predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldBudget), v))
+	})
+}
+
+// BudgetNEQ applies the NEQ predicate on the "budget" field.
+func BudgetNEQ(v schematype.Amount) predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldBudget), v))
+	})
+}
+
+// BudgetIn applies the In predicate on the "budget" field.
+func BudgetIn(vs ...schematype.Amount) predicate.Category {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Category(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldBudget), v...))
+	})
+}
+
+// BudgetNotIn applies the NotIn predicate on the "budget" field.
+func BudgetNotIn(vs ...schematype.Amount) predicate.Category {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Category(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldBudget), v...))
+	})
+}
+
+// BudgetGT applies the GT predicate on the "budget" field.
+func BudgetGT(v schematype.Amount) predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldBudget), v))
+	})
+}
+
+// BudgetGTE applies the GTE predicate on the "budget" field.
+func BudgetGTE(v schematype.Amount) predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldBudget), v))
+	})
+}
+
+// BudgetLT applies the LT predicate on the "budget" field.
+func BudgetLT(v schematype.Amount) predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldBudget), v))
+	})
+}
+
+// BudgetLTE applies the LTE predicate on the "budget" field.
+func BudgetLTE(v schematype.Amount) predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldBudget), v))
+	})
+}
+
+// BudgetIsNil applies the IsNil predicate on the "budget" field.
+func BudgetIsNil() predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldBudget)))
+	})
+}
+
+// BudgetNotNil applies the NotNil predicate on the "budget" field.
+func BudgetNotNil() predicate.Category {
+	return predicate.Category(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldBudget)))
+	})
+}
+
 // HasTodos applies the HasEdge predicate on the "todos" edge.
 func HasTodos() predicate.Category {
 	return predicate.Category(func(s *sql.Selector) {
@@ -17,6 +17,7 @@ import (
 func main() {
 	ex, err := entgql.NewExtension(
 		entgql.WithWhereFilters(true),
+		entgql.WithConstraints(),
 		// This option is disabled in this example,
 		// because the schema file is edited by the
 		// internal/todo/ent example.
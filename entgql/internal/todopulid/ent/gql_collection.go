@@ -19,6 +19,7 @@ package ent
 import (
 	"context"
 
+	"entgo.io/contrib/entgql/internal/todopulid/ent/todo"
 	"github.com/99designs/gqlgen/graphql"
 )
 
@@ -47,10 +48,16 @@ func (t *TodoQuery) collectField(ctx *graphql.OperationContext, field graphql.Co
 		switch field.Name {
 		case "children":
 			t = t.WithChildren(func(query *TodoQuery) {
+				if include, _ := field.ArgumentMap(ctx.Variables)["includeDeleted"].(bool); !include {
+					query.Where(todo.DeletedAtIsNil())
+				}
 				query.collectField(ctx, field)
 			})
 		case "parent":
 			t = t.WithParent(func(query *TodoQuery) {
+				if include, _ := field.ArgumentMap(ctx.Variables)["includeDeleted"].(bool); !include {
+					query.Where(todo.DeletedAtIsNil())
+				}
 				query.collectField(ctx, field)
 			})
 		}
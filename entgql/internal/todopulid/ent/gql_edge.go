@@ -16,28 +16,44 @@
 
 package ent
 
-import "context"
+import (
+	"context"
 
-func (c *Category) Todos(ctx context.Context) ([]*Todo, error) {
+	"entgo.io/contrib/entgql/internal/todopulid/ent/todo"
+)
+
+func (c *Category) Todos(ctx context.Context, includeDeleted *bool) ([]*Todo, error) {
 	result, err := c.Edges.TodosOrErr()
 	if IsNotLoaded(err) {
-		result, err = c.QueryTodos().All(ctx)
+		query := c.QueryTodos()
+		if includeDeleted == nil || !*includeDeleted {
+			query = query.Where(todo.DeletedAtIsNil())
+		}
+		result, err = query.All(ctx)
 	}
 	return result, err
 }
 
-func (t *Todo) Parent(ctx context.Context) (*Todo, error) {
+func (t *Todo) Parent(ctx context.Context, includeDeleted *bool) (*Todo, error) {
 	result, err := t.Edges.ParentOrErr()
 	if IsNotLoaded(err) {
-		result, err = t.QueryParent().Only(ctx)
+		query := t.QueryParent()
+		if includeDeleted == nil || !*includeDeleted {
+			query = query.Where(todo.DeletedAtIsNil())
+		}
+		result, err = query.Only(ctx)
 	}
 	return result, MaskNotFound(err)
 }
 
-func (t *Todo) Children(ctx context.Context) ([]*Todo, error) {
+func (t *Todo) Children(ctx context.Context, includeDeleted *bool) ([]*Todo, error) {
 	result, err := t.Edges.ChildrenOrErr()
 	if IsNotLoaded(err) {
-		result, err = t.QueryChildren().All(ctx)
+		query := t.QueryChildren()
+		if includeDeleted == nil || !*includeDeleted {
+			query = query.Where(todo.DeletedAtIsNil())
+		}
+		result, err = query.All(ctx)
 	}
 	return result, err
 }
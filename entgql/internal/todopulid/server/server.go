@@ -68,6 +68,8 @@ func main() {
 
 	srv := handler.NewDefaultServer(todopulid.NewSchema(client))
 	srv.Use(entgql.Transactioner{TxOpener: client})
+	srv.Use(&entgql.Validator{Funcs: ent.InputValidators})
+	srv.Use(entgql.NodeCacher{})
 	if cli.Debug {
 		srv.Use(&debug.Tracer{})
 	}
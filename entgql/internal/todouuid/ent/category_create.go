@@ -83,6 +83,20 @@ func (cc *CategoryCreate) SetNillableCount(u *uint64) *CategoryCreate {
 	return cc
 }
 
+// SetBudget sets the "budget" field.
+func (cc *CategoryCreate) SetBudget(s schematype.Amount) *CategoryCreate {
+	cc.mutation.SetBudget(s)
+	return cc
+}
+
+// SetNillableBudget sets the "budget" field if the given value is not nil.
+func (cc *CategoryCreate) SetNillableBudget(s *schematype.Amount) *CategoryCreate {
+	if s != nil {
+		cc.SetBudget(*s)
+	}
+	return cc
+}
+
 // SetID sets the "id" field.
 func (cc *CategoryCreate) SetID(u uuid.UUID) *CategoryCreate {
 	cc.mutation.SetID(u)
@@ -271,6 +285,14 @@ func (cc *CategoryCreate) createSpec() (*Category, *sqlgraph.CreateSpec) {
 		})
 		_node.Count = value
 	}
+	if value, ok := cc.mutation.Budget(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: category.FieldBudget,
+		})
+		_node.Budget = value
+	}
 	if nodes := cc.mutation.TodosIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
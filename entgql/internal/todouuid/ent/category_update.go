@@ -122,6 +122,33 @@ func (cu *CategoryUpdate) ClearCount() *CategoryUpdate {
 	return cu
 }
 
+// SetBudget sets the "budget" field.
+func (cu *CategoryUpdate) SetBudget(s schematype.Amount) *CategoryUpdate {
+	cu.mutation.ResetBudget()
+	cu.mutation.SetBudget(s)
+	return cu
+}
+
+// SetNillableBudget sets the "budget" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableBudget(s *schematype.Amount) *CategoryUpdate {
+	if s != nil {
+		cu.SetBudget(*s)
+	}
+	return cu
+}
+
+// AddBudget adds s to the "budget" field.
+func (cu *CategoryUpdate) AddBudget(s schematype.Amount) *CategoryUpdate {
+	cu.mutation.AddBudget(s)
+	return cu
+}
+
+// ClearBudget clears the value of the "budget" field.
+func (cu *CategoryUpdate) ClearBudget() *CategoryUpdate {
+	cu.mutation.ClearBudget()
+	return cu
+}
+
 // AddTodoIDs adds the "todos" edge to the Todo entity by IDs.
 func (cu *CategoryUpdate) AddTodoIDs(ids ...uuid.UUID) *CategoryUpdate {
 	cu.mutation.AddTodoIDs(ids...)
@@ -323,6 +350,26 @@ func (cu *CategoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: category.FieldCount,
 		})
 	}
+	if value, ok := cu.mutation.Budget(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: category.FieldBudget,
+		})
+	}
+	if value, ok := cu.mutation.AddedBudget(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: category.FieldBudget,
+		})
+	}
+	if cu.mutation.BudgetCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: category.FieldBudget,
+		})
+	}
 	if cu.mutation.TodosCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -474,6 +521,33 @@ func (cuo *CategoryUpdateOne) ClearCount() *CategoryUpdateOne {
 	return cuo
 }
 
+// SetBudget sets the "budget" field.
+func (cuo *CategoryUpdateOne) SetBudget(s schematype.Amount) *CategoryUpdateOne {
+	cuo.mutation.ResetBudget()
+	cuo.mutation.SetBudget(s)
+	return cuo
+}
+
+// SetNillableBudget sets the "budget" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableBudget(s *schematype.Amount) *CategoryUpdateOne {
+	if s != nil {
+		cuo.SetBudget(*s)
+	}
+	return cuo
+}
+
+// AddBudget adds s to the "budget" field.
+func (cuo *CategoryUpdateOne) AddBudget(s schematype.Amount) *CategoryUpdateOne {
+	cuo.mutation.AddBudget(s)
+	return cuo
+}
+
+// ClearBudget clears the value of the "budget" field.
+func (cuo *CategoryUpdateOne) ClearBudget() *CategoryUpdateOne {
+	cuo.mutation.ClearBudget()
+	return cuo
+}
+
 // AddTodoIDs adds the "todos" edge to the Todo entity by IDs.
 func (cuo *CategoryUpdateOne) AddTodoIDs(ids ...uuid.UUID) *CategoryUpdateOne {
 	cuo.mutation.AddTodoIDs(ids...)
@@ -699,6 +773,26 @@ func (cuo *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err
 			Column: category.FieldCount,
 		})
 	}
+	if value, ok := cuo.mutation.Budget(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: category.FieldBudget,
+		})
+	}
+	if value, ok := cuo.mutation.AddedBudget(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: category.FieldBudget,
+		})
+	}
+	if cuo.mutation.BudgetCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: category.FieldBudget,
+		})
+	}
 	if cuo.mutation.TodosCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
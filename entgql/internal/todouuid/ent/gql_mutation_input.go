@@ -0,0 +1,274 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/contrib/entgql/internal/todo/ent/schema/schematype"
+	"entgo.io/contrib/entgql/internal/todouuid/ent/category"
+	"entgo.io/contrib/entgql/internal/todouuid/ent/todo"
+	"github.com/google/uuid"
+)
+
+// CreateCategoryInput represents a mutation input for creating categories.
+type CreateCategoryInput struct {
+	Text        string                     `json:"text,omitempty"`
+	Status      category.Status            `json:"status,omitempty"`
+	Config      *schematype.CategoryConfig `json:"config,omitempty"`
+	Duration    *time.Duration             `json:"duration,omitempty"`
+	Count       *uint64                    `json:"count,omitempty"`
+	Budget      *schematype.Amount         `json:"budget,omitempty"`
+	TodoIDs     []uuid.UUID                `json:"todoIDs,omitempty"`
+	CreateTodos []*CreateTodoInput         `json:"createTodos,omitempty"`
+}
+
+// Mutate applies the CreateCategoryInput on the CategoryMutation. Nested inputs are created using
+// the client of the mutation, and therefore, they are created in its transaction (if exists).
+func (i *CreateCategoryInput) Mutate(ctx context.Context, m *CategoryMutation) error {
+	m.SetText(i.Text)
+	m.SetStatus(i.Status)
+	m.SetConfig(i.Config)
+	if v := i.Duration; v != nil {
+		m.SetDuration(*v)
+	}
+	if v := i.Count; v != nil {
+		m.SetCount(*v)
+	}
+	if v := i.Budget; v != nil {
+		m.SetBudget(*v)
+	}
+	if ids := i.TodoIDs; len(ids) > 0 {
+		m.AddTodoIDs(ids...)
+	}
+	for _, v := range i.CreateTodos {
+		c := m.Client().Todo.Create()
+		if err := v.Mutate(ctx, c.Mutation()); err != nil {
+			return err
+		}
+		n, err := c.Save(ctx)
+		if err != nil {
+			return err
+		}
+		m.AddTodoIDs(n.ID)
+	}
+	return nil
+}
+
+// UpdateCategoryInput represents a mutation input for updating categories.
+type UpdateCategoryInput struct {
+	Text          *string                    `json:"text,omitempty"`
+	Status        *category.Status           `json:"status,omitempty"`
+	Config        *schematype.CategoryConfig `json:"config,omitempty"`
+	ClearConfig   bool                       `json:"clearConfig,omitempty"`
+	Duration      *time.Duration             `json:"duration,omitempty"`
+	ClearDuration bool                       `json:"clearDuration,omitempty"`
+	Count         *uint64                    `json:"count,omitempty"`
+	ClearCount    bool                       `json:"clearCount,omitempty"`
+	Budget        *schematype.Amount         `json:"budget,omitempty"`
+	ClearBudget   bool                       `json:"clearBudget,omitempty"`
+	AddTodoIDs    []uuid.UUID                `json:"addTodoIDs,omitempty"`
+	RemoveTodoIDs []uuid.UUID                `json:"removeTodoIDs,omitempty"`
+	ClearTodos    bool                       `json:"clearTodos,omitempty"`
+	CreateTodos   []*CreateTodoInput         `json:"createTodos,omitempty"`
+}
+
+// Mutate applies the UpdateCategoryInput on the CategoryMutation. Edges and optional fields are
+// cleared before their new values are set. Nested inputs are created using the client of the
+// mutation, and therefore, they are created in its transaction (if exists).
+func (i *UpdateCategoryInput) Mutate(ctx context.Context, m *CategoryMutation) error {
+	if v := i.Text; v != nil {
+		m.SetText(*v)
+	}
+	if v := i.Status; v != nil {
+		m.SetStatus(*v)
+	}
+	if i.ClearConfig {
+		m.ClearConfig()
+	}
+	if v := i.Config; v != nil {
+		m.SetConfig(v)
+	}
+	if i.ClearDuration {
+		m.ClearDuration()
+	}
+	if v := i.Duration; v != nil {
+		m.SetDuration(*v)
+	}
+	if i.ClearCount {
+		m.ClearCount()
+	}
+	if v := i.Count; v != nil {
+		m.SetCount(*v)
+	}
+	if i.ClearBudget {
+		m.ClearBudget()
+	}
+	if v := i.Budget; v != nil {
+		m.SetBudget(*v)
+	}
+	if i.ClearTodos {
+		m.ClearTodos()
+	}
+	if ids := i.RemoveTodoIDs; len(ids) > 0 {
+		m.RemoveTodoIDs(ids...)
+	}
+	if ids := i.AddTodoIDs; len(ids) > 0 {
+		m.AddTodoIDs(ids...)
+	}
+	for _, v := range i.CreateTodos {
+		c := m.Client().Todo.Create()
+		if err := v.Mutate(ctx, c.Mutation()); err != nil {
+			return err
+		}
+		n, err := c.Save(ctx)
+		if err != nil {
+			return err
+		}
+		m.AddTodoIDs(n.ID)
+	}
+	return nil
+}
+
+// CreateTodoInput represents a mutation input for creating todos.
+type CreateTodoInput struct {
+	ClientMutationID *string            `json:"clientMutationId,omitempty"`
+	CreatedAt        *time.Time         `json:"createdAt,omitempty"`
+	Status           todo.Status        `json:"status,omitempty"`
+	Priority         *int               `json:"priority,omitempty"`
+	Text             string             `json:"text,omitempty"`
+	DeletedAt        *time.Time         `json:"deletedAt,omitempty"`
+	ParentID         *uuid.UUID         `json:"parentID,omitempty"`
+	ChildIDs         []uuid.UUID        `json:"childIDs,omitempty"`
+	CreateChildren   []*CreateTodoInput `json:"createChildren,omitempty"`
+	CategoryID       *uuid.UUID         `json:"categoryID,omitempty"`
+}
+
+// Mutate applies the CreateTodoInput on the TodoMutation. Nested inputs are created using
+// the client of the mutation, and therefore, they are created in its transaction (if exists).
+func (i *CreateTodoInput) Mutate(ctx context.Context, m *TodoMutation) error {
+	if v := i.CreatedAt; v != nil {
+		m.SetCreatedAt(*v)
+	}
+	m.SetStatus(i.Status)
+	if v := i.Priority; v != nil {
+		m.SetPriority(*v)
+	}
+	m.SetText(i.Text)
+	if v := i.DeletedAt; v != nil {
+		m.SetDeletedAt(*v)
+	}
+	if v := i.ParentID; v != nil {
+		m.SetParentID(*v)
+	}
+	if ids := i.ChildIDs; len(ids) > 0 {
+		m.AddChildIDs(ids...)
+	}
+	for _, v := range i.CreateChildren {
+		c := m.Client().Todo.Create()
+		if err := v.Mutate(ctx, c.Mutation()); err != nil {
+			return err
+		}
+		n, err := c.Save(ctx)
+		if err != nil {
+			return err
+		}
+		m.AddChildIDs(n.ID)
+	}
+	if v := i.CategoryID; v != nil {
+		m.SetCategoryID(*v)
+	}
+	return nil
+}
+
+// Payload returns the CreateTodoPayload of the given Todo, holding the clientMutationId of the CreateTodoInput.
+// The cursor of its edge is computed using the given order, or the default order if it is nil.
+func (i *CreateTodoInput) Payload(t *Todo, order *TodoOrder) *CreateTodoPayload {
+	return NewCreateTodoPayload(i.ClientMutationID, t, order)
+}
+
+// UpdateTodoInput represents a mutation input for updating todos.
+type UpdateTodoInput struct {
+	Status         *todo.Status       `json:"status,omitempty"`
+	Priority       *int               `json:"priority,omitempty"`
+	Text           *string            `json:"text,omitempty"`
+	DeletedAt      *time.Time         `json:"deletedAt,omitempty"`
+	ClearDeletedAt bool               `json:"clearDeletedAt,omitempty"`
+	ParentID       *uuid.UUID         `json:"parentID,omitempty"`
+	ClearParent    bool               `json:"clearParent,omitempty"`
+	AddChildIDs    []uuid.UUID        `json:"addChildIDs,omitempty"`
+	RemoveChildIDs []uuid.UUID        `json:"removeChildIDs,omitempty"`
+	ClearChildren  bool               `json:"clearChildren,omitempty"`
+	CreateChildren []*CreateTodoInput `json:"createChildren,omitempty"`
+	CategoryID     *uuid.UUID         `json:"categoryID,omitempty"`
+	ClearCategory  bool               `json:"clearCategory,omitempty"`
+}
+
+// Mutate applies the UpdateTodoInput on the TodoMutation. Edges and optional fields are
+// cleared before their new values are set. Nested inputs are created using the client of the
+// mutation, and therefore, they are created in its transaction (if exists).
+func (i *UpdateTodoInput) Mutate(ctx context.Context, m *TodoMutation) error {
+	if v := i.Status; v != nil {
+		m.SetStatus(*v)
+	}
+	if v := i.Priority; v != nil {
+		m.SetPriority(*v)
+	}
+	if v := i.Text; v != nil {
+		m.SetText(*v)
+	}
+	if i.ClearDeletedAt {
+		m.ClearDeletedAt()
+	}
+	if v := i.DeletedAt; v != nil {
+		m.SetDeletedAt(*v)
+	}
+	if i.ClearParent {
+		m.ClearParent()
+	}
+	if v := i.ParentID; v != nil {
+		m.SetParentID(*v)
+	}
+	if i.ClearChildren {
+		m.ClearChildren()
+	}
+	if ids := i.RemoveChildIDs; len(ids) > 0 {
+		m.RemoveChildIDs(ids...)
+	}
+	if ids := i.AddChildIDs; len(ids) > 0 {
+		m.AddChildIDs(ids...)
+	}
+	for _, v := range i.CreateChildren {
+		c := m.Client().Todo.Create()
+		if err := v.Mutate(ctx, c.Mutation()); err != nil {
+			return err
+		}
+		n, err := c.Save(ctx)
+		if err != nil {
+			return err
+		}
+		m.AddChildIDs(n.ID)
+	}
+	if i.ClearCategory {
+		m.ClearCategory()
+	}
+	if v := i.CategoryID; v != nil {
+		m.SetCategoryID(*v)
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sync"
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todouuid/ent/category"
@@ -27,6 +28,7 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-multierror"
+	"golang.org/x/sync/semaphore"
 )
 
 // Noder wraps the basic Node method.
@@ -60,7 +62,7 @@ func (c *Category) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     c.ID,
 		Type:   "Category",
-		Fields: make([]*Field, 5),
+		Fields: make([]*Field, 6),
 		Edges:  make([]*Edge, 1),
 	}
 	var buf []byte
@@ -104,6 +106,14 @@ func (c *Category) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "count",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(c.Budget); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "schematype.Amount",
+		Name:  "budget",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "Todo",
 		Name: "todos",
@@ -121,7 +131,7 @@ func (t *Todo) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     t.ID,
 		Type:   "Todo",
-		Fields: make([]*Field, 5),
+		Fields: make([]*Field, 6),
 		Edges:  make([]*Edge, 3),
 	}
 	var buf []byte
@@ -165,6 +175,14 @@ func (t *Todo) Node(ctx context.Context) (node *Node, err error) {
 		Name:  "blob",
 		Value: string(buf),
 	}
+	if buf, err = json.Marshal(t.DeletedAt); err != nil {
+		return nil, err
+	}
+	node.Fields[5] = &Field{
+		Type:  "time.Time",
+		Name:  "deleted_at",
+		Value: string(buf),
+	}
 	node.Edges[0] = &Edge{
 		Type: "Todo",
 		Name: "parent",
@@ -227,12 +245,41 @@ func WithFixedNodeType(t string) NodeOption {
 	})
 }
 
+// WithNodeBatchSize sets the maximum number of ids that are loaded by Noders
+// in a single query. Larger sets of ids are split into multiple queries.
+// Zero means that all ids of the same table are loaded in one query.
+func WithNodeBatchSize(n int) NodeOption {
+	return func(o *nodeOptions) {
+		o.batchSize = n
+	}
+}
+
+// WithNodeConcurrency sets the maximum number of queries that Noders executes
+// concurrently, when the ids span multiple tables or batches. The default is 1,
+// which executes them sequentially (e.g. as required by transactional clients).
+func WithNodeConcurrency(n int) NodeOption {
+	return func(o *nodeOptions) {
+		o.concurrency = n
+	}
+}
+
+// WithNodeLimit sets the maximum number of ids that can be passed to Noders.
+// Requests that exceed it fail with an entgql.ErrTooManyNodes error.
+func WithNodeLimit(n int) NodeOption {
+	return func(o *nodeOptions) {
+		o.limit = n
+	}
+}
+
 type nodeOptions struct {
-	nodeType func(context.Context, uuid.UUID) (string, error)
+	nodeType    func(context.Context, uuid.UUID) (string, error)
+	batchSize   int
+	concurrency int
+	limit       int
 }
 
 func (c *Client) newNodeOpts(opts []NodeOption) *nodeOptions {
-	nopts := &nodeOptions{}
+	nopts := &nodeOptions{concurrency: 1}
 	for _, opt := range opts {
 		opt(nopts)
 	}
@@ -246,11 +293,12 @@ func (c *Client) newNodeOpts(opts []NodeOption) *nodeOptions {
 
 // Noder returns a Node by its id. If the NodeType was not provided, it will
 // be derived from the id value according to the universal-id configuration.
+// If the context holds an entgql.NodeCache, the node is loaded only once per
+// cache and field selection.
 //
-//		c.Noder(ctx, id)
-//		c.Noder(ctx, id, ent.WithNodeType(pet.Table))
-//
-func (c *Client) Noder(ctx context.Context, id uuid.UUID, opts ...NodeOption) (_ Noder, err error) {
+//	c.Noder(ctx, id)
+//	c.Noder(ctx, id, ent.WithNodeType(pet.Table))
+func (c *Client) Noder(ctx context.Context, id uuid.UUID, opts ...NodeOption) (noder Noder, err error) {
 	defer func() {
 		if IsNotFound(err) {
 			err = multierror.Append(err, entgql.ErrNodeNotFound(id))
@@ -260,6 +308,17 @@ func (c *Client) Noder(ctx context.Context, id uuid.UUID, opts ...NodeOption) (_
 	if err != nil {
 		return nil, err
 	}
+	if cache := entgql.NodeCacheFromContext(ctx); cache != nil {
+		e, owner := cache.Acquire(ctx, table, id)
+		if !owner {
+			v, err := e.Wait(ctx)
+			if noder, _ = v.(Noder); noder == nil && err == nil {
+				err = &NotFoundError{"node"}
+			}
+			return noder, err
+		}
+		defer func() { e.Resolve(noder, err) }()
+	}
 	return c.noder(ctx, table, id)
 }
 
@@ -276,7 +335,7 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 		return n, nil
 	case todo.Table:
 		n, err := c.Todo.Query().
-			Where(todo.ID(id)).
+			Where(todo.ID(id), todo.DeletedAtIsNil()).
 			CollectFields(ctx, "Todo").
 			Only(ctx)
 		if err != nil {
@@ -288,7 +347,17 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 	}
 }
 
+// Noders returns the Nodes of the given ids. The ids are grouped by their tables, and
+// loaded in batches according to the WithNodeBatchSize and WithNodeConcurrency options.
+// If the context holds an entgql.NodeCache, nodes that were already loaded (or are
+// being loaded) by the operation are taken from the cache.
+//
+//	c.Noders(ctx, ids, ent.WithNodeBatchSize(100), ent.WithNodeLimit(1000))
 func (c *Client) Noders(ctx context.Context, ids []uuid.UUID, opts ...NodeOption) ([]Noder, error) {
+	nopts := c.newNodeOpts(opts)
+	if nopts.limit > 0 && len(ids) > nopts.limit {
+		return nil, entgql.ErrTooManyNodes(nopts.limit)
+	}
 	switch len(ids) {
 	case 1:
 		noder, err := c.Noder(ctx, ids[0], opts...)
@@ -304,31 +373,93 @@ func (c *Client) Noders(ctx context.Context, ids []uuid.UUID, opts ...NodeOption
 	errors := make([]error, len(ids))
 	tables := make(map[string][]uuid.UUID)
 	id2idx := make(map[uuid.UUID][]int, len(ids))
-	nopts := c.newNodeOpts(opts)
+	cache := entgql.NodeCacheFromContext(ctx)
+	owned := make(map[uuid.UUID]*entgql.NodeCacheEntry)
+	waiting := make(map[uuid.UUID]*entgql.NodeCacheEntry)
 	for i, id := range ids {
 		table, err := nopts.nodeType(ctx, id)
 		if err != nil {
 			errors[i] = err
 			continue
 		}
+		if id2idx[id] = append(id2idx[id], i); len(id2idx[id]) > 1 {
+			continue
+		}
+		if cache != nil {
+			e, owner := cache.Acquire(ctx, table, id)
+			if !owner {
+				waiting[id] = e
+				continue
+			}
+			owned[id] = e
+		}
 		tables[table] = append(tables[table], id)
-		id2idx[id] = append(id2idx[id], i)
 	}
 
+	type batch struct {
+		table string
+		ids   []uuid.UUID
+	}
+	var batches []batch
 	for table, ids := range tables {
-		nodes, err := c.noders(ctx, table, ids)
-		if err != nil {
-			for _, id := range ids {
-				for _, idx := range id2idx[id] {
-					errors[idx] = err
-				}
+		for len(ids) > 0 {
+			n := len(ids)
+			if nopts.batchSize > 0 && n > nopts.batchSize {
+				n = nopts.batchSize
 			}
-		} else {
-			for i, id := range ids {
-				for _, idx := range id2idx[id] {
-					noders[idx] = nodes[i]
-				}
+			batches = append(batches, batch{table: table, ids: ids[:n]})
+			ids = ids[n:]
+		}
+	}
+	resolve := func(b batch, nodes []Noder, err error) {
+		for i, id := range b.ids {
+			var node Noder
+			if err == nil {
+				node = nodes[i]
 			}
+			for _, idx := range id2idx[id] {
+				noders[idx], errors[idx] = node, err
+			}
+			if e, ok := owned[id]; ok {
+				e.Resolve(node, err)
+			}
+		}
+	}
+	if nopts.concurrency <= 1 || len(batches) == 1 {
+		for _, b := range batches {
+			nodes, err := c.noders(ctx, b.table, b.ids)
+			resolve(b, nodes, err)
+		}
+	} else {
+		var wg sync.WaitGroup
+		sem := semaphore.NewWeighted(int64(nopts.concurrency))
+		for _, b := range batches {
+			if err := sem.Acquire(ctx, 1); err != nil {
+				resolve(b, nil, err)
+				continue
+			}
+			wg.Add(1)
+			go func(b batch) {
+				defer func() {
+					sem.Release(1)
+					wg.Done()
+				}()
+				nodes, err := c.noders(ctx, b.table, b.ids)
+				resolve(b, nodes, err)
+			}(b)
+		}
+		wg.Wait()
+	}
+
+	for id, e := range waiting {
+		v, err := e.Wait(ctx)
+		node, _ := v.(Noder)
+		if IsNotFound(err) {
+			// Missing nodes are reported below, as in the non-cached flow.
+			node, err = nil, nil
+		}
+		for _, idx := range id2idx[id] {
+			noders[idx], errors[idx] = node, err
 		}
 	}
 
@@ -371,7 +502,7 @@ func (c *Client) noders(ctx context.Context, table string, ids []uuid.UUID) ([]N
 		}
 	case todo.Table:
 		nodes, err := c.Todo.Query().
-			Where(todo.IDIn(ids...)).
+			Where(todo.IDIn(ids...), todo.DeletedAtIsNil()).
 			CollectFields(ctx, "Todo").
 			All(ctx)
 		if err != nil {
@@ -577,9 +577,19 @@ func WithTodoFilter(filter func(*TodoQuery) (*TodoQuery, error)) TodoPaginateOpt
 	}
 }
 
+// WithTodoIncludeDeleted configures pagination to include soft-deleted nodes
+// (i.e. nodes with a non-null deleted_at field). They are excluded by default.
+func WithTodoIncludeDeleted(include *bool) TodoPaginateOption {
+	return func(pager *todoPager) error {
+		pager.includeDeleted = include != nil && *include
+		return nil
+	}
+}
+
 type todoPager struct {
-	order  *TodoOrder
-	filter func(*TodoQuery) (*TodoQuery, error)
+	order          *TodoOrder
+	filter         func(*TodoQuery) (*TodoQuery, error)
+	includeDeleted bool
 }
 
 func newTodoPager(opts []TodoPaginateOption) (*todoPager, error) {
@@ -596,6 +606,9 @@ func newTodoPager(opts []TodoPaginateOption) (*todoPager, error) {
 }
 
 func (p *todoPager) applyFilter(query *TodoQuery) (*TodoQuery, error) {
+	if !p.includeDeleted {
+		query = query.Where(todo.DeletedAtIsNil())
+	}
 	if p.filter != nil {
 		return p.filter(query)
 	}
@@ -843,3 +856,18 @@ func (t *Todo) ToEdge(order *TodoOrder) *TodoEdge {
 		Cursor: order.Field.toCursor(t),
 	}
 }
+
+// CreateTodoPayload is the Relay mutation payload of the "create" operation of Todo.
+type CreateTodoPayload struct {
+	ClientMutationID *string   `json:"clientMutationId,omitempty"`
+	TodoEdge         *TodoEdge `json:"todoEdge,omitempty"`
+}
+
+// NewCreateTodoPayload returns a new CreateTodoPayload for the given Todo. The cursor
+// of its edge is computed using the given order, or DefaultTodoOrder if it is nil.
+func NewCreateTodoPayload(clientMutationID *string, t *Todo, order *TodoOrder) *CreateTodoPayload {
+	return &CreateTodoPayload{
+		ClientMutationID: clientMutationID,
+		TodoEdge:         t.ToEdge(order),
+	}
+}
@@ -51,6 +51,8 @@ func main() {
 
 	srv := handler.NewDefaultServer(todo.NewSchema(client))
 	srv.Use(entgql.Transactioner{TxOpener: client})
+	srv.Use(&entgql.Validator{Funcs: ent.InputValidators})
+	srv.Use(entgql.NodeCacher{})
 	if cli.Debug {
 		srv.Use(&debug.Tracer{})
 	}
@@ -0,0 +1,191 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"github.com/99designs/gqlgen/graphql/introspection"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+type (
+	// introspectionResult is the result of the standard introspection query,
+	// as expected by client code generation tools (e.g. schema.json).
+	introspectionResult struct {
+		Schema *schemaType `json:"__schema"`
+	}
+	schemaType struct {
+		QueryType        *namedType       `json:"queryType"`
+		MutationType     *namedType       `json:"mutationType"`
+		SubscriptionType *namedType       `json:"subscriptionType"`
+		Types            []*fullType      `json:"types"`
+		Directives       []*directiveType `json:"directives"`
+	}
+	namedType struct {
+		Name string `json:"name"`
+	}
+	fullType struct {
+		Kind          string            `json:"kind"`
+		Name          *string           `json:"name"`
+		Description   *string           `json:"description"`
+		Fields        []*fieldType      `json:"fields"`
+		InputFields   []*inputValueType `json:"inputFields"`
+		Interfaces    []*typeRef        `json:"interfaces"`
+		EnumValues    []*enumValueType  `json:"enumValues"`
+		PossibleTypes []*typeRef        `json:"possibleTypes"`
+	}
+	fieldType struct {
+		Name              string            `json:"name"`
+		Description       *string           `json:"description"`
+		Args              []*inputValueType `json:"args"`
+		Type              *typeRef          `json:"type"`
+		IsDeprecated      bool              `json:"isDeprecated"`
+		DeprecationReason *string           `json:"deprecationReason"`
+	}
+	inputValueType struct {
+		Name         string   `json:"name"`
+		Description  *string  `json:"description"`
+		Type         *typeRef `json:"type"`
+		DefaultValue *string  `json:"defaultValue"`
+	}
+	typeRef struct {
+		Kind   string   `json:"kind"`
+		Name   *string  `json:"name"`
+		OfType *typeRef `json:"ofType"`
+	}
+	enumValueType struct {
+		Name              string  `json:"name"`
+		Description       *string `json:"description"`
+		IsDeprecated      bool    `json:"isDeprecated"`
+		DeprecationReason *string `json:"deprecationReason"`
+	}
+	directiveType struct {
+		Name        string            `json:"name"`
+		Description *string           `json:"description"`
+		Locations   []string          `json:"locations"`
+		Args        []*inputValueType `json:"args"`
+	}
+)
+
+// introspect returns the introspection result of the given schema.
+func introspect(s *ast.Schema) *introspectionResult {
+	is := introspection.WrapSchema(s)
+	schema := &schemaType{
+		QueryType:        newNamedType(is.QueryType()),
+		MutationType:     newNamedType(is.MutationType()),
+		SubscriptionType: newNamedType(is.SubscriptionType()),
+		Types:            []*fullType{},
+		Directives:       []*directiveType{},
+	}
+	types := is.Types()
+	for i := range types {
+		schema.Types = append(schema.Types, newFullType(&types[i]))
+	}
+	for _, d := range is.Directives() {
+		schema.Directives = append(schema.Directives, &directiveType{
+			Name:        d.Name,
+			Description: description(d.Description),
+			Locations:   d.Locations,
+			Args:        newInputValues(d.Args),
+		})
+	}
+	return &introspectionResult{Schema: schema}
+}
+
+func newNamedType(t *introspection.Type) *namedType {
+	if t == nil || t.Name() == nil {
+		return nil
+	}
+	return &namedType{Name: *t.Name()}
+}
+
+func newFullType(t *introspection.Type) *fullType {
+	ft := &fullType{
+		Kind:        t.Kind(),
+		Name:        t.Name(),
+		Description: description(t.Description()),
+	}
+	switch ft.Kind {
+	case string(ast.Object), string(ast.Interface):
+		ft.Fields = []*fieldType{}
+		for _, f := range t.Fields(true) {
+			f := f
+			ft.Fields = append(ft.Fields, &fieldType{
+				Name:              f.Name,
+				Description:       description(f.Description),
+				Args:              newInputValues(f.Args),
+				Type:              newTypeRef(f.Type),
+				IsDeprecated:      f.IsDeprecated(),
+				DeprecationReason: f.DeprecationReason(),
+			})
+		}
+		if ft.Kind == string(ast.Object) {
+			ft.Interfaces = newTypeRefs(t.Interfaces())
+		} else {
+			ft.PossibleTypes = newTypeRefs(t.PossibleTypes())
+		}
+	case string(ast.Union):
+		ft.PossibleTypes = newTypeRefs(t.PossibleTypes())
+	case string(ast.InputObject):
+		ft.InputFields = newInputValues(t.InputFields())
+	case string(ast.Enum):
+		ft.EnumValues = []*enumValueType{}
+		for _, v := range t.EnumValues(true) {
+			v := v
+			ft.EnumValues = append(ft.EnumValues, &enumValueType{
+				Name:              v.Name,
+				Description:       description(v.Description),
+				IsDeprecated:      v.IsDeprecated(),
+				DeprecationReason: v.DeprecationReason(),
+			})
+		}
+	}
+	return ft
+}
+
+func newInputValues(values []introspection.InputValue) []*inputValueType {
+	ivs := make([]*inputValueType, 0, len(values))
+	for _, v := range values {
+		ivs = append(ivs, &inputValueType{
+			Name:         v.Name,
+			Description:  description(v.Description),
+			Type:         newTypeRef(v.Type),
+			DefaultValue: v.DefaultValue,
+		})
+	}
+	return ivs
+}
+
+func newTypeRefs(types []introspection.Type) []*typeRef {
+	refs := make([]*typeRef, 0, len(types))
+	for i := range types {
+		refs = append(refs, newTypeRef(&types[i]))
+	}
+	return refs
+}
+
+func newTypeRef(t *introspection.Type) *typeRef {
+	if t == nil {
+		return nil
+	}
+	return &typeRef{Kind: t.Kind(), Name: t.Name(), OfType: newTypeRef(t.OfType())}
+}
+
+// description returns a pointer to the given description, or nil if it is empty.
+func description(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
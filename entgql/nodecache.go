@@ -0,0 +1,215 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// NodeCache is a request-scoped cache of nodes that is used by the generated
+// Noder and Noders functions, to ensure that the same node is loaded from the
+// database only once during an operation.
+type NodeCache struct {
+	mu         sync.Mutex
+	entries    map[nodeKey]*NodeCacheEntry
+	selections map[*ast.Field]string
+}
+
+// nodeKey identifies a node that was loaded for a field selection.
+type nodeKey struct {
+	table     string
+	id        interface{}
+	selection string
+}
+
+// NewNodeCache returns a new empty NodeCache.
+func NewNodeCache() *NodeCache {
+	return &NodeCache{
+		entries:    make(map[nodeKey]*NodeCacheEntry),
+		selections: make(map[*ast.Field]string),
+	}
+}
+
+// Acquire returns the cache entry of the given node. The returned boolean
+// reports if the entry was created by this call, in which case the caller
+// is responsible for loading the node and resolving the entry. Otherwise,
+// the caller should wait for the entry to be resolved.
+//
+// Entries are also keyed by the selection of the GraphQL field in the context,
+// because the edges that are eager-loaded with the node depend on it.
+func (c *NodeCache) Acquire(ctx context.Context, table string, id interface{}) (*NodeCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := nodeKey{table: table, id: id, selection: c.selection(ctx)}
+	if e, ok := c.entries[key]; ok {
+		return e, false
+	}
+	e := &NodeCacheEntry{done: make(chan struct{})}
+	c.entries[key] = e
+	return e, true
+}
+
+// selection returns the selection of the field in the context (including
+// the values of its arguments), or an empty string if there is no field.
+func (c *NodeCache) selection(ctx context.Context) string {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Field.Field == nil {
+		return ""
+	}
+	if s, ok := c.selections[fc.Field.Field]; ok {
+		return s
+	}
+	var vars map[string]interface{}
+	if graphql.HasOperationContext(ctx) {
+		vars = graphql.GetOperationContext(ctx).Variables
+	}
+	var b strings.Builder
+	writeSelection(&b, fc.Field.SelectionSet, vars)
+	c.selections[fc.Field.Field] = b.String()
+	return b.String()
+}
+
+// writeSelection writes the given selection set, with the resolved
+// values of its arguments and directives, to the given builder.
+func writeSelection(b *strings.Builder, set ast.SelectionSet, vars map[string]interface{}) {
+	if len(set) == 0 {
+		return
+	}
+	b.WriteByte('{')
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			fmt.Fprintf(b, "%s:%s", sel.Alias, sel.Name)
+			writeArguments(b, sel.Arguments, vars)
+			writeDirectives(b, sel.Directives, vars)
+			writeSelection(b, sel.SelectionSet, vars)
+		case *ast.InlineFragment:
+			b.WriteString("..." + sel.TypeCondition)
+			writeDirectives(b, sel.Directives, vars)
+			writeSelection(b, sel.SelectionSet, vars)
+		case *ast.FragmentSpread:
+			if sel.Definition == nil {
+				continue
+			}
+			b.WriteString("..." + sel.Definition.TypeCondition)
+			writeDirectives(b, sel.Directives, vars)
+			writeSelection(b, sel.Definition.SelectionSet, vars)
+		}
+		b.WriteByte(' ')
+	}
+	b.WriteByte('}')
+}
+
+func writeDirectives(b *strings.Builder, dirs ast.DirectiveList, vars map[string]interface{}) {
+	for _, d := range dirs {
+		b.WriteString("@" + d.Name)
+		writeArguments(b, d.Arguments, vars)
+	}
+}
+
+func writeArguments(b *strings.Builder, args ast.ArgumentList, vars map[string]interface{}) {
+	if len(args) == 0 {
+		return
+	}
+	values := make(map[string]interface{}, len(args))
+	for _, arg := range args {
+		v, err := arg.Value.Value(vars)
+		if err != nil {
+			v = arg.Value.String()
+		}
+		values[arg.Name] = v
+	}
+	// Maps are encoded with sorted keys.
+	if buf, err := json.Marshal(values); err == nil {
+		b.Write(buf)
+	} else {
+		fmt.Fprint(b, values)
+	}
+}
+
+// NodeCacheEntry holds the result of loading a node.
+type NodeCacheEntry struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// Resolve sets the result of the entry and releases its waiters.
+// It should be called exactly once, by the entry owner.
+func (e *NodeCacheEntry) Resolve(v interface{}, err error) {
+	e.value, e.err = v, err
+	close(e.done)
+}
+
+// Wait blocks until the entry is resolved, or the context is done.
+func (e *NodeCacheEntry) Wait(ctx context.Context) (interface{}, error) {
+	select {
+	case <-e.done:
+		return e.value, e.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+type nodeCacheKey struct{}
+
+// NewNodeCacheContext returns a new context with the given NodeCache attached.
+func NewNodeCacheContext(parent context.Context, c *NodeCache) context.Context {
+	return context.WithValue(parent, nodeCacheKey{}, c)
+}
+
+// NodeCacheFromContext returns the NodeCache stored in a context, or nil if there isn't one.
+func NodeCacheFromContext(ctx context.Context) *NodeCache {
+	c, _ := ctx.Value(nodeCacheKey{}).(*NodeCache)
+	return c
+}
+
+// NodeCacher is a gqlgen extension that attaches a new NodeCache to each query
+// operation. Mutations and subscriptions are executed without a cache, because
+// their nodes may change during the operation.
+//
+//	srv.Use(entgql.NodeCacher{})
+//
+type NodeCacher struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = NodeCacher{}
+
+// ExtensionName returns the extension name.
+func (NodeCacher) ExtensionName() string {
+	return "EntGQLNodeCacher"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (NodeCacher) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse attaches a node cache to the context of query operations.
+func (NodeCacher) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if op := graphql.GetOperationContext(ctx).Operation; op == nil || op.Operation != ast.Query {
+		return next(ctx)
+	}
+	return next(NewNodeCacheContext(ctx, NewNodeCache()))
+}
@@ -0,0 +1,65 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeCache(t *testing.T) {
+	require.Nil(t, entgql.NodeCacheFromContext(context.Background()))
+	c := entgql.NewNodeCache()
+	ctx := entgql.NewNodeCacheContext(context.Background(), c)
+	require.Equal(t, c, entgql.NodeCacheFromContext(ctx))
+
+	e, owner := c.Acquire(ctx, "users", 1)
+	require.True(t, owner)
+	_, owner = c.Acquire(ctx, "groups", 1)
+	require.True(t, owner, "entries are keyed by table and id")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			e, owner := c.Acquire(ctx, "users", 1)
+			require.False(t, owner)
+			v, err := e.Wait(ctx)
+			require.NoError(t, err)
+			require.Equal(t, "a8m", v)
+		}()
+	}
+	e.Resolve("a8m", nil)
+	wg.Wait()
+
+	e, _ = c.Acquire(ctx, "users", 2)
+	e.Resolve(nil, errors.New("not found"))
+	e, owner = c.Acquire(ctx, "users", 2)
+	require.False(t, owner)
+	_, err := e.Wait(ctx)
+	require.EqualError(t, err, "not found")
+
+	e, _ = c.Acquire(ctx, "users", 3)
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = e.Wait(cctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
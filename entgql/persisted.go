@@ -0,0 +1,227 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/mitchellh/mapstructure"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// PersistedOperation is an allowlisted GraphQL operation document.
+type PersistedOperation struct {
+	// Path of the document, relative to the operations directory.
+	Path string
+	// Hash is the hex-encoded SHA-256 hash of the document, which
+	// can be sent by clients instead of the document itself.
+	Hash string
+	// Query holds the document body.
+	Query string
+}
+
+// PersistedOperations is a gqlgen extension that accepts only the operations
+// defined in a directory of GraphQL documents (.graphql files). The documents
+// are validated against the server schema when the extension is added to the
+// server, and requests are accepted only if their query is one of the documents,
+// or if they send the hash of a document using the persistedQuery extension:
+//
+//	{"extensions": {"persistedQuery": {"version": 1, "sha256Hash": "<hash>"}}}
+//
+// Note that the extension handles the persistedQuery extension by itself, and therefore,
+// it should not be used with the AutomaticPersistedQuery extension of gqlgen (that is
+// enabled by handler.NewDefaultServer).
+//
+// Usage:
+//
+//	ops, err := entgql.NewPersistedOperations("./operations")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	srv := handler.New(todo.NewSchema(client))
+//	srv.AddTransport(transport.POST{})
+//	srv.Use(ops)
+//
+type PersistedOperations struct {
+	ops    []*PersistedOperation
+	hashes map[string]*PersistedOperation
+	used   map[string]map[string]bool
+	unused map[string][]string
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationParameterMutator
+} = (*PersistedOperations)(nil)
+
+// NewPersistedOperations loads the GraphQL documents in the given
+// directory (and its subdirectories) and returns a new extension
+// that accepts only their operations.
+func NewPersistedOperations(dir string) (*PersistedOperations, error) {
+	p := &PersistedOperations{hashes: make(map[string]*PersistedOperation)}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".graphql" {
+			return err
+		}
+		buf, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading graphql document %q: %w", path, err)
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		op := &PersistedOperation{Path: filepath.ToSlash(rel), Hash: operationHash(string(buf)), Query: string(buf)}
+		if prev, ok := p.hashes[op.Hash]; ok {
+			return fmt.Errorf("entgql: documents %q and %q are identical", prev.Path, op.Path)
+		}
+		p.ops = append(p.ops, op)
+		p.hashes[op.Hash] = op
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(p.ops) == 0 {
+		return nil, fmt.Errorf("entgql: no graphql documents were found in %q", dir)
+	}
+	return p, nil
+}
+
+// Operations returns the persisted operations, sorted by their paths.
+func (p *PersistedOperations) Operations() []*PersistedOperation {
+	return p.ops
+}
+
+// ExtensionName returns the extension name.
+func (*PersistedOperations) ExtensionName() string {
+	return "EntGQLPersistedOperations"
+}
+
+// Validate is called when adding an extension to the server, it validates
+// the persisted operations against the servers schema.
+func (p *PersistedOperations) Validate(s graphql.ExecutableSchema) error {
+	schema := s.Schema()
+	if schema == nil {
+		return errors.New("entgql: executable schema is nil")
+	}
+	p.used = make(map[string]map[string]bool)
+	for _, op := range p.ops {
+		doc, errs := gqlparser.LoadQuery(schema, op.Query)
+		if len(errs) > 0 {
+			return fmt.Errorf("entgql: invalid graphql document %q: %w", op.Path, errs)
+		}
+		for _, o := range doc.Operations {
+			p.collect(o.SelectionSet)
+		}
+		for _, f := range doc.Fragments {
+			p.collect(f.SelectionSet)
+		}
+	}
+	p.unused = make(map[string][]string)
+	for name, t := range schema.Types {
+		if t.Kind != ast.Object || strings.HasPrefix(name, "__") {
+			continue
+		}
+		for _, f := range t.Fields {
+			if !strings.HasPrefix(f.Name, "__") && !p.used[name][f.Name] {
+				p.unused[name] = append(p.unused[name], f.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// collect records the schema fields that are selected in the given selection set.
+func (p *PersistedOperations) collect(set ast.SelectionSet) {
+	for _, s := range set {
+		switch s := s.(type) {
+		case *ast.Field:
+			if s.ObjectDefinition != nil && !strings.HasPrefix(s.Name, "__") {
+				if p.used[s.ObjectDefinition.Name] == nil {
+					p.used[s.ObjectDefinition.Name] = make(map[string]bool)
+				}
+				p.used[s.ObjectDefinition.Name][s.Name] = true
+			}
+			p.collect(s.SelectionSet)
+		case *ast.InlineFragment:
+			p.collect(s.SelectionSet)
+		}
+	}
+}
+
+// Used returns the fields (and edges) that are selected by the persisted operations,
+// keyed by their object (or interface) type name. It is computed by the Validate method.
+func (p *PersistedOperations) Used() map[string][]string {
+	used := make(map[string][]string, len(p.used))
+	for name, fields := range p.used {
+		for f := range fields {
+			used[name] = append(used[name], f)
+		}
+		sort.Strings(used[name])
+	}
+	return used
+}
+
+// Unused returns the fields (and edges) of the object types in the schema that are
+// not selected by any of the persisted operations, keyed by their type name. It can
+// be used for pruning the schema, and it is computed by the Validate method.
+func (p *PersistedOperations) Unused() map[string][]string {
+	return p.unused
+}
+
+// MutateOperationParameters rejects operations that are not persisted, and sets
+// the query of operations that were sent using the hash of their document.
+func (p *PersistedOperations) MutateOperationParameters(_ context.Context, params *graphql.RawParams) *gqlerror.Error {
+	var ext struct {
+		Sha256 string `mapstructure:"sha256Hash"`
+	}
+	if err := mapstructure.Decode(params.Extensions["persistedQuery"], &ext); err != nil {
+		return gqlerror.Errorf("invalid persistedQuery extension data")
+	}
+	switch {
+	case params.Query == "" && ext.Sha256 == "":
+		return ErrOperationNotAllowed("")
+	case params.Query == "":
+		op, ok := p.hashes[ext.Sha256]
+		if !ok {
+			return ErrOperationNotAllowed(ext.Sha256)
+		}
+		params.Query = op.Query
+	default:
+		h := operationHash(params.Query)
+		if _, ok := p.hashes[h]; !ok || ext.Sha256 != "" && ext.Sha256 != h {
+			return ErrOperationNotAllowed(h)
+		}
+	}
+	return nil
+}
+
+func operationHash(query string) string {
+	h := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(h[:])
+}
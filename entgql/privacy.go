@@ -0,0 +1,60 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent/privacy"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// PrivacyErrors is a gqlgen extension that translates ent privacy denials into
+// FORBIDDEN errors of the fields that were denied. Denied fields are resolved
+// to null, and the rest of the operation is executed as usual. Note that, per
+// the GraphQL spec, a denied non-null field nullifies its parent field.
+//
+//	srv.Use(entgql.PrivacyErrors{})
+//
+type PrivacyErrors struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = PrivacyErrors{}
+
+// ExtensionName returns the extension name.
+func (PrivacyErrors) ExtensionName() string {
+	return "EntGQLPrivacyErrors"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (PrivacyErrors) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptField replaces privacy denials of the field resolver with a FORBIDDEN error.
+func (PrivacyErrors) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	res, err := next(ctx)
+	if err == nil || !errors.Is(err, privacy.Deny) {
+		return res, err
+	}
+	name := ""
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		name = fc.Field.Name
+	}
+	return nil, ErrForbidden(name)
+}
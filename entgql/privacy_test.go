@@ -0,0 +1,52 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/privacy"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestPrivacyErrors(t *testing.T) {
+	ctx := graphql.WithFieldContext(context.Background(), &graphql.FieldContext{
+		Field: graphql.CollectedField{Field: &ast.Field{Name: "secret"}},
+	})
+	resolve := func(v interface{}, err error) graphql.Resolver {
+		return func(context.Context) (interface{}, error) { return v, err }
+	}
+	ext := entgql.PrivacyErrors{}
+
+	res, err := ext.InterceptField(ctx, resolve("value", nil))
+	require.NoError(t, err)
+	require.Equal(t, "value", res)
+	_, err = ext.InterceptField(ctx, resolve(nil, errors.New("internal")))
+	require.EqualError(t, err, "internal")
+
+	res, err = ext.InterceptField(ctx, resolve("value", fmt.Errorf("not an admin: %w", privacy.Deny)))
+	require.Nil(t, res)
+	var gqlerr *gqlerror.Error
+	require.True(t, errors.As(err, &gqlerr))
+	require.Equal(t, "FORBIDDEN", gqlerr.Extensions["code"])
+	require.Equal(t, "Not allowed to access the 'secret' field", gqlerr.Message)
+}
@@ -0,0 +1,73 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// MarshalValue marshals the driver.Value of a custom Go type (i.e. the result of its
+// Value method) to a GraphQL value. It is used by the marshalers that are generated by
+// the ScalarTemplate. Byte slices are marshaled as strings, and time values as RFC 3339
+// strings.
+func MarshalValue(v driver.Value) graphql.Marshaler {
+	switch v := v.(type) {
+	case nil:
+		return graphql.Null
+	case []byte:
+		return graphql.MarshalString(string(v))
+	case string:
+		return graphql.MarshalString(v)
+	case time.Time:
+		return graphql.MarshalTime(v)
+	default:
+		return graphql.MarshalAny(v)
+	}
+}
+
+// UnmarshalValue unmarshals a GraphQL input value to a driver.Value that can be passed to
+// the Scan method of a custom Go type. It is used by the unmarshalers that are generated by
+// the ScalarTemplate. Numbers are converted to int64 (or float64), and objects and lists
+// are encoded as JSON (i.e. []byte).
+func UnmarshalValue(v interface{}) (driver.Value, error) {
+	switch v := v.(type) {
+	case nil, string, bool, int64, float64, []byte, time.Time:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case float32:
+		return float64(v), nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return f, nil
+	case map[string]interface{}, []interface{}:
+		return json.Marshal(v)
+	default:
+		return nil, fmt.Errorf("entgql: unexpected value type %T", v)
+	}
+}
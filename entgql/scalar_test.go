@@ -0,0 +1,65 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalValue(t *testing.T) {
+	for _, tt := range []struct {
+		value interface{}
+		want  string
+	}{
+		{nil, "null"},
+		{int64(10), "10\n"},
+		{1.5, "1.5\n"},
+		{true, "true\n"},
+		{"text", `"text"`},
+		{[]byte(`{"a":1}`), `"{\"a\":1}"`},
+		{time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC), `"2021-09-01T00:00:00Z"`},
+	} {
+		var buf bytes.Buffer
+		entgql.MarshalValue(tt.value).MarshalGQL(&buf)
+		require.Equal(t, tt.want, buf.String())
+	}
+}
+
+func TestUnmarshalValue(t *testing.T) {
+	for _, tt := range []struct {
+		value interface{}
+		want  interface{}
+	}{
+		{nil, nil},
+		{"text", "text"},
+		{10, int64(10)},
+		{json.Number("10"), int64(10)},
+		{json.Number("1.5"), 1.5},
+		{map[string]interface{}{"a": 1}, []byte(`{"a":1}`)},
+		{[]interface{}{"a"}, []byte(`["a"]`)},
+	} {
+		v, err := entgql.UnmarshalValue(tt.value)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, v)
+	}
+	_, err := entgql.UnmarshalValue(struct{}{})
+	require.EqualError(t, err, "entgql: unexpected value type struct {}")
+}
@@ -0,0 +1,402 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sdl generates ent schemas from an existing GraphQL schema (SDL).
+package sdl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/schemast"
+	"entgo.io/ent"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// GenerateSchema reads the GraphQL schema (SDL) file in sdlPath, and upserts the ent
+// schemas of its object types to the ent schema package in schemaPath. See Schemas
+// for more info about how GraphQL types are mapped to ent schemas.
+//
+//	sdl.GenerateSchema("./schema.graphql", "./ent/schema")
+//
+func GenerateSchema(sdlPath, schemaPath string) error {
+	buf, err := ioutil.ReadFile(sdlPath)
+	if err != nil {
+		return fmt.Errorf("reading graphql schema %q: %w", sdlPath, err)
+	}
+	schemas, err := Schemas(filepath.Base(sdlPath), buf)
+	if err != nil {
+		return err
+	}
+	ctx, err := schemast.Load(schemaPath)
+	if err != nil {
+		return fmt.Errorf("loading ent schema %q: %w", schemaPath, err)
+	}
+	mutations := make([]schemast.Mutator, 0, len(schemas))
+	for _, s := range schemas {
+		mutations = append(mutations, s)
+	}
+	if err := schemast.Mutate(ctx, mutations...); err != nil {
+		return err
+	}
+	return ctx.Print(schemaPath)
+}
+
+// Schemas parses the given GraphQL schema (SDL) and returns the schemast mutations
+// for creating the ent schemas of its object types. The root operation types, the PageInfo
+// type and the Relay connection and edge types are not mapped to ent schemas. Object fields
+// are mapped as follows: The "id" field of type ID is skipped, as it is the default ID field
+// in ent. Scalar and enum fields are mapped to ent fields, and nullable fields are marked as
+// Optional. Object, list and connection fields are mapped to edges, and edges to a single
+// object are marked as Unique. Fields that are listed in the order enum of a connection
+// (e.g. TodoOrderField) are annotated with entgql.OrderField, and edges are annotated with
+// entgql.Bind (or entgql.MapsTo if their ent name differs from their GraphQL name).
+//
+// Reciprocal object fields (i.e. the only field of type A that points to type B, and the only
+// field of type B that points to type A, or the only two fields of a type that point to itself)
+// are paired into an assoc edge (edge.To) and its inverse edge (edge.From). The inverse edge is
+// the unique side of the pair, or the field of the later type if both sides are (non-)unique. Other
+// fields are generated as assoc edges.
+func Schemas(name string, sdl []byte) ([]*schemast.UpsertSchema, error) {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: &source.Source{
+			Body: sdl,
+			Name: name,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parsing graphql schema %q: %w", name, err)
+	}
+	return newSDLMapper(doc).schemas()
+}
+
+type sdlMapper struct {
+	objects map[string]*ast.ObjectDefinition
+	enums   map[string]*ast.EnumDefinition
+	inputs  map[string]*ast.InputObjectDefinition
+	// roots holds the root operation types.
+	roots map[string]bool
+	// order holds the order fields (enum values) of each type.
+	order map[string]map[string]bool
+	types []*ast.ObjectDefinition
+	// inverse holds the inverse edge fields,
+	// mapped to the name of their assoc edges.
+	inverse map[*ast.FieldDefinition]string
+}
+
+// relation describes an object field that is mapped to an edge.
+type relation struct {
+	field  *ast.FieldDefinition
+	owner  string
+	target string
+	unique bool
+}
+
+func newSDLMapper(doc *ast.Document) *sdlMapper {
+	m := &sdlMapper{
+		objects: make(map[string]*ast.ObjectDefinition),
+		enums:   make(map[string]*ast.EnumDefinition),
+		inputs:  make(map[string]*ast.InputObjectDefinition),
+		roots:   map[string]bool{"Query": true, "Mutation": true, "Subscription": true},
+		order:   make(map[string]map[string]bool),
+	}
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.ObjectDefinition:
+			m.objects[def.Name.Value] = def
+			m.types = append(m.types, def)
+		case *ast.EnumDefinition:
+			m.enums[def.Name.Value] = def
+		case *ast.InputObjectDefinition:
+			m.inputs[def.Name.Value] = def
+		case *ast.SchemaDefinition:
+			m.roots = make(map[string]bool)
+			for _, op := range def.OperationTypes {
+				m.roots[op.Type.Name.Value] = true
+			}
+		}
+	}
+	for _, t := range m.types {
+		for _, f := range t.Fields {
+			m.collectOrder(f)
+		}
+	}
+	return m
+}
+
+// collectOrder collects the order fields of connection fields that accept
+// an order input (e.g. "orderBy: TodoOrder") with an enum field.
+func (m *sdlMapper) collectOrder(f *ast.FieldDefinition) {
+	node, ok := m.connectionNode(baseType(f.Type))
+	if !ok {
+		return
+	}
+	for _, arg := range f.Arguments {
+		input, ok := m.inputs[baseType(arg.Type)]
+		if !ok {
+			continue
+		}
+		for _, v := range input.Fields {
+			enum, ok := m.enums[baseType(v.Type)]
+			if !ok || v.Name.Value != "field" {
+				continue
+			}
+			if m.order[node] == nil {
+				m.order[node] = make(map[string]bool)
+			}
+			for _, ev := range enum.Values {
+				m.order[node][ev.Name.Value] = true
+			}
+		}
+	}
+}
+
+func (m *sdlMapper) schemas() ([]*schemast.UpsertSchema, error) {
+	m.pairRelations()
+	var schemas []*schemast.UpsertSchema
+	for _, t := range m.types {
+		name := t.Name.Value
+		if m.skipType(name) {
+			continue
+		}
+		s := &schemast.UpsertSchema{Name: name}
+		for _, f := range t.Fields {
+			if err := m.appendField(s, f); err != nil {
+				return nil, err
+			}
+		}
+		schemas = append(schemas, s)
+	}
+	return schemas, nil
+}
+
+// skipType reports if the given object type is not mapped to an ent schema.
+func (m *sdlMapper) skipType(name string) bool {
+	return m.roots[name] || name == "PageInfo" || m.isConnection(name) || m.isEdge(name)
+}
+
+// relation returns the relation of the given object field, if it is mapped to an edge.
+func (m *sdlMapper) relation(owner string, f *ast.FieldDefinition) (*relation, bool) {
+	typ := baseType(f.Type)
+	if node, ok := m.connectionNode(typ); ok {
+		return &relation{field: f, owner: owner, target: node}, true
+	}
+	if _, ok := m.objects[typ]; ok {
+		_, list := unwrapNonNull(f.Type).(*ast.List)
+		return &relation{field: f, owner: owner, target: typ, unique: !list}, true
+	}
+	return nil, false
+}
+
+// pairRelations pairs the reciprocal relations of the object types
+// into assoc and inverse edges, and records the inverse edges.
+func (m *sdlMapper) pairRelations() {
+	m.inverse = make(map[*ast.FieldDefinition]string)
+	type typePair struct{ from, to string }
+	var (
+		pos  = make(map[string]int)
+		rels = make(map[typePair][]*relation)
+	)
+	for i, t := range m.types {
+		name := t.Name.Value
+		if m.skipType(name) {
+			continue
+		}
+		pos[name] = i
+		for _, f := range t.Fields {
+			if r, ok := m.relation(name, f); ok {
+				k := typePair{from: name, to: r.target}
+				rels[k] = append(rels[k], r)
+			}
+		}
+	}
+	for k, from := range rels {
+		var assoc, inverse *relation
+		switch to := rels[typePair{from: k.to, to: k.from}]; {
+		case k.from == k.to && len(from) == 2:
+			assoc, inverse = from[0], from[1]
+		case k.from != k.to && len(from) == 1 && len(to) == 1 && pos[k.from] < pos[k.to]:
+			assoc, inverse = from[0], to[0]
+		default:
+			continue
+		}
+		if assoc.unique && !inverse.unique {
+			assoc, inverse = inverse, assoc
+		}
+		m.inverse[inverse.field] = snake(assoc.field.Name.Value)
+	}
+}
+
+func (m *sdlMapper) appendField(s *schemast.UpsertSchema, f *ast.FieldDefinition) error {
+	var (
+		typ         = baseType(f.Type)
+		_, nonNull  = f.Type.(*ast.NonNull)
+		_, list     = unwrapNonNull(f.Type).(*ast.List)
+		gqlName     = f.Name.Value
+		name        = snake(gqlName)
+		annotations []schema.Annotation
+	)
+	if gqlName == "id" && typ == "ID" {
+		return nil
+	}
+	if r, ok := m.relation(s.Name, f); ok {
+		e := m.edge(name, gqlName, r.target, r.unique, r.unique && nonNull)
+		if ref, ok := m.inverse[f]; ok {
+			e.Inverse, e.RefName = true, ref
+		}
+		s.Edges = append(s.Edges, e)
+		return nil
+	}
+	if list {
+		return fmt.Errorf("entgql: unsupported list field %s.%s of type %s", s.Name, gqlName, typ)
+	}
+	if m.order[s.Name][strings.ToUpper(name)] {
+		annotations = append(annotations, entgql.OrderField(strings.ToUpper(name)))
+	}
+	var fd ent.Field
+	switch typ {
+	case "ID", "String":
+		fd = field.String(name)
+	case "Int":
+		fd = field.Int(name)
+	case "Float":
+		fd = field.Float(name)
+	case "Boolean":
+		fd = field.Bool(name)
+	case "Time":
+		fd = field.Time(name)
+	default:
+		enum, ok := m.enums[typ]
+		if !ok {
+			return fmt.Errorf("entgql: unsupported type %s of field %s.%s", typ, s.Name, gqlName)
+		}
+		values := make([]string, 0, len(enum.Values))
+		for _, v := range enum.Values {
+			values = append(values, v.Name.Value)
+		}
+		fd = field.Enum(name).Values(values...)
+	}
+	// The builders of the different field types do not share an interface
+	// for their options. Hence, the options are set on their descriptors.
+	desc := fd.Descriptor()
+	desc.Optional = !nonNull
+	desc.Annotations = annotations
+	s.Fields = append(s.Fields, fd)
+	return nil
+}
+
+// edge returns an edge to the given type. Edges are annotated with the Bind annotation
+// if their name is equal to their GraphQL name, or with the MapsTo annotation otherwise.
+func (m *sdlMapper) edge(name, gqlName, typ string, unique, required bool) *sdlEdge {
+	ant := entgql.Bind()
+	if name != gqlName {
+		ant = entgql.MapsTo(gqlName)
+	}
+	return &sdlEdge{
+		Name:        name,
+		Type:        typ,
+		Unique:      unique,
+		Required:    required,
+		Annotations: []schema.Annotation{ant},
+	}
+}
+
+// connectionNode returns the node type of the given connection type. A connection type
+// is an object type named <T>Connection, with an "edges" field that holds the edge type.
+func (m *sdlMapper) connectionNode(name string) (string, bool) {
+	t, ok := m.objects[name]
+	if !ok || !strings.HasSuffix(name, "Connection") {
+		return "", false
+	}
+	for _, f := range t.Fields {
+		if f.Name.Value == "edges" && m.isEdge(baseType(f.Type)) {
+			return m.edgeNode(baseType(f.Type)), true
+		}
+	}
+	return "", false
+}
+
+func (m *sdlMapper) isConnection(name string) bool {
+	_, ok := m.connectionNode(name)
+	return ok
+}
+
+// isEdge reports if the given type is a connection edge type. An edge type
+// is an object type named <T>Edge, with the "node" and "cursor" fields.
+func (m *sdlMapper) isEdge(name string) bool {
+	t, ok := m.objects[name]
+	if !ok || !strings.HasSuffix(name, "Edge") {
+		return false
+	}
+	var node, cursor bool
+	for _, f := range t.Fields {
+		switch f.Name.Value {
+		case "node":
+			node = true
+		case "cursor":
+			cursor = true
+		}
+	}
+	return node && cursor
+}
+
+func (m *sdlMapper) edgeNode(name string) string {
+	for _, f := range m.objects[name].Fields {
+		if f.Name.Value == "node" {
+			return baseType(f.Type)
+		}
+	}
+	return ""
+}
+
+// sdlEdge implements the ent.Edge interface for edges that
+// their types are known only by their names.
+type sdlEdge edge.Descriptor
+
+// Descriptor implements the ent.Edge interface.
+func (e *sdlEdge) Descriptor() *edge.Descriptor {
+	return (*edge.Descriptor)(e)
+}
+
+// baseType returns the name of the underlying named type.
+func baseType(t ast.Type) string {
+	switch t := t.(type) {
+	case *ast.NonNull:
+		return baseType(t.Type)
+	case *ast.List:
+		return baseType(t.Type)
+	case *ast.Named:
+		return t.Name.Value
+	default:
+		return ""
+	}
+}
+
+func unwrapNonNull(t ast.Type) ast.Type {
+	if n, ok := t.(*ast.NonNull); ok {
+		return n.Type
+	}
+	return t
+}
+
+var snake = gen.Funcs["snake"].(func(string) string)
@@ -0,0 +1,206 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdl
+
+import (
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/stretchr/testify/require"
+)
+
+const sdlSchema = `
+scalar Time
+scalar Cursor
+
+enum Status { IN_PROGRESS COMPLETED }
+enum OrderDirection { ASC DESC }
+enum TodoOrderField { CREATED_AT PRIORITY }
+
+input TodoOrder {
+	direction: OrderDirection!
+	field: TodoOrderField
+}
+
+type PageInfo {
+	hasNextPage: Boolean!
+	hasPreviousPage: Boolean!
+	startCursor: Cursor
+	endCursor: Cursor
+}
+
+type TodoConnection {
+	totalCount: Int!
+	pageInfo: PageInfo!
+	edges: [TodoEdge]
+}
+
+type TodoEdge {
+	node: Todo
+	cursor: Cursor!
+}
+
+type Todo {
+	id: ID!
+	createdAt: Time!
+	status: Status!
+	priority: Int!
+	text: String
+	parent: Todo
+	children: [Todo!]
+	owner: User!
+}
+
+type User {
+	id: ID!
+	name: String!
+	todos: TodoConnection
+}
+
+type Query {
+	todos(after: Cursor, first: Int, orderBy: TodoOrder): TodoConnection
+}
+`
+
+func TestSchemas(t *testing.T) {
+	schemas, err := Schemas("schema.graphql", []byte(sdlSchema))
+	require.NoError(t, err)
+	require.Len(t, schemas, 2)
+
+	todo := schemas[0]
+	require.Equal(t, "Todo", todo.Name)
+	require.Len(t, todo.Fields, 4)
+	fields := make(map[string]*field.Descriptor)
+	for _, f := range todo.Fields {
+		fields[f.Descriptor().Name] = f.Descriptor()
+	}
+	require.Equal(t, field.TypeTime, fields["created_at"].Info.Type)
+	require.False(t, fields["created_at"].Optional)
+	require.Equal(t, []schema.Annotation{entgql.OrderField("CREATED_AT")}, fields["created_at"].Annotations)
+	require.Equal(t, field.TypeEnum, fields["status"].Info.Type)
+	require.Len(t, fields["status"].Enums, 2)
+	require.Empty(t, fields["status"].Annotations)
+	require.Equal(t, []schema.Annotation{entgql.OrderField("PRIORITY")}, fields["priority"].Annotations)
+	require.True(t, fields["text"].Optional)
+
+	require.Len(t, todo.Edges, 3)
+	parent := todo.Edges[0].Descriptor()
+	require.Equal(t, "parent", parent.Name)
+	require.Equal(t, "Todo", parent.Type)
+	require.True(t, parent.Unique)
+	require.False(t, parent.Required)
+	require.Equal(t, []schema.Annotation{entgql.Bind()}, parent.Annotations)
+	require.True(t, parent.Inverse, "unique side of the pair is the inverse edge")
+	require.Equal(t, "children", parent.RefName)
+	children := todo.Edges[1].Descriptor()
+	require.Equal(t, "children", children.Name)
+	require.False(t, children.Unique)
+	require.False(t, children.Inverse)
+	owner := todo.Edges[2].Descriptor()
+	require.Equal(t, "User", owner.Type)
+	require.True(t, owner.Unique)
+	require.True(t, owner.Required)
+	require.True(t, owner.Inverse)
+	require.Equal(t, "todos", owner.RefName)
+
+	user := schemas[1]
+	require.Equal(t, "User", user.Name)
+	require.Len(t, user.Fields, 1)
+	require.Len(t, user.Edges, 1)
+	todos := user.Edges[0].Descriptor()
+	require.Equal(t, "todos", todos.Name)
+	require.Equal(t, "Todo", todos.Type)
+	require.False(t, todos.Unique)
+	require.False(t, todos.Inverse)
+}
+
+func TestSchemas_Inverse(t *testing.T) {
+	schemas, err := Schemas("schema.graphql", []byte(`
+type User {
+	id: ID!
+	groups: [Group!]
+	spouse: User
+	posts: [Post!]
+	drafts: [Post!]
+}
+type Group {
+	id: ID!
+	users: [User!]
+}
+type Post {
+	id: ID!
+	author: User
+}
+`))
+	require.NoError(t, err)
+	require.Len(t, schemas, 3)
+	edges := make(map[string]string)
+	for _, s := range schemas {
+		for _, e := range s.Edges {
+			d := e.Descriptor()
+			edges[s.Name+"."+d.Name] = d.RefName
+			require.Equal(t, d.RefName != "", d.Inverse)
+		}
+	}
+	require.Equal(t, map[string]string{
+		// Many-to-many edges are inversed on the later type.
+		"User.groups": "",
+		"Group.users": "groups",
+		// Self-references with a single field are assoc edges.
+		"User.spouse": "",
+		// Edges without a single reciprocal field are assoc edges.
+		"User.posts":  "",
+		"User.drafts": "",
+		"Post.author": "",
+	}, edges)
+}
+
+func TestSchemas_Mapping(t *testing.T) {
+	schemas, err := Schemas("schema.graphql", []byte(`
+type User {
+	id: ID!
+	bestFriend: User
+}
+`))
+	require.NoError(t, err)
+	require.Len(t, schemas, 1)
+	e := schemas[0].Edges[0].Descriptor()
+	require.Equal(t, "best_friend", e.Name)
+	require.Equal(t, []schema.Annotation{entgql.MapsTo("bestFriend")}, e.Annotations)
+}
+
+func TestSchemas_Errors(t *testing.T) {
+	_, err := Schemas("schema.graphql", []byte(`type User {`))
+	require.Error(t, err)
+
+	_, err = Schemas("schema.graphql", []byte(`
+scalar Map
+type User {
+	id: ID!
+	labels: Map
+}
+`))
+	require.EqualError(t, err, "entgql: unsupported type Map of field User.labels")
+
+	_, err = Schemas("schema.graphql", []byte(`
+type User {
+	id: ID!
+	tags: [String!]
+}
+`))
+	require.EqualError(t, err, "entgql: unsupported list field User.tags of type String")
+}
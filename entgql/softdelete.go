@@ -0,0 +1,81 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// IncludeDeletedArg is the name of the argument for including soft-deleted nodes.
+// The extension adds it to the query fields and edge resolvers of types that have a
+// field annotated with entgql.SoftDelete(entgql.AllowIncludeDeleted()).
+const IncludeDeletedArg = "includeDeleted"
+
+// IncludeDeleted is a gqlgen extension that gates the includeDeleted argument of
+// connection fields. Fields that are queried with "includeDeleted: true" are resolved
+// only if the Authorize hook allows it, and they are resolved to null with a FORBIDDEN
+// error otherwise.
+//
+//	srv.Use(entgql.IncludeDeleted{
+//		Authorize: func(ctx context.Context, fc *graphql.FieldContext) error {
+//			if !viewer.FromContext(ctx).Admin() {
+//				return errors.New("only admins can query deleted nodes")
+//			}
+//			return nil
+//		},
+//	})
+//
+type IncludeDeleted struct {
+	// Authorize is called before resolving fields that were queried
+	// with "includeDeleted: true". A non-nil error denies the field.
+	Authorize func(context.Context, *graphql.FieldContext) error
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = IncludeDeleted{}
+
+// ExtensionName returns the extension name.
+func (IncludeDeleted) ExtensionName() string {
+	return "EntGQLIncludeDeleted"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (i IncludeDeleted) Validate(graphql.ExecutableSchema) error {
+	if i.Authorize == nil {
+		return errors.New("entgql: IncludeDeleted.Authorize is nil")
+	}
+	return nil
+}
+
+// InterceptField calls the Authorize hook for fields that include soft-deleted nodes.
+func (i IncludeDeleted) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Field.Field == nil || len(fc.Field.Arguments) == 0 {
+		return next(ctx)
+	}
+	args := fc.Field.ArgumentMap(graphql.GetOperationContext(ctx).Variables)
+	if include, _ := args[IncludeDeletedArg].(bool); !include {
+		return next(ctx)
+	}
+	if err := i.Authorize(ctx, fc); err != nil {
+		return nil, ErrForbidden(fc.Field.Name)
+	}
+	return next(ctx)
+}
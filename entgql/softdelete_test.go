@@ -0,0 +1,70 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestIncludeDeleted(t *testing.T) {
+	require.Error(t, entgql.IncludeDeleted{}.Validate(nil))
+	var calls int
+	ext := entgql.IncludeDeleted{
+		Authorize: func(context.Context, *graphql.FieldContext) error {
+			calls++
+			return errors.New("not an admin")
+		},
+	}
+	require.NoError(t, ext.Validate(nil))
+	resolve := func(context.Context) (interface{}, error) { return "value", nil }
+	withArg := func(raw string) context.Context {
+		f := &ast.Field{
+			Name: "todos",
+			Definition: &ast.FieldDefinition{
+				Name: "todos",
+				Arguments: ast.ArgumentDefinitionList{
+					{Name: entgql.IncludeDeletedArg, Type: ast.NamedType("Boolean", nil)},
+				},
+			},
+			Arguments: ast.ArgumentList{
+				{Name: entgql.IncludeDeletedArg, Value: &ast.Value{Kind: ast.BooleanValue, Raw: raw}},
+			},
+		}
+		ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{})
+		return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+			Field: graphql.CollectedField{Field: f},
+		})
+	}
+
+	res, err := ext.InterceptField(withArg("false"), resolve)
+	require.NoError(t, err)
+	require.Equal(t, "value", res)
+	require.Zero(t, calls)
+
+	res, err = ext.InterceptField(withArg("true"), resolve)
+	require.Nil(t, res)
+	require.Equal(t, 1, calls)
+	var gqlerr *gqlerror.Error
+	require.True(t, errors.As(err, &gqlerr))
+	require.Equal(t, "FORBIDDEN", gqlerr.Extensions["code"])
+}
@@ -16,6 +16,7 @@ package entgql
 
 import (
 	"embed"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -34,14 +35,16 @@ var (
 	EnumTemplate = parseT("template/enum.tmpl")
 
 	// NodeTemplate implements the Relay Node interface for all types.
+	// It supports both the SQL and the Gremlin storage drivers.
 	NodeTemplate = parseT("template/node.tmpl")
 
 	// PaginationTemplate adds pagination support according to the GraphQL Cursor Connections Spec.
 	// More info can be found in the following link: https://relay.dev/graphql/connections.htm.
+	// It supports both the SQL and the Gremlin storage drivers.
 	PaginationTemplate = parseT("template/pagination.tmpl")
 
 	// TransactionTemplate adds support for ent.Client for opening transactions for the transaction
-	// middleware. See transaction.go for for information.
+	// middleware. See transaction.go for for information. It requires the SQL storage driver.
 	TransactionTemplate = parseT("template/transaction.tmpl")
 
 	// EdgeTemplate adds edge resolution using eager-loading with a query fallback.
@@ -50,6 +53,28 @@ var (
 	// WhereTemplate adds a template for generating <T>WhereInput filters for each schema type.
 	WhereTemplate = parseT("template/where_input.tmpl")
 
+	// QueryTemplate adds a template for generating the resolvers of the root query
+	// fields that were configured using the entgql.QueryField annotation.
+	QueryTemplate = parseT("template/query.tmpl")
+
+	// MutationInputTemplate adds a template for generating the Create<T>Input and Update<T>Input
+	// types of the schema types that were annotated with entgql.MutationInputs.
+	MutationInputTemplate = parseT("template/mutation_input.tmpl")
+
+	// ScalarTemplate adds a template for generating the marshalers of the GraphQL scalars
+	// that are backed by custom Go types (i.e. GoType). See entgql.Scalar for more info.
+	ScalarTemplate = parseT("template/scalar.tmpl")
+
+	// ValidatorTemplate adds a template for generating the InputValidators of the input fields
+	// that are used by the entgql.Validator extension. It is not part of AllTemplates, and it
+	// can be enabled using the entgql.WithConstraints option.
+	ValidatorTemplate = parseT("template/validator.tmpl")
+
+	// FixtureTemplate adds a template for generating the "fixture" package, which creates
+	// entities with generated values for tests. It is not part of AllTemplates, and it
+	// can be enabled using the entgql.WithFixtures option.
+	FixtureTemplate = parseT("template/fixture.tmpl")
+
 	// AllTemplates holds all templates for extending ent to support GraphQL.
 	AllTemplates = []*gen.Template{
 		CollectionTemplate,
@@ -58,13 +83,20 @@ var (
 		PaginationTemplate,
 		TransactionTemplate,
 		EdgeTemplate,
+		QueryTemplate,
+		MutationInputTemplate,
+		ScalarTemplate,
 	}
 
 	// TemplateFuncs contains the extra template functions used by entgql.
 	TemplateFuncs = template.FuncMap{
-		"filterNodes":  filterNodes,
-		"filterEdges":  filterEdges,
-		"filterFields": filterFields,
+		"filterNodes":    filterNodes,
+		"filterEdges":    filterEdges,
+		"filterFields":   filterFields,
+		"hasValidators":  hasValidators,
+		"lowerFirst":     lowerFirst,
+		"softDelete":     softDeleteField,
+		"includeDeleted": includeDeletedField,
 	}
 
 	//go:embed template/*
@@ -121,9 +153,14 @@ func filterEdges(edges []*gen.Edge) ([]*gen.Edge, error) {
 	return filteredEdges, nil
 }
 
+// filterFields returns the fields that are exposed in the GraphQL artifacts. Fields
+// that were annotated with entgql.Skip, or marked as Sensitive in ent, are excluded.
 func filterFields(fields []*gen.Field) ([]*gen.Field, error) {
 	var filteredFields []*gen.Field
 	for _, f := range fields {
+		if f.Sensitive() {
+			continue
+		}
 		ant := &Annotation{}
 		if f.Annotations != nil && f.Annotations[ant.Name()] != nil {
 			if err := ant.Decode(f.Annotations[ant.Name()]); err != nil {
@@ -138,11 +175,67 @@ func filterFields(fields []*gen.Field) ([]*gen.Field, error) {
 	return filteredFields, nil
 }
 
+// softDeleteField returns the field of the given type that was annotated with
+// entgql.SoftDelete, or nil if there is no such field.
+func softDeleteField(t *gen.Type) (*gen.Field, error) {
+	var marker *gen.Field
+	for _, f := range t.Fields {
+		ant := &Annotation{}
+		if f.Annotations == nil || f.Annotations[ant.Name()] == nil {
+			continue
+		}
+		if err := ant.Decode(f.Annotations[ant.Name()]); err != nil {
+			return nil, err
+		}
+		switch {
+		case !ant.SoftDelete:
+		case !f.Optional:
+			return nil, fmt.Errorf("entgql: soft-delete field %s.%s must be optional", t.Name, f.Name)
+		case marker != nil:
+			return nil, fmt.Errorf("entgql: type %s has multiple soft-delete fields: %s and %s", t.Name, marker.Name, f.Name)
+		default:
+			marker = f
+		}
+	}
+	return marker, nil
+}
+
+// includeDeletedField returns the soft-delete field of the given type if it was
+// configured with entgql.AllowIncludeDeleted, or nil otherwise.
+func includeDeletedField(t *gen.Type) (*gen.Field, error) {
+	marker, err := softDeleteField(t)
+	if err != nil || marker == nil {
+		return nil, err
+	}
+	ant := &Annotation{}
+	if err := ant.Decode(marker.Annotations[ant.Name()]); err != nil {
+		return nil, err
+	}
+	if !ant.IncludeDeleted {
+		return nil, nil
+	}
+	return marker, nil
+}
+
+// hasValidators reports if the given field has validators that can be
+// enforced on its GraphQL input values (i.e. string and numeric fields).
+func hasValidators(f *gen.Field) bool {
+	return f.Validators > 0 && f.Type != nil && !f.HasGoType() && !f.IsEdgeField() &&
+		(f.IsString() || f.Type.Numeric())
+}
+
 // removeOldAssets removes files that were generated before v0.1.0.
 func removeOldAssets(next gen.Generator) gen.Generator {
 	const prefix = "gql_"
-	templates := []*gen.Template{WhereTemplate}
-	templates = append(templates, AllTemplates...)
+	templates := []*gen.Template{
+		CollectionTemplate,
+		EnumTemplate,
+		NodeTemplate,
+		PaginationTemplate,
+		TransactionTemplate,
+		EdgeTemplate,
+		WhereTemplate,
+	}
 	return gen.GenerateFunc(func(g *gen.Graph) error {
 		for _, rootT := range templates {
 			for _, t := range rootT.Templates() {
@@ -162,6 +255,19 @@ func removeOldAssets(next gen.Generator) gen.Generator {
 	})
 }
 
+// removeTemplateFiles removes the files that were generated by the given template.
+func removeTemplateFiles(g *gen.Graph, rootT *gen.Template) error {
+	for _, t := range rootT.Templates() {
+		if parse.IsEmptyTree(t.Root) || strings.Contains(t.Name(), "/") {
+			continue
+		}
+		if err := removeOldTemplate(g, t.Name()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func removeOldTemplate(g *gen.Graph, name string) error {
 	// Check if name already taken by existing schema field.
 	for _, n := range g.Nodes {
@@ -15,7 +15,12 @@
 package entgql
 
 import (
+	"os"
+	"path/filepath"
+
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema/field"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -132,3 +137,79 @@ func TestFilterFields(t *testing.T) {
 		},
 	}, fields)
 }
+
+func TestFilterSensitiveFields(t *testing.T) {
+	typ, err := gen.NewType(&gen.Config{Package: "entc/gen"}, &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "password", Info: &field.TypeInfo{Type: field.TypeString}, Sensitive: true},
+		},
+	})
+	require.NoError(t, err)
+	fields, err := filterFields(typ.Fields)
+	require.NoError(t, err)
+	require.Len(t, fields, 1)
+	require.Equal(t, "name", fields[0].Name)
+}
+
+func TestSoftDeleteField(t *testing.T) {
+	marker := map[string]interface{}{
+		annotationName: map[string]interface{}{"SoftDelete": true},
+	}
+	typ := &gen.Type{
+		Name: "Todo",
+		Fields: []*gen.Field{
+			{Name: "text"},
+			{Name: "deleted_at", Optional: true, Annotations: marker},
+		},
+	}
+	f, err := softDeleteField(typ)
+	require.NoError(t, err)
+	require.Equal(t, typ.Fields[1], f)
+
+	f, err = softDeleteField(&gen.Type{Name: "User", Fields: typ.Fields[:1]})
+	require.NoError(t, err)
+	require.Nil(t, f)
+
+	typ.Fields = append(typ.Fields, &gen.Field{Name: "archived_at", Optional: true, Annotations: marker})
+	_, err = softDeleteField(typ)
+	require.EqualError(t, err, "entgql: type Todo has multiple soft-delete fields: deleted_at and archived_at")
+
+	typ.Fields = []*gen.Field{{Name: "deleted_at", Annotations: marker}}
+	_, err = softDeleteField(typ)
+	require.EqualError(t, err, "entgql: soft-delete field Todo.deleted_at must be optional")
+}
+
+func TestSkipTemplates(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "gql_query.go"), []byte("package ent"), 0644))
+	g := &gen.Graph{
+		Config: &gen.Config{Target: dir, Templates: AllTemplates},
+		Nodes:  []*gen.Type{{Name: "Todo"}},
+	}
+	var templates []*gen.Template
+	generate := skipTemplates(gen.GenerateFunc(func(g *gen.Graph) error {
+		templates = g.Templates
+		return nil
+	}))
+	require.NoError(t, generate.Generate(g))
+	require.NotContains(t, templates, QueryTemplate)
+	require.Contains(t, templates, NodeTemplate)
+	require.NotContains(t, templates, MutationInputTemplate)
+	require.NotContains(t, templates, ScalarTemplate)
+	require.NoFileExists(t, filepath.Join(dir, "gql_query.go"))
+
+	g.Templates = AllTemplates
+	g.Nodes[0].Annotations = map[string]interface{}{
+		annotationName: map[string]interface{}{"QueryField": "todos", "MutationInputs": true},
+	}
+	require.NoError(t, generate.Generate(g))
+	require.Contains(t, templates, QueryTemplate)
+	require.Contains(t, templates, MutationInputTemplate)
+
+	g.Templates = AllTemplates
+	g.Annotations = gen.Annotations{scalarsAnnotation: []*goScalar{{Name: "Money"}}}
+	require.NoError(t, generate.Generate(g))
+	require.Contains(t, templates, ScalarTemplate)
+}
@@ -0,0 +1,262 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/99designs/gqlgen/graphql"
+	"go.uber.org/zap"
+)
+
+// Tracer starts spans for traced units of work. Implementations are expected to
+// link the new span to the span stored in the given context, if there is one, and
+// return a context that holds the new span. For example, an adapter of OpenTracing
+// or OpenTelemetry, or an in-memory recorder in tests.
+type Tracer interface {
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a traced unit of work.
+type Span interface {
+	// SetTag sets a key-value attribute on the span.
+	SetTag(key string, value interface{})
+	// Finish ends the span and records its error, if there is one.
+	Finish(err error)
+}
+
+// Span names and tags recorded by the Tracing extension.
+const (
+	SpanOperation = "graphql.operation"
+	SpanResolver  = "graphql.resolver"
+	SpanQuery     = "ent.query"
+	SpanExec      = "ent.exec"
+	SpanTx        = "ent.tx"
+
+	TagOperationName = "graphql.operation.name"
+	TagOperationType = "graphql.operation.type"
+	TagFieldPath     = "graphql.field.path"
+	TagFieldObject   = "graphql.field.object"
+	TagStatement     = "db.statement"
+	TagTxOutcome     = "db.tx.outcome"
+)
+
+// Tracing is a gqlgen extension that opens a span for each operation and each
+// field resolver, and logs slow operations. Its Driver method wraps the driver
+// of the ent client, in order to trace and log the queries executed by the
+// resolvers as children of their spans.
+//
+//	tr := &entgql.Tracing{Tracer: tracer, Logger: logger, SlowOperation: time.Second}
+//	client := ent.NewClient(ent.Driver(tr.Driver(drv)))
+//	srv.Use(tr)
+//
+type Tracing struct {
+	// Tracer is used for creating spans. If nil, only logs are written.
+	Tracer Tracer
+	// Logger is used for writing the slow operations and queries logs.
+	Logger *zap.Logger
+	// SlowOperation and SlowQuery are the durations from which operations
+	// and queries are logged as slow. Zero disables their logs.
+	SlowOperation time.Duration
+	SlowQuery     time.Duration
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = (*Tracing)(nil)
+
+// ExtensionName returns the extension name.
+func (*Tracing) ExtensionName() string {
+	return "EntGQLTracing"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (t *Tracing) Validate(graphql.ExecutableSchema) error {
+	if t.Tracer == nil && t.Logger == nil {
+		return errors.New("entgql: tracer and logger are nil")
+	}
+	return nil
+}
+
+// InterceptResponse traces the execution of operations, and logs the slow ones.
+func (t *Tracing) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	var name, typ string
+	if op := graphql.GetOperationContext(ctx).Operation; op != nil {
+		name, typ = op.Name, string(op.Operation)
+	}
+	ctx, span := t.startSpan(ctx, SpanOperation)
+	span.SetTag(TagOperationName, name)
+	span.SetTag(TagOperationType, typ)
+	start := time.Now()
+	rsp := next(ctx)
+	var err error
+	if rsp != nil && len(rsp.Errors) > 0 {
+		err = rsp.Errors
+	}
+	span.Finish(err)
+	if d := time.Since(start); t.Logger != nil && t.SlowOperation > 0 && d >= t.SlowOperation {
+		t.Logger.Warn("slow graphql operation",
+			zap.String("operation", name),
+			zap.String("type", typ),
+			zap.Duration("duration", d),
+			zap.Error(err),
+		)
+	}
+	return rsp
+}
+
+// InterceptField traces the execution of field resolvers.
+func (t *Tracing) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if t.Tracer == nil || fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+	ctx, span := t.Tracer.StartSpan(ctx, SpanResolver)
+	span.SetTag(TagFieldPath, fc.Path().String())
+	span.SetTag(TagFieldObject, fc.Object)
+	res, err := next(ctx)
+	span.Finish(err)
+	return res, err
+}
+
+// Driver returns a driver that traces and logs the queries executed by the given driver.
+func (t *Tracing) Driver(drv dialect.Driver) dialect.Driver {
+	return &tracedDriver{Driver: drv, t: t}
+}
+
+// startSpan starts a new span if a tracer was configured.
+func (t *Tracing) startSpan(ctx context.Context, name string) (context.Context, Span) {
+	if t.Tracer == nil {
+		return ctx, nopSpan{}
+	}
+	return t.Tracer.StartSpan(ctx, name)
+}
+
+// trace traces the execution of a driver operation, and logs it if it is slow.
+func (t *Tracing) trace(ctx context.Context, name, query string, fn func(context.Context) error) error {
+	ctx, span := t.startSpan(ctx, name)
+	span.SetTag(TagStatement, query)
+	start := time.Now()
+	err := fn(ctx)
+	span.Finish(err)
+	if d := time.Since(start); t.Logger != nil && t.SlowQuery > 0 && d >= t.SlowQuery {
+		fields := []zap.Field{zap.String("query", query), zap.Duration("duration", d), zap.Error(err)}
+		if graphql.HasOperationContext(ctx) {
+			if op := graphql.GetOperationContext(ctx).Operation; op != nil {
+				fields = append(fields, zap.String("operation", op.Name))
+			}
+		}
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			fields = append(fields, zap.String("path", fc.Path().String()))
+		}
+		t.Logger.Warn("slow ent query", fields...)
+	}
+	return err
+}
+
+// tracedDriver is a driver that traces all driver operations.
+type tracedDriver struct {
+	dialect.Driver
+	t *Tracing
+}
+
+// Exec traces the underlying driver Exec method.
+func (d *tracedDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return d.t.trace(ctx, SpanExec, query, func(ctx context.Context) error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+// Query traces the underlying driver Query method.
+func (d *tracedDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	return d.t.trace(ctx, SpanQuery, query, func(ctx context.Context) error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+// Tx starts a span that ends when the transaction is committed or rolled back.
+func (d *tracedDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, span := d.t.startSpan(ctx, SpanTx)
+	return &tracedTx{Tx: tx, t: d.t, span: span}, nil
+}
+
+// BeginTx calls the underlying driver BeginTx method if it is supported.
+func (d *tracedDriver) BeginTx(ctx context.Context, opts *stdsql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *stdsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	_, span := d.t.startSpan(ctx, SpanTx)
+	return &tracedTx{Tx: tx, t: d.t, span: span}, nil
+}
+
+// tracedTx is a transaction that traces all transaction operations.
+type tracedTx struct {
+	dialect.Tx
+	t    *Tracing
+	span Span
+}
+
+// Exec traces the underlying transaction Exec method.
+func (tx *tracedTx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return tx.t.trace(ctx, SpanExec, query, func(ctx context.Context) error {
+		return tx.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+// Query traces the underlying transaction Query method.
+func (tx *tracedTx) Query(ctx context.Context, query string, args, v interface{}) error {
+	return tx.t.trace(ctx, SpanQuery, query, func(ctx context.Context) error {
+		return tx.Tx.Query(ctx, query, args, v)
+	})
+}
+
+// Commit calls the underlying transaction Commit method and ends the transaction span.
+func (tx *tracedTx) Commit() error {
+	err := tx.Tx.Commit()
+	tx.span.SetTag(TagTxOutcome, "commit")
+	tx.span.Finish(err)
+	return err
+}
+
+// Rollback calls the underlying transaction Rollback method and ends the transaction span.
+func (tx *tracedTx) Rollback() error {
+	err := tx.Tx.Rollback()
+	tx.span.SetTag(TagTxOutcome, "rollback")
+	tx.span.Finish(err)
+	return err
+}
+
+type nopSpan struct{}
+
+func (nopSpan) SetTag(string, interface{}) {}
+func (nopSpan) Finish(error)              {}
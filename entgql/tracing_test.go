@@ -0,0 +1,124 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestTracing(t *testing.T) {
+	rec := &recorder{}
+	core, logs := observer.New(zap.WarnLevel)
+	tr := &entgql.Tracing{Tracer: rec, Logger: zap.New(core), SlowOperation: 1}
+	srv := testserver.New()
+	srv.AddTransport(transport.POST{})
+	srv.Use(tr)
+
+	var rsp struct{ Name string }
+	err := client.New(srv).Post(`query Name { name }`, &rsp)
+	require.NoError(t, err)
+	require.Len(t, rec.spans, 1)
+	span := rec.spans[0]
+	require.Equal(t, entgql.SpanOperation, span.name)
+	require.True(t, span.finished)
+	require.Equal(t, "Name", span.tags[entgql.TagOperationName])
+	require.Equal(t, "query", span.tags[entgql.TagOperationType])
+	require.Equal(t, 1, logs.FilterMessage("slow graphql operation").Len())
+
+	err = tr.Validate(nil)
+	require.NoError(t, err)
+	err = (&entgql.Tracing{}).Validate(nil)
+	require.EqualError(t, err, "entgql: tracer and logger are nil")
+}
+
+func TestTracingDriver(t *testing.T) {
+	rec := &recorder{}
+	core, logs := observer.New(zap.WarnLevel)
+	drv := (&entgql.Tracing{Tracer: rec, Logger: zap.New(core), SlowQuery: 1}).Driver(&driver{})
+
+	ctx, parent := rec.StartSpan(context.Background(), "parent")
+	err := drv.Query(ctx, "SELECT 1", []interface{}{}, nil)
+	require.NoError(t, err)
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	err = tx.Exec(ctx, "UPDATE", []interface{}{}, nil)
+	require.EqualError(t, err, "exec failed")
+	require.NoError(t, tx.Rollback())
+
+	require.Len(t, rec.spans, 4)
+	for i, name := range []string{"parent", entgql.SpanQuery, entgql.SpanTx, entgql.SpanExec} {
+		require.Equal(t, name, rec.spans[i].name)
+	}
+	for _, span := range rec.spans[1:] {
+		require.Equal(t, parent, span.parent)
+		require.True(t, span.finished)
+	}
+	require.Equal(t, "SELECT 1", rec.spans[1].tags[entgql.TagStatement])
+	require.Equal(t, "rollback", rec.spans[2].tags[entgql.TagTxOutcome])
+	require.EqualError(t, rec.spans[3].err, "exec failed")
+	require.Equal(t, 2, logs.FilterMessage("slow ent query").Len())
+}
+
+// recorder is an in-memory tracer.
+type recorder struct {
+	mu    sync.Mutex
+	spans []*span
+}
+
+type spanKey struct{}
+
+func (r *recorder) StartSpan(ctx context.Context, name string) (context.Context, entgql.Span) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s := &span{name: name, tags: make(map[string]interface{})}
+	s.parent, _ = ctx.Value(spanKey{}).(*span)
+	r.spans = append(r.spans, s)
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+type span struct {
+	name     string
+	parent   *span
+	tags     map[string]interface{}
+	err      error
+	finished bool
+}
+
+func (s *span) SetTag(key string, value interface{}) { s.tags[key] = value }
+
+func (s *span) Finish(err error) { s.err, s.finished = err, true }
+
+// driver is a no-op driver that fails on Exec.
+type driver struct{ dialect.Driver }
+
+func (*driver) Query(context.Context, string, interface{}, interface{}) error { return nil }
+
+func (*driver) Exec(context.Context, string, interface{}, interface{}) error {
+	return errors.New("exec failed")
+}
+
+func (d *driver) Tx(context.Context) (dialect.Tx, error) { return dialect.NopTx(d), nil }
@@ -0,0 +1,220 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ConstraintDirective is the name of the GraphQL directive that declares
+// the constraints of input fields and arguments. When the WithConstraints option is
+// used, the extension adds it to the generated input fields of the fields that have
+// builtin validators (e.g. MaxLen or Range), or the entgql.Constraint annotation:
+//
+//	directive @constraint(minLength: Int, maxLength: Int, min: Float, max: Float, pattern: String) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+//
+// The directive is enforced by the Validator extension, and therefore,
+// it should be skipped by gqlgen at runtime:
+//
+//	directives:
+//	  constraint:
+//	    skip_runtime: true
+//
+const ConstraintDirective = "constraint"
+
+// ErrBadUserInput is the error code of argument validation errors.
+const ErrBadUserInput = "BAD_USER_INPUT"
+
+// ValidateFunc validates a raw GraphQL input value.
+type ValidateFunc func(interface{}) error
+
+// Validator is a gqlgen extension for validating field arguments before their
+// resolvers are executed. Arguments are validated by the @constraint directives
+// defined on them (or on their input fields), and by the validation functions
+// registered for input fields (e.g. the ent validators).
+//
+//	srv.Use(&entgql.Validator{Funcs: ent.InputValidators})
+//
+type Validator struct {
+	// Funcs holds the validation functions of input fields, keyed by the input
+	// type name and the field name. In case of list values, the functions are
+	// called for each element in the list.
+	Funcs  map[string]map[string]ValidateFunc
+	schema *ast.Schema
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = (*Validator)(nil)
+
+// ExtensionName returns the extension name.
+func (*Validator) ExtensionName() string {
+	return "EntGQLValidator"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (v *Validator) Validate(s graphql.ExecutableSchema) error {
+	if v.schema = s.Schema(); v.schema == nil {
+		return errors.New("entgql: executable schema is nil")
+	}
+	return nil
+}
+
+// InterceptField validates the field arguments before calling its resolver.
+func (v *Validator) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Field.Field == nil || fc.Field.Definition == nil || len(fc.Field.Arguments) == 0 {
+		return next(ctx)
+	}
+	var (
+		errs []*gqlerror.Error
+		args = fc.Field.ArgumentMap(graphql.GetOperationContext(ctx).Variables)
+	)
+	for _, def := range fc.Field.Definition.Arguments {
+		for _, err := range v.validate(args[def.Name], def.Type, def.Directives, nil, def.Name) {
+			gqlerr := gqlerror.ErrorPathf(fc.Path(), "invalid value for argument %q: %s", err.arg, err.err)
+			gqlerr.Extensions = map[string]interface{}{"argument": err.arg}
+			errcode.Set(gqlerr, ErrBadUserInput)
+			errs = append(errs, gqlerr)
+		}
+	}
+	if len(errs) == 0 {
+		return next(ctx)
+	}
+	for _, err := range errs[:len(errs)-1] {
+		graphql.AddError(ctx, err)
+	}
+	return nil, errs[len(errs)-1]
+}
+
+// argError is a validation error of a specific argument path.
+type argError struct {
+	arg string
+	err error
+}
+
+// validate validates the given raw value against its type, directives and validation
+// function. Input objects are validated recursively, and lists are validated element
+// by element.
+func (v *Validator) validate(value interface{}, typ *ast.Type, dirs ast.DirectiveList, fn ValidateFunc, path string) []*argError {
+	if value == nil || typ == nil {
+		return nil
+	}
+	if typ.Elem != nil {
+		list, ok := value.([]interface{})
+		if !ok {
+			// Input coercion accepts a single value for list types.
+			return v.validate(value, typ.Elem, dirs, fn, path)
+		}
+		var errs []*argError
+		for i := range list {
+			errs = append(errs, v.validate(list[i], typ.Elem, dirs, fn, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+		return errs
+	}
+	var errs []*argError
+	if d := dirs.ForName(ConstraintDirective); d != nil {
+		if err := checkConstraint(d, value); err != nil {
+			errs = append(errs, &argError{arg: path, err: err})
+		}
+	}
+	// The validation function is skipped if the value violates the directive,
+	// as both usually declare the same constraints.
+	if fn != nil && len(errs) == 0 {
+		if err := fn(value); err != nil {
+			errs = append(errs, &argError{arg: path, err: err})
+		}
+	}
+	def := v.schema.Types[typ.NamedType]
+	if def == nil || def.Kind != ast.InputObject {
+		return errs
+	}
+	fields, ok := value.(map[string]interface{})
+	if !ok {
+		return errs
+	}
+	for _, f := range def.Fields {
+		errs = append(errs, v.validate(fields[f.Name], f.Type, f.Directives, v.Funcs[def.Name][f.Name], path+"."+f.Name)...)
+	}
+	return errs
+}
+
+// checkConstraint checks the given value against the arguments of a @constraint directive.
+func checkConstraint(d *ast.Directive, value interface{}) error {
+	for _, arg := range d.Arguments {
+		limit, err := arg.Value.Value(nil)
+		if err != nil {
+			return err
+		}
+		switch arg.Name {
+		case "minLength", "maxLength":
+			s, ok := value.(string)
+			if !ok {
+				continue
+			}
+			n, err := graphql.UnmarshalInt(limit)
+			if err != nil {
+				return err
+			}
+			if arg.Name == "minLength" && len(s) < n {
+				return fmt.Errorf("value is less than the required length %d", n)
+			}
+			if arg.Name == "maxLength" && len(s) > n {
+				return fmt.Errorf("value is greater than the required length %d", n)
+			}
+		case "min", "max":
+			f, err := graphql.UnmarshalFloat(value)
+			if err != nil {
+				continue
+			}
+			n, err := graphql.UnmarshalFloat(limit)
+			if err != nil {
+				return err
+			}
+			if arg.Name == "min" && f < n {
+				return fmt.Errorf("value is less than the minimum %v", n)
+			}
+			if arg.Name == "max" && f > n {
+				return fmt.Errorf("value is greater than the maximum %v", n)
+			}
+		case "pattern":
+			s, ok := value.(string)
+			if !ok {
+				continue
+			}
+			expr, ok := limit.(string)
+			if !ok {
+				return fmt.Errorf("pattern %T must be a string", limit)
+			}
+			re, err := regexp.Compile(expr)
+			if err != nil {
+				return err
+			}
+			if !re.MatchString(s) {
+				return errors.New("value does not match validation")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestValidator(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		directive @constraint(minLength: Int, maxLength: Int, min: Float, max: Float, pattern: String) on INPUT_FIELD_DEFINITION | ARGUMENT_DEFINITION
+
+		input UserInput {
+			name: String @constraint(minLength: 2, maxLength: 4)
+			age: Int @constraint(min: 18, max: 120)
+			tags: [String!] @constraint(pattern: "^[a-z]+$")
+			nickname: String
+			friends: [UserInput!]
+		}
+
+		type Query {
+			user(input: UserInput, limit: Int @constraint(max: 10)): String
+		}
+	`})
+	v := &Validator{
+		schema: schema,
+		Funcs: map[string]map[string]ValidateFunc{
+			"UserInput": {
+				"nickname": func(v interface{}) error {
+					if v == "admin" {
+						return errors.New("reserved nickname")
+					}
+					return nil
+				},
+			},
+		},
+	}
+	field := schema.Query.Fields.ForName("user")
+	validate := func(name string, value interface{}) []*argError {
+		arg := field.Arguments.ForName(name)
+		return v.validate(value, arg.Type, arg.Directives, nil, name)
+	}
+
+	require.Empty(t, validate("input", map[string]interface{}{
+		"name":     "a8m",
+		"age":      json.Number("30"),
+		"tags":     []interface{}{"a", "b"},
+		"nickname": "ariel",
+	}))
+	require.Empty(t, validate("limit", int64(10)))
+	require.Equal(t, []*argError{
+		{arg: "limit", err: errors.New("value is greater than the maximum 10")},
+	}, validate("limit", int64(11)))
+	require.Equal(t, []*argError{
+		{arg: "input.name", err: errors.New("value is greater than the required length 4")},
+		{arg: "input.age", err: errors.New("value is less than the minimum 18")},
+		{arg: "input.tags[1]", err: errors.New("value does not match validation")},
+		{arg: "input.nickname", err: errors.New("reserved nickname")},
+		{arg: "input.friends[0].name", err: errors.New("value is less than the required length 2")},
+	}, validate("input", map[string]interface{}{
+		"name":     "ariel",
+		"age":      int64(10),
+		"tags":     []interface{}{"a", "B"},
+		"nickname": "admin",
+		"friends": []interface{}{
+			map[string]interface{}{"name": "a"},
+		},
+	}))
+}
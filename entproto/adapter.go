@@ -25,8 +25,13 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/builder"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/descriptorpb"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
+	_ "google.golang.org/protobuf/types/known/structpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	_ "google.golang.org/protobuf/types/known/wrapperspb" // needed to load wkt to global proto registry
 )
@@ -39,10 +44,14 @@ const (
 var (
 	ErrSchemaSkipped   = errors.New("entproto: schema not annotated with Generate=true")
 	repeatedFieldLabel = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	optionalFieldLabel = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	proto3Optional     = true
 	wktsPaths          = map[string]string{
 		// TODO: handle more Well-Known proto types
 		"google.protobuf.Timestamp":   "google/protobuf/timestamp.proto",
+		"google.protobuf.Duration":    "google/protobuf/duration.proto",
 		"google.protobuf.Empty":       "google/protobuf/empty.proto",
+		"google.protobuf.FieldMask":   "google/protobuf/field_mask.proto",
 		"google.protobuf.Int32Value":  "google/protobuf/wrappers.proto",
 		"google.protobuf.Int64Value":  "google/protobuf/wrappers.proto",
 		"google.protobuf.UInt32Value": "google/protobuf/wrappers.proto",
@@ -52,16 +61,20 @@ var (
 		"google.protobuf.StringValue": "google/protobuf/wrappers.proto",
 		"google.protobuf.BoolValue":   "google/protobuf/wrappers.proto",
 		"google.protobuf.BytesValue":  "google/protobuf/wrappers.proto",
+		"google.protobuf.Struct":      "google/protobuf/struct.proto",
+		"google.protobuf.Value":       "google/protobuf/struct.proto",
+		"google.protobuf.ListValue":   "google/protobuf/struct.proto",
 	}
 )
 
 // LoadAdapter takes a *gen.Graph and parses it into protobuf file descriptors
 func LoadAdapter(graph *gen.Graph) (*Adapter, error) {
 	a := &Adapter{
-		graph:            graph,
-		descriptors:      make(map[string]*desc.FileDescriptor),
-		schemaProtoFiles: make(map[string]string),
-		errors:           make(map[string]error),
+		graph:              graph,
+		descriptors:        make(map[string]*desc.FileDescriptor),
+		schemaProtoFiles:   make(map[string]string),
+		schemaServiceFiles: make(map[string]string),
+		errors:             make(map[string]error),
 	}
 	if err := a.parse(); err != nil {
 		return nil, err
@@ -74,7 +87,9 @@ type Adapter struct {
 	graph            *gen.Graph
 	descriptors      map[string]*desc.FileDescriptor
 	schemaProtoFiles map[string]string
-	errors           map[string]error
+	// schemaServiceFiles holds the proto files of the services of the schemas.
+	schemaServiceFiles map[string]string
+	errors             map[string]error
 }
 
 // AllFileDescriptors returns a file descriptor per proto package for each package that contains
@@ -118,26 +133,15 @@ func (a *Adapter) parse() error {
 			continue
 		}
 
-		if _, ok := protoPackages[protoPkg]; !ok {
-			goPkg := a.goPackageName(protoPkg)
-			protoPackages[protoPkg] = &descriptorpb.FileDescriptorProto{
-				Name:    relFileName(protoPkg),
-				Package: &protoPkg,
-				Syntax:  strptr("proto3"),
-				Options: &descriptorpb.FileOptions{
-					GoPackage: &goPkg,
-				},
-			}
-		}
-		fd := protoPackages[protoPkg]
-		fd.MessageType = append(fd.MessageType, messageDescriptor)
-		a.schemaProtoFiles[genType.Name] = *fd.Name
-
 		depPaths, err := a.extractDepPaths(messageDescriptor)
 		if err != nil {
 			a.errors[genType.Name] = err
 			continue
 		}
+
+		fd := a.protoFile(protoPackages, protoPkg)
+		fd.MessageType = append(fd.MessageType, messageDescriptor)
+		a.schemaProtoFiles[genType.Name] = *fd.Name
 		fd.Dependency = append(fd.Dependency, depPaths...)
 
 		svcAnnotation, err := extractServiceAnnotation(genType)
@@ -148,13 +152,19 @@ func (a *Adapter) parse() error {
 			return err
 		}
 		if svcAnnotation.Generate {
-			svcResources, err := a.createServiceResources(genType)
+			svcFd, msgType := fd, genType.Name
+			if svcAnnotation.ServicePackage != "" && svcAnnotation.ServicePackage != protoPkg {
+				svcFd, msgType = a.protoFile(protoPackages, svcAnnotation.ServicePackage), protoPkg+"."+genType.Name
+				svcFd.Dependency = append(svcFd.Dependency, *fd.Name)
+			}
+			svcResources, err := a.createServiceResources(genType, svcAnnotation, msgType)
 			if err != nil {
 				return err
 			}
-			fd.Service = append(fd.Service, svcResources.svc)
-			fd.MessageType = append(fd.MessageType, svcResources.svcMessages...)
-			fd.Dependency = append(fd.Dependency, "google/protobuf/empty.proto")
+			svcFd.Service = append(svcFd.Service, svcResources.svc)
+			svcFd.MessageType = append(svcFd.MessageType, svcResources.svcMessages...)
+			svcFd.Dependency = append(svcFd.Dependency, svcResources.dependencies...)
+			a.schemaServiceFiles[genType.Name] = *svcFd.Name
 		}
 	}
 
@@ -167,6 +177,15 @@ func (a *Adapter) parse() error {
 		dpbDescriptors = append(dpbDescriptors, typeDesc.AsFileDescriptorProto())
 	}
 
+	// Append the imported proto files that are not generated by entproto to the context.
+	imports, err := importedFiles(protoPackages)
+	if err != nil {
+		return err
+	}
+	for _, fd := range imports {
+		dpbDescriptors = append(dpbDescriptors, fd.AsFileDescriptorProto())
+	}
+
 	for _, fd := range protoPackages {
 		fd.Dependency = dedupe(fd.Dependency)
 		dpbDescriptors = append(dpbDescriptors, fd)
@@ -181,6 +200,9 @@ func (a *Adapter) parse() error {
 	for _, wp := range wktsPaths {
 		delete(descriptors, wp)
 	}
+	for _, fd := range imports {
+		delete(descriptors, fd.GetName())
+	}
 
 	for dp, fd := range descriptors {
 		fbuild, err := builder.FromFile(fd)
@@ -202,6 +224,61 @@ func (a *Adapter) parse() error {
 	return nil
 }
 
+// protoFile returns the file of the given proto package, creating it if it does not exist.
+func (a *Adapter) protoFile(protoPackages map[string]*descriptorpb.FileDescriptorProto, protoPkg string) *descriptorpb.FileDescriptorProto {
+	if _, ok := protoPackages[protoPkg]; !ok {
+		goPkg := a.goPackageName(protoPkg)
+		protoPackages[protoPkg] = &descriptorpb.FileDescriptorProto{
+			Name:    relFileName(protoPkg),
+			Package: &protoPkg,
+			Syntax:  strptr("proto3"),
+			Options: &descriptorpb.FileOptions{
+				GoPackage: &goPkg,
+			},
+		}
+	}
+	return protoPackages[protoPkg]
+}
+
+// importedFiles loads the proto files imported by the generated packages, and their transitive dependencies,
+// from the global proto registry. Well-known types and the generated files themselves are excluded.
+func importedFiles(protoPackages map[string]*descriptorpb.FileDescriptorProto) ([]*desc.FileDescriptor, error) {
+	var (
+		out  []*desc.FileDescriptor
+		seen = make(map[string]bool)
+	)
+	for _, wp := range wktsPaths {
+		seen[wp] = true
+	}
+	for _, fd := range protoPackages {
+		seen[fd.GetName()] = true
+	}
+	var load func(fd *desc.FileDescriptor)
+	load = func(fd *desc.FileDescriptor) {
+		if seen[fd.GetName()] {
+			return
+		}
+		seen[fd.GetName()] = true
+		out = append(out, fd)
+		for _, dep := range fd.GetDependencies() {
+			load(dep)
+		}
+	}
+	for _, fd := range protoPackages {
+		for _, dep := range fd.Dependency {
+			if seen[dep] {
+				continue
+			}
+			typeDesc, err := desc.LoadFileDescriptor(dep)
+			if err != nil {
+				return nil, fmt.Errorf("entproto: failed loading imported file %q: %w", dep, err)
+			}
+			load(typeDesc)
+		}
+	}
+	return out, nil
+}
+
 func (a *Adapter) goPackageName(protoPkgName string) string {
 	// TODO(rotemtam): make this configurable from an annotation
 	entBase := a.graph.Config.Package
@@ -228,6 +305,38 @@ func (a *Adapter) GetFileDescriptor(schemaName string) (*desc.FileDescriptor, er
 	return dsc, nil
 }
 
+// GetServiceDescriptor returns the descriptor of the service generated for `schemaName`.
+func (a *Adapter) GetServiceDescriptor(schemaName string) (*desc.ServiceDescriptor, error) {
+	if err, ok := a.errors[schemaName]; ok {
+		return nil, err
+	}
+	fn, ok := a.schemaServiceFiles[schemaName]
+	if !ok {
+		return nil, fmt.Errorf("entproto: could not find service descriptor for schema %s", schemaName)
+	}
+	fd, ok := a.descriptors[fn]
+	if !ok {
+		return nil, fmt.Errorf("entproto: could not find service descriptor for schema %s", schemaName)
+	}
+	bt, err := extractGenTypeByName(a.graph, schemaName)
+	if err != nil {
+		return nil, err
+	}
+	svcAnnotation, err := extractServiceAnnotation(bt)
+	if err != nil {
+		return nil, err
+	}
+	name := svcAnnotation.ServiceName
+	if name == "" {
+		name = fmt.Sprintf("%sService", schemaName)
+	}
+	sd := fd.FindService(fd.GetPackage() + "." + name)
+	if sd == nil {
+		return nil, fmt.Errorf("entproto: could not find service descriptor for schema %s", schemaName)
+	}
+	return sd, nil
+}
+
 func protoPackageName(genType *gen.Type) (string, error) {
 	msgAnnot, err := extractMessageAnnotation(genType)
 	if err != nil {
@@ -274,6 +383,10 @@ func (a *Adapter) extractDepPaths(m *descriptorpb.DescriptorProto) ([]string, er
 					importPath := relFileName(depPackageName)
 					out = append(out, *importPath)
 				}
+			} else if d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(fieldTypeName)); err == nil {
+				// Messages defined outside of the graph, such as the messages of JSON fields, are resolved
+				// from the Go packages linked into the program running entproto.
+				out = append(out, d.ParentFile().Path())
 			} else {
 				return nil, fmt.Errorf("entproto: failed extracting deps, unknown path for %s", fieldTypeName)
 			}
@@ -333,6 +446,11 @@ func (a *Adapter) toProtoMessageDescriptor(genType *gen.Type) (*descriptorpb.Des
 			}
 			msg.EnumType = append(msg.EnumType, dp)
 		}
+		if msgAnnot.Proto3Optional {
+			if err := setProto3Optional(msg, protoField, f); err != nil {
+				return nil, err
+			}
+		}
 		msg.Field = append(msg.Field, protoField)
 	}
 
@@ -353,6 +471,32 @@ func (a *Adapter) toProtoMessageDescriptor(genType *gen.Type) (*descriptorpb.Des
 	return msg, nil
 }
 
+// setProto3Optional maps the optional scalar field f to a proto3 optional field, instead of a wrapper type.
+// Proto3 optional fields are represented in descriptors as the only member of a synthetic oneof.
+func setProto3Optional(msg *descriptorpb.DescriptorProto, fieldDesc *descriptorpb.FieldDescriptorProto, f *gen.Field) error {
+	if !f.Optional {
+		return nil
+	}
+	fann, err := extractFieldAnnotation(f)
+	if err != nil {
+		return err
+	}
+	cfg, ok := typeMap[f.Type.Type]
+	if !ok || fann.Type != descriptorpb.FieldDescriptorProto_Type(0) || cfg.optionalType == "" ||
+		cfg.pbType == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+		return nil
+	}
+	fieldDesc.Type = &cfg.pbType
+	fieldDesc.TypeName = nil
+	fieldDesc.Label = &optionalFieldLabel
+	fieldDesc.Proto3Optional = &proto3Optional
+	fieldDesc.OneofIndex = int32ptr(int32(len(msg.OneofDecl)))
+	msg.OneofDecl = append(msg.OneofDecl, &descriptorpb.OneofDescriptorProto{
+		Name: strptr("_" + f.Name),
+	})
+	return nil
+}
+
 func verifyNoDuplicateFieldNumbers(msg *descriptorpb.DescriptorProto) error {
 	mem := make(map[int32]struct{})
 	for _, fld := range msg.Field {
@@ -474,6 +618,9 @@ func toProtoFieldDescriptor(f *gen.Field) (*descriptorpb.FieldDescriptorProto, e
 	if typeDetails.messageName != "" {
 		fieldDesc.TypeName = &typeDetails.messageName
 	}
+	if typeDetails.repeated {
+		fieldDesc.Label = &repeatedFieldLabel
+	}
 
 	return fieldDesc, nil
 }
@@ -483,6 +630,9 @@ func extractProtoTypeDetails(f *gen.Field) (fieldType, error) {
 	if !ok || cfg.unsupported {
 		return fieldType{}, unsupportedTypeError{Type: f.Type}
 	}
+	if f.IsJSON() {
+		return extractJSONTypeDetails(f), nil
+	}
 	if f.Optional {
 		if cfg.optionalType == "" {
 			return fieldType{}, unsupportedTypeError{Type: f.Type}
@@ -505,6 +655,7 @@ func extractProtoTypeDetails(f *gen.Field) (fieldType, error) {
 type fieldType struct {
 	messageName string
 	protoType   descriptorpb.FieldDescriptorProto_Type
+	repeated    bool
 }
 
 func strptr(s string) *string {
@@ -48,9 +48,28 @@ type converter struct {
 	ToProtoConstructor           protogen.GoIdent
 	toProtoMarshallerConstructor protogen.GoIdent
 	ToProtoValuer                string
+	ToEntElemConversion          string
+	ToProtoElemConversion        string
+	ToEntJSONType                string
+	ToProtoJSONMessage           protogen.GoIdent
+	ToEntFunc                    protogen.GoIdent
+	ToEntFuncErr                 bool
+	ToProtoFunc                  protogen.GoIdent
+	ToProtoFuncErr               bool
 }
 
 func (g *serviceGenerator) newConverter(fld *entproto.FieldMappingDescriptor) (*converter, error) {
+	if fc := fld.Converter; fc != nil {
+		return &converter{
+			ToEntFunc:      protogen.GoImportPath(fc.ToEnt.PkgPath).Ident(fc.ToEnt.Name),
+			ToEntFuncErr:   fc.ToEnt.Err,
+			ToProtoFunc:    protogen.GoImportPath(fc.ToProto.PkgPath).Ident(fc.ToProto.Name),
+			ToProtoFuncErr: fc.ToProto.Err,
+		}, nil
+	}
+	if !fld.IsEdgeField && fld.EntField.IsJSON() {
+		return g.jsonConverter(fld)
+	}
 	out := &converter{}
 	pbd := fld.PbFieldDescriptor
 	switch pbd.GetType() {
@@ -117,6 +136,83 @@ func (g *serviceGenerator) newConverter(fld *entproto.FieldMappingDescriptor) (*
 	return out, nil
 }
 
+// jsonConverter returns the converter of a JSON field. Repeated scalars are copied element by element, and
+// message fields are converted through the JSON encoding of the ent field value.
+func (g *serviceGenerator) jsonConverter(fld *entproto.FieldMappingDescriptor) (*converter, error) {
+	out := &converter{}
+	pbd := fld.PbFieldDescriptor
+	switch {
+	case pbd.IsRepeated() && pbd.GetType() != dpb.FieldDescriptorProto_TYPE_MESSAGE:
+		goType, ok := scalarGoTypes[pbd.GetType()]
+		if !ok {
+			return nil, fmt.Errorf("entproto: no mapping for repeated pb field type %q", pbd.GetType())
+		}
+		if elem := strings.TrimPrefix(fld.EntField.Type.Ident, "[]"); elem != goType {
+			out.ToEntElemConversion = elem
+			out.ToProtoElemConversion = goType
+		}
+	case pbd.GetType() == dpb.FieldDescriptorProto_TYPE_MESSAGE:
+		ident, err := g.pbMessageIdent(pbd)
+		if err != nil {
+			return nil, err
+		}
+		out.ToProtoJSONMessage = ident
+		out.ToEntJSONType = g.entGoType(fld.EntField.Type)
+	default:
+		return nil, fmt.Errorf("entproto: no mapping from pb field type %q to ent field type %q",
+			pbd.GetType(), fld.EntField.Type.ConstName())
+	}
+	return out, nil
+}
+
+// pbMessageIdent returns the Go identifier of the message type of the given message field.
+func (g *serviceGenerator) pbMessageIdent(pbd *desc.FieldDescriptor) (protogen.GoIdent, error) {
+	owner := pbd.GetOwner().GetFullyQualifiedName()
+	for _, m := range g.PbFile.Messages {
+		if string(m.Desc.FullName()) != owner {
+			continue
+		}
+		for _, f := range m.Fields {
+			if string(f.Desc.Name()) == pbd.GetName() && f.Message != nil {
+				return f.Message.GoIdent, nil
+			}
+		}
+	}
+	return protogen.GoIdent{}, fmt.Errorf("entproto: message type of field %q not found", pbd.GetFullyQualifiedName())
+}
+
+// entGoType returns the Go type of an ent field, with its package qualified in the generated file.
+func (g *serviceGenerator) entGoType(t *field.TypeInfo) string {
+	if t.PkgPath == "" {
+		return t.Ident
+	}
+	name := t.Ident
+	for stripped := true; stripped; {
+		stripped = false
+		for _, prefix := range []string{"[]", "*", "map[string]"} {
+			if strings.HasPrefix(name, prefix) {
+				name, stripped = strings.TrimPrefix(name, prefix), true
+			}
+		}
+	}
+	prefix := strings.TrimSuffix(t.Ident, name)
+	if i := strings.LastIndexByte(name, '.'); i != -1 {
+		name = name[i+1:]
+	}
+	return prefix + g.QualifiedGoIdent(protogen.GoImportPath(t.PkgPath).Ident(name))
+}
+
+var scalarGoTypes = map[dpb.FieldDescriptorProto_Type]string{
+	dpb.FieldDescriptorProto_TYPE_BOOL:   "bool",
+	dpb.FieldDescriptorProto_TYPE_STRING: "string",
+	dpb.FieldDescriptorProto_TYPE_INT32:  "int32",
+	dpb.FieldDescriptorProto_TYPE_INT64:  "int64",
+	dpb.FieldDescriptorProto_TYPE_UINT32: "uint32",
+	dpb.FieldDescriptorProto_TYPE_UINT64: "uint64",
+	dpb.FieldDescriptorProto_TYPE_FLOAT:  "float32",
+	dpb.FieldDescriptorProto_TYPE_DOUBLE: "float64",
+}
+
 // Supported value scanner types (https://golang.org/pkg/database/sql/driver/#Value): [int64, float64, bool, []byte, string, time.Time]
 func basicTypeConversion(md *desc.FieldDescriptor, entField *gen.Field, conv *converter) error {
 	switch md.GetType() {
@@ -0,0 +1,357 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// httpRoute is a route of a method, defined by its google.api.http rule or one of its additional bindings.
+type httpRoute struct {
+	Method     *protogen.Method
+	HTTPMethod string
+	Path       string
+	Body       string
+}
+
+// pathVar matches the variables of the path template of a route.
+var pathVar = regexp.MustCompile(`{([^}=]+)}`)
+
+// HTTPRoutes returns the routes of the methods of the service that have google.api.http rules.
+func (g *serviceGenerator) HTTPRoutes() ([]*httpRoute, error) {
+	var routes []*httpRoute
+	for _, m := range g.Service.Methods {
+		opts, ok := m.Desc.Options().(*descriptorpb.MethodOptions)
+		if !ok || !proto.HasExtension(opts, annotations.E_Http) {
+			continue
+		}
+		rule := proto.GetExtension(opts, annotations.E_Http).(*annotations.HttpRule)
+		for _, r := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+			route, err := newHTTPRoute(m, r)
+			if err != nil {
+				return nil, err
+			}
+			routes = append(routes, route)
+		}
+	}
+	return routes, nil
+}
+
+func newHTTPRoute(m *protogen.Method, rule *annotations.HttpRule) (*httpRoute, error) {
+	route := &httpRoute{Method: m, Body: rule.GetBody()}
+	switch p := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		route.HTTPMethod, route.Path = http.MethodGet, p.Get
+	case *annotations.HttpRule_Put:
+		route.HTTPMethod, route.Path = http.MethodPut, p.Put
+	case *annotations.HttpRule_Post:
+		route.HTTPMethod, route.Path = http.MethodPost, p.Post
+	case *annotations.HttpRule_Delete:
+		route.HTTPMethod, route.Path = http.MethodDelete, p.Delete
+	case *annotations.HttpRule_Patch:
+		route.HTTPMethod, route.Path = http.MethodPatch, p.Patch
+	case *annotations.HttpRule_Custom:
+		route.HTTPMethod, route.Path = p.Custom.GetKind(), p.Custom.GetPath()
+	default:
+		return nil, fmt.Errorf("entproto: google.api.http rule of method %q has no pattern", m.Desc.FullName())
+	}
+	return route, nil
+}
+
+// PathVars returns the field paths of the variables of the path template of the route.
+func (r *httpRoute) PathVars() []string {
+	var vars []string
+	for _, match := range pathVar.FindAllStringSubmatch(r.Path, -1) {
+		vars = append(vars, match[1])
+	}
+	return vars
+}
+
+// generateOpenAPI generates an OpenAPI 3 document describing the routes of the services of the file, if
+// any of their methods has a google.api.http rule.
+func generateOpenAPI(plugin *protogen.Plugin, file *protogen.File, generators []*serviceGenerator) error {
+	doc := &openAPI{
+		paths:   make(map[string]map[string]interface{}),
+		schemas: make(map[string]interface{}),
+	}
+	for _, sg := range generators {
+		routes, err := sg.HTTPRoutes()
+		if err != nil {
+			return err
+		}
+		for _, r := range routes {
+			if err := doc.addRoute(sg.Service, r); err != nil {
+				return err
+			}
+		}
+	}
+	if len(doc.paths) == 0 {
+		return nil
+	}
+	doc.schemas["google.rpc.Status"] = map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"code":    map[string]interface{}{"type": "integer", "format": "int32"},
+			"message": map[string]interface{}{"type": "string"},
+			"details": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "object"}},
+		},
+	}
+	b, err := json.MarshalIndent(map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":   string(file.Desc.Package()),
+			"version": "1.0",
+		},
+		"paths": doc.paths,
+		"components": map[string]interface{}{
+			"schemas": doc.schemas,
+		},
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	g := plugin.NewGeneratedFile(file.GeneratedFilenamePrefix+".openapi.json", "")
+	_, err = g.Write(append(b, '\n'))
+	return err
+}
+
+// openAPI holds the paths and component schemas of an OpenAPI document.
+type openAPI struct {
+	paths   map[string]map[string]interface{}
+	schemas map[string]interface{}
+}
+
+// addRoute adds the operation of the route to the document.
+func (doc *openAPI) addRoute(svc *protogen.Service, r *httpRoute) error {
+	input := r.Method.Input.Desc
+	var params []interface{}
+	// Fields set by the path or the body are not query parameters.
+	exclude := make(map[string]bool)
+	for _, v := range r.PathVars() {
+		fd, err := fieldByPath(input, v)
+		if err != nil {
+			return err
+		}
+		exclude[v] = true
+		params = append(params, map[string]interface{}{
+			"name":     v,
+			"in":       "path",
+			"required": true,
+			"schema":   doc.fieldSchema(fd),
+		})
+	}
+	op := map[string]interface{}{
+		"operationId": fmt.Sprintf("%s_%s", svc.GoName, r.Method.GoName),
+		"tags":        []string{svc.GoName},
+		"responses": map[string]interface{}{
+			"200": map[string]interface{}{
+				"description": "OK",
+				"content":     jsonContent(doc.messageSchema(r.Method.Output.Desc)),
+			},
+			"default": map[string]interface{}{
+				"description": "Error",
+				"content":     jsonContent(map[string]interface{}{"$ref": "#/components/schemas/google.rpc.Status"}),
+			},
+		},
+	}
+	switch r.Body {
+	case "":
+		params = append(params, doc.queryParams(input, "", exclude, make(map[protoreflect.FullName]bool))...)
+	case "*":
+		op["requestBody"] = map[string]interface{}{"content": jsonContent(doc.messageSchema(input))}
+	default:
+		fd := input.Fields().ByName(protoreflect.Name(r.Body))
+		if fd == nil {
+			return fmt.Errorf("entproto: unknown body field %q of method %q", r.Body, r.Method.Desc.FullName())
+		}
+		op["requestBody"] = map[string]interface{}{"content": jsonContent(doc.fieldSchema(fd))}
+		exclude[r.Body] = true
+		params = append(params, doc.queryParams(input, "", exclude, make(map[protoreflect.FullName]bool))...)
+	}
+	if len(params) > 0 {
+		op["parameters"] = params
+	}
+	if doc.paths[r.Path] == nil {
+		doc.paths[r.Path] = make(map[string]interface{})
+	}
+	doc.paths[r.Path][strings.ToLower(r.HTTPMethod)] = op
+	return nil
+}
+
+// queryParams returns the query parameters of the fields of the message that are not excluded. Nested
+// messages are flattened using dot-separated field paths, and seen holds the messages being flattened.
+func (doc *openAPI) queryParams(md protoreflect.MessageDescriptor, prefix string, exclude map[string]bool, seen map[protoreflect.FullName]bool) []interface{} {
+	var params []interface{}
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		name := prefix + string(fd.Name())
+		if exclude[name] || fd.IsMap() {
+			continue
+		}
+		if fd.Message() != nil && wktSchema(fd.Message()) == nil {
+			if fd.IsList() || seen[fd.Message().FullName()] {
+				continue
+			}
+			seen[fd.Message().FullName()] = true
+			params = append(params, doc.queryParams(fd.Message(), name+".", exclude, seen)...)
+			delete(seen, fd.Message().FullName())
+			continue
+		}
+		params = append(params, map[string]interface{}{
+			"name":   name,
+			"in":     "query",
+			"schema": doc.fieldSchema(fd),
+		})
+	}
+	return params
+}
+
+// fieldSchema returns the schema of the JSON encoding of the field.
+func (doc *openAPI) fieldSchema(fd protoreflect.FieldDescriptor) map[string]interface{} {
+	switch {
+	case fd.IsMap():
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": doc.valueSchema(fd.MapValue()),
+		}
+	case fd.IsList():
+		return map[string]interface{}{
+			"type":  "array",
+			"items": doc.valueSchema(fd),
+		}
+	default:
+		return doc.valueSchema(fd)
+	}
+}
+
+// valueSchema returns the schema of the JSON encoding of a single value of the field.
+func (doc *openAPI) valueSchema(fd protoreflect.FieldDescriptor) map[string]interface{} {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]interface{}{"type": "boolean"}
+	case protoreflect.EnumKind:
+		var values []string
+		for i := 0; i < fd.Enum().Values().Len(); i++ {
+			values = append(values, string(fd.Enum().Values().Get(i).Name()))
+		}
+		return map[string]interface{}{"type": "string", "enum": values}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// 64-bit integers are encoded as JSON strings.
+		return map[string]interface{}{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind:
+		return map[string]interface{}{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case protoreflect.BytesKind:
+		return map[string]interface{}{"type": "string", "format": "byte"}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return doc.messageSchema(fd.Message())
+	default:
+		return map[string]interface{}{"type": "string"}
+	}
+}
+
+// messageSchema returns the schema of the JSON encoding of the message. Messages that are not well-known
+// types are referenced from the component schemas of the document.
+func (doc *openAPI) messageSchema(md protoreflect.MessageDescriptor) map[string]interface{} {
+	if s := wktSchema(md); s != nil {
+		return s
+	}
+	name := string(md.FullName())
+	ref := map[string]interface{}{"$ref": "#/components/schemas/" + name}
+	if _, ok := doc.schemas[name]; ok {
+		return ref
+	}
+	// Add the schema before its fields, to stop the recursion of self-referencing messages.
+	props := make(map[string]interface{})
+	doc.schemas[name] = map[string]interface{}{
+		"type":       "object",
+		"properties": props,
+	}
+	for i := 0; i < md.Fields().Len(); i++ {
+		fd := md.Fields().Get(i)
+		props[fd.JSONName()] = doc.fieldSchema(fd)
+	}
+	return ref
+}
+
+// wktSchema returns the schema of the JSON encoding of a well-known type, or nil if md is not one.
+func wktSchema(md protoreflect.MessageDescriptor) map[string]interface{} {
+	switch md.FullName() {
+	case "google.protobuf.Timestamp":
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case "google.protobuf.Duration", "google.protobuf.FieldMask", "google.protobuf.StringValue":
+		return map[string]interface{}{"type": "string"}
+	case "google.protobuf.Empty", "google.protobuf.Struct", "google.protobuf.Any":
+		return map[string]interface{}{"type": "object"}
+	case "google.protobuf.Value":
+		return map[string]interface{}{}
+	case "google.protobuf.ListValue":
+		return map[string]interface{}{"type": "array", "items": map[string]interface{}{}}
+	case "google.protobuf.BoolValue":
+		return map[string]interface{}{"type": "boolean"}
+	case "google.protobuf.Int32Value":
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case "google.protobuf.UInt32Value":
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case "google.protobuf.Int64Value", "google.protobuf.UInt64Value":
+		return map[string]interface{}{"type": "string", "format": "int64"}
+	case "google.protobuf.FloatValue":
+		return map[string]interface{}{"type": "number", "format": "float"}
+	case "google.protobuf.DoubleValue":
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case "google.protobuf.BytesValue":
+		return map[string]interface{}{"type": "string", "format": "byte"}
+	default:
+		return nil
+	}
+}
+
+// jsonContent returns the content of a JSON request or response body with the given schema.
+func jsonContent(schema map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"application/json": map[string]interface{}{"schema": schema},
+	}
+}
+
+// fieldByPath returns the field of the message at the dot-separated path of field names.
+func fieldByPath(md protoreflect.MessageDescriptor, path string) (protoreflect.FieldDescriptor, error) {
+	var fd protoreflect.FieldDescriptor
+	for _, name := range strings.Split(path, ".") {
+		if md == nil {
+			return nil, fmt.Errorf("entproto: field path %q is not a message field", path)
+		}
+		if fd = md.Fields().ByName(protoreflect.Name(name)); fd == nil {
+			return nil, fmt.Errorf("entproto: unknown field path %q of message %q", path, md.FullName())
+		}
+		md = fd.Message()
+	}
+	return fd, nil
+}
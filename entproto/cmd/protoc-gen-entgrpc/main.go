@@ -27,6 +27,8 @@ import (
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/pluginpb"
 )
 
 var (
@@ -42,10 +44,14 @@ func main() {
 	protogen.Options{
 		ParamFunc: flags.Set,
 	}.Run(func(plg *protogen.Plugin) error {
+		plg.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 		g, err := entc.LoadGraph(*entSchemaPath, &gen.Config{})
 		if err != nil {
 			return err
 		}
+		if err := registerFiles(plg); err != nil {
+			return err
+		}
 		for _, f := range plg.Files {
 			if !f.Generate {
 				continue
@@ -58,21 +64,69 @@ func main() {
 	})
 }
 
+// registerFiles registers the proto files of the request in the global registry, so that the messages
+// of JSON fields that are defined outside of the ent graph can be resolved by the entproto adapter.
+func registerFiles(plg *protogen.Plugin) error {
+	for _, f := range plg.Files {
+		if _, err := protoregistry.GlobalFiles.FindFileByPath(f.Desc.Path()); err == nil {
+			continue
+		}
+		if err := protoregistry.GlobalFiles.RegisterFile(f.Desc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // processFile generates service implementations from all services defined in the file.
 func processFile(gen *protogen.Plugin, file *protogen.File, graph *gen.Graph) error {
 	if len(file.Services) == 0 {
 		return nil
 	}
+	generators := make([]*serviceGenerator, 0, len(file.Services))
+	converted := make(map[string]bool)
 	for _, s := range file.Services {
 		sg, err := newServiceGenerator(gen, file, graph, s)
 		if err != nil {
 			return err
 		}
+		generators = append(generators, sg)
+		converted[sg.EntType.Name] = true
+	}
+	// The WITH_EDGES view converts the edges of an entity using the toProto functions of their types.
+	// Types without a service in the file get their functions generated alongside the first service
+	// with an edge to them.
+	for _, sg := range generators {
+		for _, e := range sg.FieldMap.Edges() {
+			typ := e.EntEdge.Type
+			if converted[typ.Name] {
+				continue
+			}
+			fieldMap, err := sg.adapter.FieldMap(typ.Name)
+			if err != nil {
+				return err
+			}
+			pbFile, err := messageFile(gen, sg.adapter, typ)
+			if err != nil {
+				return err
+			}
+			sg.EdgeConverters = append(sg.EdgeConverters, &serviceGenerator{
+				GeneratedFile: sg.GeneratedFile,
+				EntPackage:    sg.EntPackage,
+				File:          sg.File,
+				PbFile:        pbFile,
+				EntType:       typ,
+				FieldMap:      fieldMap,
+			})
+			converted[typ.Name] = true
+		}
+	}
+	for _, sg := range generators {
 		if err := sg.generate(); err != nil {
 			return err
 		}
 	}
-	return nil
+	return generateOpenAPI(gen, file, generators)
 }
 
 func newServiceGenerator(plugin *protogen.Plugin, file *protogen.File, graph *gen.Graph, service *protogen.Service) (*serviceGenerator, error) {
@@ -80,7 +134,7 @@ func newServiceGenerator(plugin *protogen.Plugin, file *protogen.File, graph *ge
 	if err != nil {
 		return nil, err
 	}
-	typ, err := extractEntTypeName(service, graph)
+	typ, err := extractEntTypeName(service, graph, adapter)
 	if err != nil {
 		return nil, err
 	}
@@ -90,17 +144,42 @@ func newServiceGenerator(plugin *protogen.Plugin, file *protogen.File, graph *ge
 	if err != nil {
 		return nil, err
 	}
-	return &serviceGenerator{
+	pbFile, err := messageFile(plugin, adapter, typ)
+	if err != nil {
+		return nil, err
+	}
+	sg := &serviceGenerator{
+		adapter:       adapter,
 		GeneratedFile: g,
 		EntPackage:    protogen.GoImportPath(graph.Config.Package),
 		File:          file,
+		PbFile:        pbFile,
 		Service:       service,
 		EntType:       typ,
 		FieldMap:      fieldMap,
-	}, nil
+	}
+	if sg.HasMethod("List") {
+		if sg.FilterMap, err = adapter.FilterFieldMap(typ.Name); err != nil {
+			return nil, err
+		}
+	}
+	return sg, nil
 }
 
 func (g *serviceGenerator) generate() error {
+	if err := g.execute("service"); err != nil {
+		return err
+	}
+	for _, c := range g.EdgeConverters {
+		if err := c.execute("edge_converter"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// execute executes the named template on the generator.
+func (g *serviceGenerator) execute(name string) error {
 	tmpl, err := gen.NewTemplate("service").
 		Funcs(template.FuncMap{
 			"ident":        g.QualifiedGoIdent,
@@ -136,7 +215,7 @@ func (g *serviceGenerator) generate() error {
 	if err != nil {
 		return err
 	}
-	if err := tmpl.ExecuteTemplate(g, "service", g); err != nil {
+	if err := tmpl.ExecuteTemplate(g, name, g); err != nil {
 		return fmt.Errorf("template execution failed: %w", err)
 	}
 	return nil
@@ -147,9 +226,15 @@ type (
 		*protogen.GeneratedFile
 		EntPackage protogen.GoImportPath
 		File       *protogen.File
-		Service    *protogen.Service
-		EntType    *gen.Type
-		FieldMap   entproto.FieldMap
+		// PbFile is the file of the message of EntType, which may differ from the file of the service.
+		PbFile    *protogen.File
+		Service   *protogen.Service
+		EntType   *gen.Type
+		FieldMap  entproto.FieldMap
+		FilterMap entproto.FieldMap
+		// EdgeConverters generate the toProto functions of the edge types that have no service in the file.
+		EdgeConverters []*serviceGenerator
+		adapter        *entproto.Adapter
 	}
 	methodInput struct {
 		G      *serviceGenerator
@@ -160,7 +245,15 @@ type (
 //go:embed template/*
 var templates embed.FS
 
-func extractEntTypeName(s *protogen.Service, g *gen.Graph) (*gen.Type, error) {
+// extractEntTypeName returns the ent type of the service. Services that were not generated by entproto are
+// matched to the type named by their name without the "Service" suffix.
+func extractEntTypeName(s *protogen.Service, g *gen.Graph, adapter *entproto.Adapter) (*gen.Type, error) {
+	for _, gt := range g.Nodes {
+		sd, err := adapter.GetServiceDescriptor(gt.Name)
+		if err == nil && sd.GetFullyQualifiedName() == string(s.Desc.FullName()) {
+			return gt, nil
+		}
+	}
 	typeName := strings.TrimSuffix(s.GoName, "Service")
 	for _, gt := range g.Nodes {
 		if gt.Name == typeName {
@@ -170,6 +263,32 @@ func extractEntTypeName(s *protogen.Service, g *gen.Graph) (*gen.Type, error) {
 	return nil, fmt.Errorf("entproto: type %q of service %q not found in graph", typeName, s.GoName)
 }
 
+// messageFile returns the file defining the message of the ent type.
+func messageFile(plugin *protogen.Plugin, adapter *entproto.Adapter, typ *gen.Type) (*protogen.File, error) {
+	md, err := adapter.GetMessageDescriptor(typ.Name)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range plugin.Files {
+		for _, m := range f.Messages {
+			if string(m.Desc.FullName()) == md.GetFullyQualifiedName() {
+				return f, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("entproto: message %q of type %q not found", md.GetFullyQualifiedName(), typ.Name)
+}
+
+// HasMethod reports if the service has a method with the given name.
+func (g *serviceGenerator) HasMethod(name string) bool {
+	for _, m := range g.Service.Methods {
+		if m.GoName == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (g *serviceGenerator) entIdent(subpath string, ident string) protogen.GoIdent {
 	ip := path.Join(string(g.EntPackage), subpath)
 	return protogen.GoImportPath(ip).Ident(ident)
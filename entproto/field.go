@@ -16,10 +16,16 @@ package entproto
 
 import (
 	"fmt"
+	"net/url"
+	"reflect"
+	"runtime"
+	"strings"
+	"unicode"
 
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema"
 	"github.com/mitchellh/mapstructure"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
@@ -36,9 +42,10 @@ func Field(num int, options ...FieldOption) schema.Annotation {
 }
 
 type pbfield struct {
-	Number   int
-	Type     descriptorpb.FieldDescriptorProto_Type
-	TypeName string
+	Number    int
+	Type      descriptorpb.FieldDescriptorProto_Type
+	TypeName  string
+	Converter *FieldConverter
 }
 
 func (f pbfield) Name() string {
@@ -66,6 +73,123 @@ func TypeName(n string) FieldOption {
 	}
 }
 
+// Converter sets the functions the generated services use to convert the values of the field between
+// its Go type and its proto type. It allows mapping fields of types that are not supported out of the box,
+// such as TypeOther fields and fields with a custom GoType.
+//
+// toProto must be an exported package-level function of the form func(T) P or func(T) (P, error), and toEnt
+// of the form func(P) T or func(P) (T, error), where T is the Go type of the ent field and P is the Go type
+// of the proto field. Unless it is set with the Type option, the proto type is inferred from P, which must
+// be a protobuf scalar Go type (bool, string, []byte, int32, int64, uint32, uint64, float32 or float64)
+// or a generated message type. For example:
+//
+//	field.Int64("timeout").
+//		GoType(time.Duration(0)).
+//		Annotations(
+//			entproto.Field(2,
+//				entproto.Converter(durationpb.New, DurationFromProto),
+//			),
+//		)
+//
+// Converter panics if the functions do not match these requirements.
+func Converter(toProto, toEnt interface{}) FieldOption {
+	toProtoFunc, ptype := mustGoFunc(toProto)
+	toEntFunc, etype := mustGoFunc(toEnt)
+	if ptype.Out(0) != etype.In(0) {
+		panic(fmt.Sprintf("entproto: converter %s returns %s but %s expects %s",
+			toProtoFunc, ptype.Out(0), toEntFunc, etype.In(0)))
+	}
+	pbType, typeName := converterProtoType(ptype.Out(0))
+	return func(p *pbfield) {
+		p.Converter = &FieldConverter{ToProto: toProtoFunc, ToEnt: toEntFunc}
+		if p.Type == descriptorpb.FieldDescriptorProto_Type(0) {
+			p.Type, p.TypeName = pbType, typeName
+		}
+	}
+}
+
+// FieldConverter holds the functions converting the values of a field between its Go type and its proto type.
+type FieldConverter struct {
+	ToProto GoFunc
+	ToEnt   GoFunc
+}
+
+// GoFunc references a package-level Go function of one argument.
+type GoFunc struct {
+	// PkgPath is the import path of the package of the function.
+	PkgPath string
+	// Name is the name of the function.
+	Name string
+	// Err reports if the function returns an error as its second result.
+	Err bool
+}
+
+func (f GoFunc) String() string {
+	return f.PkgPath + "." + f.Name
+}
+
+func mustGoFunc(fn interface{}) (GoFunc, reflect.Type) {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		panic(fmt.Sprintf("entproto: converter %v is not a function", fn))
+	}
+	t := v.Type()
+	errType := reflect.TypeOf((*error)(nil)).Elem()
+	if t.NumIn() != 1 || t.IsVariadic() || t.NumOut() == 0 || t.NumOut() > 2 || (t.NumOut() == 2 && t.Out(1) != errType) {
+		panic(fmt.Sprintf("entproto: converter of type %s must be of the form func(A) B or func(A) (B, error)", t))
+	}
+	// Function names are reported in the form of "<import path>.<name>", with the dots of the last
+	// path element escaped.
+	full := runtime.FuncForPC(v.Pointer()).Name()
+	i := strings.LastIndexByte(full, '/') + 1
+	j := strings.IndexByte(full[i:], '.')
+	if j == -1 {
+		panic(fmt.Sprintf("entproto: unexpected converter name %q", full))
+	}
+	pkg, err := url.PathUnescape(full[:i+j])
+	if err != nil {
+		panic(fmt.Sprintf("entproto: unexpected converter name %q: %v", full, err))
+	}
+	name := full[i+j+1:]
+	if strings.ContainsAny(name, ".()") || !unicode.IsUpper([]rune(name)[0]) {
+		panic(fmt.Sprintf("entproto: converter %q must be an exported package-level function", full))
+	}
+	return GoFunc{PkgPath: pkg, Name: name, Err: t.NumOut() == 2}, t
+}
+
+// converterProtoType returns the proto type of a field whose converter returns values of type t.
+func converterProtoType(t reflect.Type) (descriptorpb.FieldDescriptorProto_Type, string) {
+	if t.Implements(reflect.TypeOf((*proto.Message)(nil)).Elem()) && t.Kind() == reflect.Ptr {
+		msg := reflect.New(t.Elem()).Interface().(proto.Message)
+		return descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, string(msg.ProtoReflect().Descriptor().FullName())
+	}
+	if t.PkgPath() == "" {
+		switch t.Kind() {
+		case reflect.Bool:
+			return descriptorpb.FieldDescriptorProto_TYPE_BOOL, ""
+		case reflect.String:
+			return descriptorpb.FieldDescriptorProto_TYPE_STRING, ""
+		case reflect.Int32:
+			return descriptorpb.FieldDescriptorProto_TYPE_INT32, ""
+		case reflect.Int64:
+			return descriptorpb.FieldDescriptorProto_TYPE_INT64, ""
+		case reflect.Uint32:
+			return descriptorpb.FieldDescriptorProto_TYPE_UINT32, ""
+		case reflect.Uint64:
+			return descriptorpb.FieldDescriptorProto_TYPE_UINT64, ""
+		case reflect.Float32:
+			return descriptorpb.FieldDescriptorProto_TYPE_FLOAT, ""
+		case reflect.Float64:
+			return descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, ""
+		case reflect.Slice:
+			if t.Elem().Kind() == reflect.Uint8 && t.Elem().PkgPath() == "" {
+				return descriptorpb.FieldDescriptorProto_TYPE_BYTES, ""
+			}
+		}
+	}
+	panic(fmt.Sprintf("entproto: converter proto type %s is not a protobuf scalar or message type", t))
+}
+
 func extractFieldAnnotation(fld *gen.Field) (*pbfield, error) {
 	annot, ok := fld.Annotations[FieldAnnotation]
 	if !ok {
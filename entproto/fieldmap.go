@@ -21,6 +21,7 @@ import (
 
 	"entgo.io/ent/entc/gen"
 	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 // FieldMap returns a FieldMap containing descriptors of all of the mappings between the ent schema field
@@ -37,6 +38,25 @@ func (a *Adapter) FieldMap(schemaName string) (FieldMap, error) {
 	return a.mapFields(bt, md)
 }
 
+// FilterFieldMap returns a FieldMap containing descriptors of the mappings between the ent schema fields
+// and the fields of the Filter message of the schema's List method.
+func (a *Adapter) FilterFieldMap(schemaName string) (FieldMap, error) {
+	bt, err := extractGenTypeByName(a.graph, schemaName)
+	if err != nil {
+		return nil, err
+	}
+	sd, err := a.GetServiceDescriptor(schemaName)
+	if err != nil {
+		return nil, err
+	}
+	fd := sd.GetFile()
+	md := fd.FindMessage(fmt.Sprintf("%s.%s%sRequest.Filter", fd.GetPackage(), list, schemaName))
+	if md == nil {
+		return nil, fmt.Errorf("entproto: could not find filter message descriptor for schema %q", schemaName)
+	}
+	return a.mapFields(bt, md)
+}
+
 // FieldMap contains a mapping between the field's name in the ent schema and a FieldMappingDescriptor.
 type FieldMap map[string]*FieldMappingDescriptor
 
@@ -103,6 +123,27 @@ type FieldMappingDescriptor struct {
 	IsIDField         bool
 	IsEnumFIeld       bool
 	ReferencedPbType  *desc.MessageDescriptor
+	// Converter holds the functions converting the field values, if set with the entproto.Converter option.
+	Converter *FieldConverter
+}
+
+// Nullable reports if the field is optional and its unset values can be told apart from zero
+// values in the proto message.
+func (d *FieldMappingDescriptor) Nullable() bool {
+	if d.IsEdgeField || !d.EntField.Optional {
+		return false
+	}
+	pbd := d.PbFieldDescriptor
+	return pbd.GetType() == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE || pbd.IsRepeated() || pbd.IsProto3Optional()
+}
+
+// Orderable reports if the results of the List method can be ordered by the field.
+func (d *FieldMappingDescriptor) Orderable() bool {
+	f := d.EntField
+	if d.IsEdgeField || d.IsIDField || f.Optional || f.Nillable || f.Sensitive() || f.HasGoType() {
+		return false
+	}
+	return f.IsString() || f.IsTime() || f.Type.Numeric()
 }
 
 func (d *FieldMappingDescriptor) PbStructField() string {
@@ -110,11 +151,11 @@ func (d *FieldMappingDescriptor) PbStructField() string {
 }
 
 func (d *FieldMappingDescriptor) EdgeIDPbStructField() string {
-	return camelCase(d.EntEdge.Ref.Type.ID.Name)
+	return camelCase(d.EntEdge.Type.ID.Name)
 }
 
 func (d *FieldMappingDescriptor) EdgeIDPbStructFieldDesc() *desc.FieldDescriptor {
-	field := strings.Title(camel(d.EntEdge.Ref.Type.ID.Name))
+	field := strings.Title(camel(d.EntEdge.Type.ID.Name))
 	return d.ReferencedPbType.FindFieldByName(snake(field))
 }
 
@@ -149,6 +190,9 @@ func (a *Adapter) mapFields(entType *gen.Type, pbType *desc.MessageDescriptor) (
 				return nil, err
 			}
 			fd.EntField = enf
+			if fann, err := extractFieldAnnotation(enf); err == nil {
+				fd.Converter = fann.Converter
+			}
 		}
 		m[fld.GetName()] = fd
 	}
@@ -20,4 +20,5 @@ var (
 	snake  = gen.Funcs["snake"].(func(string) string)
 	pascal = gen.Funcs["pascal"].(func(string) string)
 	camel  = gen.Funcs["camel"].(func(string) string)
+	plural = gen.Funcs["plural"].(func(string) string)
 )
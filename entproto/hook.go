@@ -53,7 +53,9 @@ func Hook() gen.Hook {
 
 // Generate takes a *gen.Graph and creates .proto files. Next to each .proto file, Generate creates a generate.go
 // file containing a //go:generate directive to invoke protoc and compile Go code from the protobuf definitions.
-// If generate.go already exists next to the .proto file, this step is skipped.
+// If generate.go already exists next to the .proto file, this step is skipped. The field and enum value numbers
+// of the generated files are recorded in the entproto.lock file: Generate fails if a number is reused or changes to
+// an incompatible type, and the numbers and names of removed fields and values are declared as reserved.
 func Generate(g *gen.Graph) error {
 	entProtoDir := path.Join(g.Config.Target, "proto")
 	adapter, err := LoadAdapter(g)
@@ -76,11 +78,30 @@ func Generate(g *gen.Graph) error {
 		allDescriptors = append(allDescriptors, filedesc)
 	}
 
+	// Verify the field numbers against the lock file, and reserve the numbers of removed fields.
+	lockPath := filepath.Join(entProtoDir, LockFileName)
+	lock, err := readLockFile(lockPath)
+	if err != nil {
+		return err
+	}
+	if allDescriptors, err = lock.apply(allDescriptors); err != nil {
+		return err
+	}
+
 	// Print the .proto files.
 	var printer protoprint.Printer
 	if err = printer.PrintProtosToFileSystem(allDescriptors, entProtoDir); err != nil {
 		return fmt.Errorf("entproto: failed writing .proto files: %w", err)
 	}
+	// The google/api files imported by services with google.api.http annotations are not distributed with
+	// protoc, print them alongside the generated files.
+	if err = printer.PrintProtosToFileSystem(googleAPIFiles(allDescriptors), entProtoDir); err != nil {
+		return fmt.Errorf("entproto: failed writing .proto files: %w", err)
+	}
+
+	if err := lock.write(lockPath); err != nil {
+		return fmt.Errorf("entproto: failed writing %s: %w", LockFileName, err)
+	}
 
 	// Print a generate.go file with protoc command for go file generation
 	for _, fd := range allDescriptors {
@@ -97,6 +118,31 @@ func Generate(g *gen.Graph) error {
 	return nil
 }
 
+// googleAPIFiles returns the google/api files imported by the given files, directly or transitively.
+func googleAPIFiles(fds []*desc.FileDescriptor) []*desc.FileDescriptor {
+	var (
+		out  []*desc.FileDescriptor
+		seen = make(map[string]bool)
+	)
+	var walk func(fd *desc.FileDescriptor)
+	walk = func(fd *desc.FileDescriptor) {
+		for _, dep := range fd.GetDependencies() {
+			if seen[dep.GetName()] {
+				continue
+			}
+			seen[dep.GetName()] = true
+			if strings.HasPrefix(dep.GetName(), "google/api/") {
+				out = append(out, dep)
+			}
+			walk(dep)
+		}
+	}
+	for _, fd := range fds {
+		walk(fd)
+	}
+	return out
+}
+
 func fileExists(fpath string) bool {
 	if _, err := os.Stat(fpath); err != nil {
 		if os.IsNotExist(err) {
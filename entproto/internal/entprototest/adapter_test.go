@@ -16,9 +16,13 @@ package entprototest
 
 import (
 	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"entgo.io/contrib/entproto"
+	_ "entgo.io/contrib/entproto/internal/todo/ent/proto/geo" // register the messages of geo.proto
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
 	"github.com/stretchr/testify/suite"
@@ -101,7 +105,71 @@ func (suite *AdapterTestSuite) TestExplicitSkippedMessage() {
 
 func (suite *AdapterTestSuite) TestInvalidField() {
 	_, err := suite.adapter.GetFileDescriptor("InvalidFieldMessage")
-	suite.EqualError(err, "unsupported field type \"TypeJSON\"")
+	suite.EqualError(err, "unsupported field type \"TypeOther\"")
+}
+
+func (suite *AdapterTestSuite) TestJSONFields() {
+	message, err := suite.adapter.GetMessageDescriptor("MessageWithJSON")
+	suite.Require().NoError(err)
+
+	for name, typ := range map[string]descriptorpb.FieldDescriptorProto_Type{
+		"strings": descriptorpb.FieldDescriptorProto_TYPE_STRING,
+		"ints":    descriptorpb.FieldDescriptorProto_TYPE_INT32,
+		"floats":  descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+	} {
+		fld := message.FindFieldByName(name)
+		suite.Require().NotNil(fld, name)
+		suite.EqualValues(typ, fld.GetType(), name)
+		suite.True(fld.IsRepeated(), name)
+	}
+	for name, typ := range map[string]string{
+		"object": "google.protobuf.Struct",
+		"list":   "google.protobuf.ListValue",
+		"value":  "google.protobuf.Value",
+		"raw":    "google.protobuf.Value",
+		"named":  "geo.Address",
+	} {
+		fld := message.FindFieldByName(name)
+		suite.Require().NotNil(fld, name)
+		suite.EqualValues(descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, fld.GetType(), name)
+		suite.EqualValues(typ, fld.GetMessageType().GetFullyQualifiedName(), name)
+		suite.False(fld.IsRepeated(), name)
+	}
+	suite.Contains(message.GetFile().AsFileDescriptorProto().GetDependency(), "google/protobuf/struct.proto")
+	suite.Contains(message.GetFile().AsFileDescriptorProto().GetDependency(), "geo/geo.proto")
+	_, present := suite.adapter.AllFileDescriptors()["geo/geo.proto"]
+	suite.False(present, "imported protos should not be included in the output descriptors")
+}
+
+func (suite *AdapterTestSuite) TestConverter() {
+	message, err := suite.adapter.GetMessageDescriptor("MessageWithConverter")
+	suite.Require().NoError(err)
+
+	duration := message.FindFieldByName("duration")
+	suite.EqualValues(descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, duration.GetType())
+	suite.EqualValues("google.protobuf.Duration", duration.GetMessageType().GetFullyQualifiedName())
+	other := message.FindFieldByName("other")
+	suite.EqualValues(descriptorpb.FieldDescriptorProto_TYPE_BYTES, other.GetType())
+
+	fm, err := suite.adapter.FieldMap("MessageWithConverter")
+	suite.Require().NoError(err)
+	conv := fm["other"].Converter
+	suite.Require().NotNil(conv)
+	suite.EqualValues("entgo.io/contrib/entproto/internal/entprototest/ent/schema", conv.ToProto.PkgPath)
+	suite.EqualValues("NullStringToProto", conv.ToProto.Name)
+	suite.False(conv.ToProto.Err)
+	suite.EqualValues("NullStringFromProto", conv.ToEnt.Name)
+	suite.True(conv.ToEnt.Err)
+	suite.EqualValues("google.golang.org/protobuf/types/known/durationpb", fm["duration"].Converter.ToProto.PkgPath)
+	suite.EqualValues("New", fm["duration"].Converter.ToProto.Name)
+}
+
+func (suite *AdapterTestSuite) TestConverterInvalid() {
+	suite.Panics(func() { entproto.Converter(strconv.Itoa, strconv.FormatBool) }, "mismatched types")
+	suite.Panics(func() { entproto.Converter(strings.ToUpper, func(s string) string { return s }) }, "closure")
+	suite.Panics(func() { entproto.Converter(strconv.IntSize, strconv.Atoi) }, "not a function")
+	suite.Panics(func() { entproto.Converter(time.Duration.String, time.ParseDuration) }, "method expression")
+	suite.Panics(func() { entproto.Converter(time.Unix, time.Time.Unix) }, "multiple arguments")
 }
 
 func (suite *AdapterTestSuite) TestDuplicateNumber() {
@@ -239,3 +307,26 @@ func (suite *AdapterTestSuite) TestOptionals() {
 	suite.Require().EqualValues(descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, bytesField.GetType())
 	suite.Require().EqualValues("BytesValue", uuidField.GetMessageType().GetName())
 }
+
+func (suite *AdapterTestSuite) TestProto3Optionals() {
+	message, err := suite.adapter.GetMessageDescriptor("MessageWithProto3Optionals")
+	suite.Require().NoError(err)
+
+	for name, typ := range map[string]descriptorpb.FieldDescriptorProto_Type{
+		"str_optional":  descriptorpb.FieldDescriptorProto_TYPE_STRING,
+		"int_optional":  descriptorpb.FieldDescriptorProto_TYPE_INT32,
+		"uuid_optional": descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+	} {
+		fld := message.FindFieldByName(name)
+		suite.Require().NotNil(fld, name)
+		suite.EqualValues(typ, fld.GetType(), name)
+		suite.True(fld.IsProto3Optional(), name)
+		suite.EqualValues("_"+name, fld.GetOneOf().GetName(), name)
+	}
+
+	timeField := message.FindFieldByName("time_optional")
+	suite.EqualValues("google.protobuf.Timestamp", timeField.GetMessageType().GetFullyQualifiedName())
+	suite.False(timeField.IsProto3Optional())
+	suite.False(message.FindFieldByName("str").IsProto3Optional())
+	suite.Len(message.GetOneOfs(), 3)
+}
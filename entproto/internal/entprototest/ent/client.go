@@ -18,11 +18,14 @@ import (
 	"entgo.io/contrib/entproto/internal/entprototest/ent/image"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/implicitskippedmessage"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/invalidfieldmessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithconverter"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithenum"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithfieldone"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithid"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithjson"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithoptionals"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithpackagename"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithproto3optionals"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/portal"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/user"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/validmessage"
@@ -53,16 +56,22 @@ type Client struct {
 	ImplicitSkippedMessage *ImplicitSkippedMessageClient
 	// InvalidFieldMessage is the client for interacting with the InvalidFieldMessage builders.
 	InvalidFieldMessage *InvalidFieldMessageClient
+	// MessageWithConverter is the client for interacting with the MessageWithConverter builders.
+	MessageWithConverter *MessageWithConverterClient
 	// MessageWithEnum is the client for interacting with the MessageWithEnum builders.
 	MessageWithEnum *MessageWithEnumClient
 	// MessageWithFieldOne is the client for interacting with the MessageWithFieldOne builders.
 	MessageWithFieldOne *MessageWithFieldOneClient
 	// MessageWithID is the client for interacting with the MessageWithID builders.
 	MessageWithID *MessageWithIDClient
+	// MessageWithJSON is the client for interacting with the MessageWithJSON builders.
+	MessageWithJSON *MessageWithJSONClient
 	// MessageWithOptionals is the client for interacting with the MessageWithOptionals builders.
 	MessageWithOptionals *MessageWithOptionalsClient
 	// MessageWithPackageName is the client for interacting with the MessageWithPackageName builders.
 	MessageWithPackageName *MessageWithPackageNameClient
+	// MessageWithProto3Optionals is the client for interacting with the MessageWithProto3Optionals builders.
+	MessageWithProto3Optionals *MessageWithProto3OptionalsClient
 	// Portal is the client for interacting with the Portal builders.
 	Portal *PortalClient
 	// User is the client for interacting with the User builders.
@@ -90,11 +99,14 @@ func (c *Client) init() {
 	c.Image = NewImageClient(c.config)
 	c.ImplicitSkippedMessage = NewImplicitSkippedMessageClient(c.config)
 	c.InvalidFieldMessage = NewInvalidFieldMessageClient(c.config)
+	c.MessageWithConverter = NewMessageWithConverterClient(c.config)
 	c.MessageWithEnum = NewMessageWithEnumClient(c.config)
 	c.MessageWithFieldOne = NewMessageWithFieldOneClient(c.config)
 	c.MessageWithID = NewMessageWithIDClient(c.config)
+	c.MessageWithJSON = NewMessageWithJSONClient(c.config)
 	c.MessageWithOptionals = NewMessageWithOptionalsClient(c.config)
 	c.MessageWithPackageName = NewMessageWithPackageNameClient(c.config)
+	c.MessageWithProto3Optionals = NewMessageWithProto3OptionalsClient(c.config)
 	c.Portal = NewPortalClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.ValidMessage = NewValidMessageClient(c.config)
@@ -129,24 +141,27 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                    ctx,
-		config:                 cfg,
-		BlogPost:               NewBlogPostClient(cfg),
-		Category:               NewCategoryClient(cfg),
-		DependsOnSkipped:       NewDependsOnSkippedClient(cfg),
-		DuplicateNumberMessage: NewDuplicateNumberMessageClient(cfg),
-		ExplicitSkippedMessage: NewExplicitSkippedMessageClient(cfg),
-		Image:                  NewImageClient(cfg),
-		ImplicitSkippedMessage: NewImplicitSkippedMessageClient(cfg),
-		InvalidFieldMessage:    NewInvalidFieldMessageClient(cfg),
-		MessageWithEnum:        NewMessageWithEnumClient(cfg),
-		MessageWithFieldOne:    NewMessageWithFieldOneClient(cfg),
-		MessageWithID:          NewMessageWithIDClient(cfg),
-		MessageWithOptionals:   NewMessageWithOptionalsClient(cfg),
-		MessageWithPackageName: NewMessageWithPackageNameClient(cfg),
-		Portal:                 NewPortalClient(cfg),
-		User:                   NewUserClient(cfg),
-		ValidMessage:           NewValidMessageClient(cfg),
+		ctx:                        ctx,
+		config:                     cfg,
+		BlogPost:                   NewBlogPostClient(cfg),
+		Category:                   NewCategoryClient(cfg),
+		DependsOnSkipped:           NewDependsOnSkippedClient(cfg),
+		DuplicateNumberMessage:     NewDuplicateNumberMessageClient(cfg),
+		ExplicitSkippedMessage:     NewExplicitSkippedMessageClient(cfg),
+		Image:                      NewImageClient(cfg),
+		ImplicitSkippedMessage:     NewImplicitSkippedMessageClient(cfg),
+		InvalidFieldMessage:        NewInvalidFieldMessageClient(cfg),
+		MessageWithConverter:       NewMessageWithConverterClient(cfg),
+		MessageWithEnum:            NewMessageWithEnumClient(cfg),
+		MessageWithFieldOne:        NewMessageWithFieldOneClient(cfg),
+		MessageWithID:              NewMessageWithIDClient(cfg),
+		MessageWithJSON:            NewMessageWithJSONClient(cfg),
+		MessageWithOptionals:       NewMessageWithOptionalsClient(cfg),
+		MessageWithPackageName:     NewMessageWithPackageNameClient(cfg),
+		MessageWithProto3Optionals: NewMessageWithProto3OptionalsClient(cfg),
+		Portal:                     NewPortalClient(cfg),
+		User:                       NewUserClient(cfg),
+		ValidMessage:               NewValidMessageClient(cfg),
 	}, nil
 }
 
@@ -164,23 +179,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		config:                 cfg,
-		BlogPost:               NewBlogPostClient(cfg),
-		Category:               NewCategoryClient(cfg),
-		DependsOnSkipped:       NewDependsOnSkippedClient(cfg),
-		DuplicateNumberMessage: NewDuplicateNumberMessageClient(cfg),
-		ExplicitSkippedMessage: NewExplicitSkippedMessageClient(cfg),
-		Image:                  NewImageClient(cfg),
-		ImplicitSkippedMessage: NewImplicitSkippedMessageClient(cfg),
-		InvalidFieldMessage:    NewInvalidFieldMessageClient(cfg),
-		MessageWithEnum:        NewMessageWithEnumClient(cfg),
-		MessageWithFieldOne:    NewMessageWithFieldOneClient(cfg),
-		MessageWithID:          NewMessageWithIDClient(cfg),
-		MessageWithOptionals:   NewMessageWithOptionalsClient(cfg),
-		MessageWithPackageName: NewMessageWithPackageNameClient(cfg),
-		Portal:                 NewPortalClient(cfg),
-		User:                   NewUserClient(cfg),
-		ValidMessage:           NewValidMessageClient(cfg),
+		config:                     cfg,
+		BlogPost:                   NewBlogPostClient(cfg),
+		Category:                   NewCategoryClient(cfg),
+		DependsOnSkipped:           NewDependsOnSkippedClient(cfg),
+		DuplicateNumberMessage:     NewDuplicateNumberMessageClient(cfg),
+		ExplicitSkippedMessage:     NewExplicitSkippedMessageClient(cfg),
+		Image:                      NewImageClient(cfg),
+		ImplicitSkippedMessage:     NewImplicitSkippedMessageClient(cfg),
+		InvalidFieldMessage:        NewInvalidFieldMessageClient(cfg),
+		MessageWithConverter:       NewMessageWithConverterClient(cfg),
+		MessageWithEnum:            NewMessageWithEnumClient(cfg),
+		MessageWithFieldOne:        NewMessageWithFieldOneClient(cfg),
+		MessageWithID:              NewMessageWithIDClient(cfg),
+		MessageWithJSON:            NewMessageWithJSONClient(cfg),
+		MessageWithOptionals:       NewMessageWithOptionalsClient(cfg),
+		MessageWithPackageName:     NewMessageWithPackageNameClient(cfg),
+		MessageWithProto3Optionals: NewMessageWithProto3OptionalsClient(cfg),
+		Portal:                     NewPortalClient(cfg),
+		User:                       NewUserClient(cfg),
+		ValidMessage:               NewValidMessageClient(cfg),
 	}, nil
 }
 
@@ -190,7 +208,6 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 //		BlogPost.
 //		Query().
 //		Count(ctx)
-//
 func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
@@ -218,11 +235,14 @@ func (c *Client) Use(hooks ...Hook) {
 	c.Image.Use(hooks...)
 	c.ImplicitSkippedMessage.Use(hooks...)
 	c.InvalidFieldMessage.Use(hooks...)
+	c.MessageWithConverter.Use(hooks...)
 	c.MessageWithEnum.Use(hooks...)
 	c.MessageWithFieldOne.Use(hooks...)
 	c.MessageWithID.Use(hooks...)
+	c.MessageWithJSON.Use(hooks...)
 	c.MessageWithOptionals.Use(hooks...)
 	c.MessageWithPackageName.Use(hooks...)
+	c.MessageWithProto3Optionals.Use(hooks...)
 	c.Portal.Use(hooks...)
 	c.User.Use(hooks...)
 	c.ValidMessage.Use(hooks...)
@@ -1028,6 +1048,96 @@ func (c *InvalidFieldMessageClient) Hooks() []Hook {
 	return c.hooks.InvalidFieldMessage
 }
 
+// MessageWithConverterClient is a client for the MessageWithConverter schema.
+type MessageWithConverterClient struct {
+	config
+}
+
+// NewMessageWithConverterClient returns a client for the MessageWithConverter from the given config.
+func NewMessageWithConverterClient(c config) *MessageWithConverterClient {
+	return &MessageWithConverterClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagewithconverter.Hooks(f(g(h())))`.
+func (c *MessageWithConverterClient) Use(hooks ...Hook) {
+	c.hooks.MessageWithConverter = append(c.hooks.MessageWithConverter, hooks...)
+}
+
+// Create returns a create builder for MessageWithConverter.
+func (c *MessageWithConverterClient) Create() *MessageWithConverterCreate {
+	mutation := newMessageWithConverterMutation(c.config, OpCreate)
+	return &MessageWithConverterCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageWithConverter entities.
+func (c *MessageWithConverterClient) CreateBulk(builders ...*MessageWithConverterCreate) *MessageWithConverterCreateBulk {
+	return &MessageWithConverterCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageWithConverter.
+func (c *MessageWithConverterClient) Update() *MessageWithConverterUpdate {
+	mutation := newMessageWithConverterMutation(c.config, OpUpdate)
+	return &MessageWithConverterUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageWithConverterClient) UpdateOne(mwc *MessageWithConverter) *MessageWithConverterUpdateOne {
+	mutation := newMessageWithConverterMutation(c.config, OpUpdateOne, withMessageWithConverter(mwc))
+	return &MessageWithConverterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageWithConverterClient) UpdateOneID(id int) *MessageWithConverterUpdateOne {
+	mutation := newMessageWithConverterMutation(c.config, OpUpdateOne, withMessageWithConverterID(id))
+	return &MessageWithConverterUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageWithConverter.
+func (c *MessageWithConverterClient) Delete() *MessageWithConverterDelete {
+	mutation := newMessageWithConverterMutation(c.config, OpDelete)
+	return &MessageWithConverterDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *MessageWithConverterClient) DeleteOne(mwc *MessageWithConverter) *MessageWithConverterDeleteOne {
+	return c.DeleteOneID(mwc.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *MessageWithConverterClient) DeleteOneID(id int) *MessageWithConverterDeleteOne {
+	builder := c.Delete().Where(messagewithconverter.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageWithConverterDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageWithConverter.
+func (c *MessageWithConverterClient) Query() *MessageWithConverterQuery {
+	return &MessageWithConverterQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a MessageWithConverter entity by its id.
+func (c *MessageWithConverterClient) Get(ctx context.Context, id int) (*MessageWithConverter, error) {
+	return c.Query().Where(messagewithconverter.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageWithConverterClient) GetX(ctx context.Context, id int) *MessageWithConverter {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MessageWithConverterClient) Hooks() []Hook {
+	return c.hooks.MessageWithConverter
+}
+
 // MessageWithEnumClient is a client for the MessageWithEnum schema.
 type MessageWithEnumClient struct {
 	config
@@ -1298,6 +1408,96 @@ func (c *MessageWithIDClient) Hooks() []Hook {
 	return c.hooks.MessageWithID
 }
 
+// MessageWithJSONClient is a client for the MessageWithJSON schema.
+type MessageWithJSONClient struct {
+	config
+}
+
+// NewMessageWithJSONClient returns a client for the MessageWithJSON from the given config.
+func NewMessageWithJSONClient(c config) *MessageWithJSONClient {
+	return &MessageWithJSONClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagewithjson.Hooks(f(g(h())))`.
+func (c *MessageWithJSONClient) Use(hooks ...Hook) {
+	c.hooks.MessageWithJSON = append(c.hooks.MessageWithJSON, hooks...)
+}
+
+// Create returns a create builder for MessageWithJSON.
+func (c *MessageWithJSONClient) Create() *MessageWithJSONCreate {
+	mutation := newMessageWithJSONMutation(c.config, OpCreate)
+	return &MessageWithJSONCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageWithJSON entities.
+func (c *MessageWithJSONClient) CreateBulk(builders ...*MessageWithJSONCreate) *MessageWithJSONCreateBulk {
+	return &MessageWithJSONCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageWithJSON.
+func (c *MessageWithJSONClient) Update() *MessageWithJSONUpdate {
+	mutation := newMessageWithJSONMutation(c.config, OpUpdate)
+	return &MessageWithJSONUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageWithJSONClient) UpdateOne(mwj *MessageWithJSON) *MessageWithJSONUpdateOne {
+	mutation := newMessageWithJSONMutation(c.config, OpUpdateOne, withMessageWithJSON(mwj))
+	return &MessageWithJSONUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageWithJSONClient) UpdateOneID(id int) *MessageWithJSONUpdateOne {
+	mutation := newMessageWithJSONMutation(c.config, OpUpdateOne, withMessageWithJSONID(id))
+	return &MessageWithJSONUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageWithJSON.
+func (c *MessageWithJSONClient) Delete() *MessageWithJSONDelete {
+	mutation := newMessageWithJSONMutation(c.config, OpDelete)
+	return &MessageWithJSONDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *MessageWithJSONClient) DeleteOne(mwj *MessageWithJSON) *MessageWithJSONDeleteOne {
+	return c.DeleteOneID(mwj.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *MessageWithJSONClient) DeleteOneID(id int) *MessageWithJSONDeleteOne {
+	builder := c.Delete().Where(messagewithjson.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageWithJSONDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageWithJSON.
+func (c *MessageWithJSONClient) Query() *MessageWithJSONQuery {
+	return &MessageWithJSONQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a MessageWithJSON entity by its id.
+func (c *MessageWithJSONClient) Get(ctx context.Context, id int) (*MessageWithJSON, error) {
+	return c.Query().Where(messagewithjson.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageWithJSONClient) GetX(ctx context.Context, id int) *MessageWithJSON {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MessageWithJSONClient) Hooks() []Hook {
+	return c.hooks.MessageWithJSON
+}
+
 // MessageWithOptionalsClient is a client for the MessageWithOptionals schema.
 type MessageWithOptionalsClient struct {
 	config
@@ -1478,6 +1678,96 @@ func (c *MessageWithPackageNameClient) Hooks() []Hook {
 	return c.hooks.MessageWithPackageName
 }
 
+// MessageWithProto3OptionalsClient is a client for the MessageWithProto3Optionals schema.
+type MessageWithProto3OptionalsClient struct {
+	config
+}
+
+// NewMessageWithProto3OptionalsClient returns a client for the MessageWithProto3Optionals from the given config.
+func NewMessageWithProto3OptionalsClient(c config) *MessageWithProto3OptionalsClient {
+	return &MessageWithProto3OptionalsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `messagewithproto3optionals.Hooks(f(g(h())))`.
+func (c *MessageWithProto3OptionalsClient) Use(hooks ...Hook) {
+	c.hooks.MessageWithProto3Optionals = append(c.hooks.MessageWithProto3Optionals, hooks...)
+}
+
+// Create returns a create builder for MessageWithProto3Optionals.
+func (c *MessageWithProto3OptionalsClient) Create() *MessageWithProto3OptionalsCreate {
+	mutation := newMessageWithProto3OptionalsMutation(c.config, OpCreate)
+	return &MessageWithProto3OptionalsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MessageWithProto3Optionals entities.
+func (c *MessageWithProto3OptionalsClient) CreateBulk(builders ...*MessageWithProto3OptionalsCreate) *MessageWithProto3OptionalsCreateBulk {
+	return &MessageWithProto3OptionalsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MessageWithProto3Optionals.
+func (c *MessageWithProto3OptionalsClient) Update() *MessageWithProto3OptionalsUpdate {
+	mutation := newMessageWithProto3OptionalsMutation(c.config, OpUpdate)
+	return &MessageWithProto3OptionalsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MessageWithProto3OptionalsClient) UpdateOne(mwp *MessageWithProto3Optionals) *MessageWithProto3OptionalsUpdateOne {
+	mutation := newMessageWithProto3OptionalsMutation(c.config, OpUpdateOne, withMessageWithProto3Optionals(mwp))
+	return &MessageWithProto3OptionalsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MessageWithProto3OptionalsClient) UpdateOneID(id int) *MessageWithProto3OptionalsUpdateOne {
+	mutation := newMessageWithProto3OptionalsMutation(c.config, OpUpdateOne, withMessageWithProto3OptionalsID(id))
+	return &MessageWithProto3OptionalsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageWithProto3Optionals.
+func (c *MessageWithProto3OptionalsClient) Delete() *MessageWithProto3OptionalsDelete {
+	mutation := newMessageWithProto3OptionalsMutation(c.config, OpDelete)
+	return &MessageWithProto3OptionalsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *MessageWithProto3OptionalsClient) DeleteOne(mwp *MessageWithProto3Optionals) *MessageWithProto3OptionalsDeleteOne {
+	return c.DeleteOneID(mwp.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *MessageWithProto3OptionalsClient) DeleteOneID(id int) *MessageWithProto3OptionalsDeleteOne {
+	builder := c.Delete().Where(messagewithproto3optionals.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageWithProto3OptionalsDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageWithProto3Optionals.
+func (c *MessageWithProto3OptionalsClient) Query() *MessageWithProto3OptionalsQuery {
+	return &MessageWithProto3OptionalsQuery{
+		config: c.config,
+	}
+}
+
+// Get returns a MessageWithProto3Optionals entity by its id.
+func (c *MessageWithProto3OptionalsClient) Get(ctx context.Context, id int) (*MessageWithProto3Optionals, error) {
+	return c.Query().Where(messagewithproto3optionals.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageWithProto3OptionalsClient) GetX(ctx context.Context, id int) *MessageWithProto3Optionals {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MessageWithProto3OptionalsClient) Hooks() []Hook {
+	return c.hooks.MessageWithProto3Optionals
+}
+
 // PortalClient is a client for the Portal schema.
 type PortalClient struct {
 	config
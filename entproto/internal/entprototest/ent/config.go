@@ -24,22 +24,25 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	BlogPost               []ent.Hook
-	Category               []ent.Hook
-	DependsOnSkipped       []ent.Hook
-	DuplicateNumberMessage []ent.Hook
-	ExplicitSkippedMessage []ent.Hook
-	Image                  []ent.Hook
-	ImplicitSkippedMessage []ent.Hook
-	InvalidFieldMessage    []ent.Hook
-	MessageWithEnum        []ent.Hook
-	MessageWithFieldOne    []ent.Hook
-	MessageWithID          []ent.Hook
-	MessageWithOptionals   []ent.Hook
-	MessageWithPackageName []ent.Hook
-	Portal                 []ent.Hook
-	User                   []ent.Hook
-	ValidMessage           []ent.Hook
+	BlogPost                   []ent.Hook
+	Category                   []ent.Hook
+	DependsOnSkipped           []ent.Hook
+	DuplicateNumberMessage     []ent.Hook
+	ExplicitSkippedMessage     []ent.Hook
+	Image                      []ent.Hook
+	ImplicitSkippedMessage     []ent.Hook
+	InvalidFieldMessage        []ent.Hook
+	MessageWithConverter       []ent.Hook
+	MessageWithEnum            []ent.Hook
+	MessageWithFieldOne        []ent.Hook
+	MessageWithID              []ent.Hook
+	MessageWithJSON            []ent.Hook
+	MessageWithOptionals       []ent.Hook
+	MessageWithPackageName     []ent.Hook
+	MessageWithProto3Optionals []ent.Hook
+	Portal                     []ent.Hook
+	User                       []ent.Hook
+	ValidMessage               []ent.Hook
 }
 
 // Options applies the options on the config object.
@@ -14,11 +14,14 @@ import (
 	"entgo.io/contrib/entproto/internal/entprototest/ent/image"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/implicitskippedmessage"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/invalidfieldmessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithconverter"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithenum"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithfieldone"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithid"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithjson"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithoptionals"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithpackagename"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithproto3optionals"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/portal"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/user"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/validmessage"
@@ -44,22 +47,25 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		blogpost.Table:               blogpost.ValidColumn,
-		category.Table:               category.ValidColumn,
-		dependsonskipped.Table:       dependsonskipped.ValidColumn,
-		duplicatenumbermessage.Table: duplicatenumbermessage.ValidColumn,
-		explicitskippedmessage.Table: explicitskippedmessage.ValidColumn,
-		image.Table:                  image.ValidColumn,
-		implicitskippedmessage.Table: implicitskippedmessage.ValidColumn,
-		invalidfieldmessage.Table:    invalidfieldmessage.ValidColumn,
-		messagewithenum.Table:        messagewithenum.ValidColumn,
-		messagewithfieldone.Table:    messagewithfieldone.ValidColumn,
-		messagewithid.Table:          messagewithid.ValidColumn,
-		messagewithoptionals.Table:   messagewithoptionals.ValidColumn,
-		messagewithpackagename.Table: messagewithpackagename.ValidColumn,
-		portal.Table:                 portal.ValidColumn,
-		user.Table:                   user.ValidColumn,
-		validmessage.Table:           validmessage.ValidColumn,
+		blogpost.Table:                   blogpost.ValidColumn,
+		category.Table:                   category.ValidColumn,
+		dependsonskipped.Table:           dependsonskipped.ValidColumn,
+		duplicatenumbermessage.Table:     duplicatenumbermessage.ValidColumn,
+		explicitskippedmessage.Table:     explicitskippedmessage.ValidColumn,
+		image.Table:                      image.ValidColumn,
+		implicitskippedmessage.Table:     implicitskippedmessage.ValidColumn,
+		invalidfieldmessage.Table:        invalidfieldmessage.ValidColumn,
+		messagewithconverter.Table:       messagewithconverter.ValidColumn,
+		messagewithenum.Table:            messagewithenum.ValidColumn,
+		messagewithfieldone.Table:        messagewithfieldone.ValidColumn,
+		messagewithid.Table:              messagewithid.ValidColumn,
+		messagewithjson.Table:            messagewithjson.ValidColumn,
+		messagewithoptionals.Table:       messagewithoptionals.ValidColumn,
+		messagewithpackagename.Table:     messagewithpackagename.ValidColumn,
+		messagewithproto3optionals.Table: messagewithproto3optionals.ValidColumn,
+		portal.Table:                     portal.ValidColumn,
+		user.Table:                       user.ValidColumn,
+		validmessage.Table:               validmessage.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -109,7 +115,6 @@ type AggregateFunc func(*sql.Selector) string
 //	GroupBy(field1, field2).
 //	Aggregate(ent.As(ent.Sum(field1), "sum_field1"), (ent.As(ent.Sum(field2), "sum_field2")).
 //	Scan(ctx, &v)
-//
 func As(fn AggregateFunc, end string) AggregateFunc {
 	return func(s *sql.Selector) string {
 		return sql.As(fn(s), end)
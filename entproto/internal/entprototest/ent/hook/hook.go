@@ -113,6 +113,19 @@ func (f InvalidFieldMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (en
 	return f(ctx, mv)
 }
 
+// The MessageWithConverterFunc type is an adapter to allow the use of ordinary
+// function as MessageWithConverter mutator.
+type MessageWithConverterFunc func(context.Context, *ent.MessageWithConverterMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageWithConverterFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.MessageWithConverterMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageWithConverterMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The MessageWithEnumFunc type is an adapter to allow the use of ordinary
 // function as MessageWithEnum mutator.
 type MessageWithEnumFunc func(context.Context, *ent.MessageWithEnumMutation) (ent.Value, error)
@@ -152,6 +165,19 @@ func (f MessageWithIDFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return f(ctx, mv)
 }
 
+// The MessageWithJSONFunc type is an adapter to allow the use of ordinary
+// function as MessageWithJSON mutator.
+type MessageWithJSONFunc func(context.Context, *ent.MessageWithJSONMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageWithJSONFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.MessageWithJSONMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageWithJSONMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The MessageWithOptionalsFunc type is an adapter to allow the use of ordinary
 // function as MessageWithOptionals mutator.
 type MessageWithOptionalsFunc func(context.Context, *ent.MessageWithOptionalsMutation) (ent.Value, error)
@@ -178,6 +204,19 @@ func (f MessageWithPackageNameFunc) Mutate(ctx context.Context, m ent.Mutation)
 	return f(ctx, mv)
 }
 
+// The MessageWithProto3OptionalsFunc type is an adapter to allow the use of ordinary
+// function as MessageWithProto3Optionals mutator.
+type MessageWithProto3OptionalsFunc func(context.Context, *ent.MessageWithProto3OptionalsMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MessageWithProto3OptionalsFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.MessageWithProto3OptionalsMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageWithProto3OptionalsMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The PortalFunc type is an adapter to allow the use of ordinary
 // function as Portal mutator.
 type PortalFunc func(context.Context, *ent.PortalMutation) (ent.Value, error)
@@ -312,7 +351,6 @@ func HasFields(field string, fields ...string) Condition {
 // If executes the given hook under condition.
 //
 //	hook.If(ComputeAverage, And(HasFields(...), HasAddedFields(...)))
-//
 func If(hk ent.Hook, cond Condition) ent.Hook {
 	return func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
@@ -327,7 +365,6 @@ func If(hk ent.Hook, cond Condition) ent.Hook {
 // On executes the given hook only for the given operation.
 //
 //	hook.On(Log, ent.Delete|ent.Create)
-//
 func On(hk ent.Hook, op ent.Op) ent.Hook {
 	return If(hk, HasOp(op))
 }
@@ -335,7 +372,6 @@ func On(hk ent.Hook, op ent.Op) ent.Hook {
 // Unless skips the given hook only for the given operation.
 //
 //	hook.Unless(Log, ent.Update|ent.UpdateOne)
-//
 func Unless(hk ent.Hook, op ent.Op) ent.Hook {
 	return If(hk, Not(HasOp(op)))
 }
@@ -356,7 +392,6 @@ func FixedError(err error) ent.Hook {
 //			Reject(ent.Delete|ent.Update),
 //		}
 //	}
-//
 func Reject(op ent.Op) ent.Hook {
 	hk := FixedError(fmt.Errorf("%s operation is not allowed", op))
 	return On(hk, op)
@@ -3,12 +3,10 @@
 package ent
 
 import (
-	"encoding/json"
 	"fmt"
 	"strings"
 
 	"entgo.io/contrib/entproto/internal/entprototest/ent/invalidfieldmessage"
-	"entgo.io/contrib/entproto/internal/entprototest/ent/schema"
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -17,8 +15,8 @@ type InvalidFieldMessage struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
-	// JSON holds the value of the "json" field.
-	JSON *schema.SomeJSON `json:"json,omitempty"`
+	// Other holds the value of the "other" field.
+	Other *sql.NullString `json:"other,omitempty"`
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -26,10 +24,10 @@ func (*InvalidFieldMessage) scanValues(columns []string) ([]interface{}, error)
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case invalidfieldmessage.FieldJSON:
-			values[i] = new([]byte)
 		case invalidfieldmessage.FieldID:
 			values[i] = new(sql.NullInt64)
+		case invalidfieldmessage.FieldOther:
+			values[i] = new(sql.NullString)
 		default:
 			return nil, fmt.Errorf("unexpected column %q for type InvalidFieldMessage", columns[i])
 		}
@@ -51,13 +49,11 @@ func (ifm *InvalidFieldMessage) assignValues(columns []string, values []interfac
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			ifm.ID = int(value.Int64)
-		case invalidfieldmessage.FieldJSON:
-			if value, ok := values[i].(*[]byte); !ok {
-				return fmt.Errorf("unexpected type %T for field json", values[i])
-			} else if value != nil && len(*value) > 0 {
-				if err := json.Unmarshal(*value, &ifm.JSON); err != nil {
-					return fmt.Errorf("unmarshal field json: %w", err)
-				}
+		case invalidfieldmessage.FieldOther:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field other", values[i])
+			} else if value.Valid {
+				ifm.Other = value
 			}
 		}
 	}
@@ -87,8 +83,8 @@ func (ifm *InvalidFieldMessage) String() string {
 	var builder strings.Builder
 	builder.WriteString("InvalidFieldMessage(")
 	builder.WriteString(fmt.Sprintf("id=%v", ifm.ID))
-	builder.WriteString(", json=")
-	builder.WriteString(fmt.Sprintf("%v", ifm.JSON))
+	builder.WriteString(", other=")
+	builder.WriteString(fmt.Sprintf("%v", ifm.Other))
 	builder.WriteByte(')')
 	return builder.String()
 }
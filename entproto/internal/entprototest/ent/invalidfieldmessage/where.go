@@ -90,6 +90,89 @@ func IDLTE(id int) predicate.InvalidFieldMessage {
 	})
 }
 
+// Other applies equality check predicate on the "other" field. It's identical to OtherEQ.
+func Other(v *sql.NullString) predicate.InvalidFieldMessage {
+	return predicate.InvalidFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOther), v))
+	})
+}
+
+// OtherEQ applies the EQ predicate on the "other" field.
+func OtherEQ(v *sql.NullString) predicate.InvalidFieldMessage {
+	return predicate.InvalidFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOther), v))
+	})
+}
+
+// OtherNEQ applies the NEQ predicate on the "other" field.
+func OtherNEQ(v *sql.NullString) predicate.InvalidFieldMessage {
+	return predicate.InvalidFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOther), v))
+	})
+}
+
+// OtherIn applies the In predicate on the "other" field.
+func OtherIn(vs ...*sql.NullString) predicate.InvalidFieldMessage {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvalidFieldMessage(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOther), v...))
+	})
+}
+
+// OtherNotIn applies the NotIn predicate on the "other" field.
+func OtherNotIn(vs ...*sql.NullString) predicate.InvalidFieldMessage {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.InvalidFieldMessage(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOther), v...))
+	})
+}
+
+// OtherGT applies the GT predicate on the "other" field.
+func OtherGT(v *sql.NullString) predicate.InvalidFieldMessage {
+	return predicate.InvalidFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOther), v))
+	})
+}
+
+// OtherGTE applies the GTE predicate on the "other" field.
+func OtherGTE(v *sql.NullString) predicate.InvalidFieldMessage {
+	return predicate.InvalidFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOther), v))
+	})
+}
+
+// OtherLT applies the LT predicate on the "other" field.
+func OtherLT(v *sql.NullString) predicate.InvalidFieldMessage {
+	return predicate.InvalidFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOther), v))
+	})
+}
+
+// OtherLTE applies the LTE predicate on the "other" field.
+func OtherLTE(v *sql.NullString) predicate.InvalidFieldMessage {
+	return predicate.InvalidFieldMessage(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOther), v))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.InvalidFieldMessage) predicate.InvalidFieldMessage {
 	return predicate.InvalidFieldMessage(func(s *sql.Selector) {
@@ -8,7 +8,7 @@ import (
 	"fmt"
 
 	"entgo.io/contrib/entproto/internal/entprototest/ent/invalidfieldmessage"
-	"entgo.io/contrib/entproto/internal/entprototest/ent/schema"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 )
@@ -20,9 +20,9 @@ type InvalidFieldMessageCreate struct {
 	hooks    []Hook
 }
 
-// SetJSON sets the "json" field.
-func (ifmc *InvalidFieldMessageCreate) SetJSON(sj *schema.SomeJSON) *InvalidFieldMessageCreate {
-	ifmc.mutation.SetJSON(sj)
+// SetOther sets the "other" field.
+func (ifmc *InvalidFieldMessageCreate) SetOther(ss *sql.NullString) *InvalidFieldMessageCreate {
+	ifmc.mutation.SetOther(ss)
 	return ifmc
 }
 
@@ -96,8 +96,8 @@ func (ifmc *InvalidFieldMessageCreate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (ifmc *InvalidFieldMessageCreate) check() error {
-	if _, ok := ifmc.mutation.JSON(); !ok {
-		return &ValidationError{Name: "json", err: errors.New(`ent: missing required field "json"`)}
+	if _, ok := ifmc.mutation.Other(); !ok {
+		return &ValidationError{Name: "other", err: errors.New(`ent: missing required field "other"`)}
 	}
 	return nil
 }
@@ -126,13 +126,13 @@ func (ifmc *InvalidFieldMessageCreate) createSpec() (*InvalidFieldMessage, *sqlg
 			},
 		}
 	)
-	if value, ok := ifmc.mutation.JSON(); ok {
+	if value, ok := ifmc.mutation.Other(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
-			Type:   field.TypeJSON,
+			Type:   field.TypeOther,
 			Value:  value,
-			Column: invalidfieldmessage.FieldJSON,
+			Column: invalidfieldmessage.FieldOther,
 		})
-		_node.JSON = value
+		_node.Other = value
 	}
 	return _node, _spec
 }
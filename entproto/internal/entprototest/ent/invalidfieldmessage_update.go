@@ -8,7 +8,6 @@ import (
 
 	"entgo.io/contrib/entproto/internal/entprototest/ent/invalidfieldmessage"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
-	"entgo.io/contrib/entproto/internal/entprototest/ent/schema"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -27,9 +26,9 @@ func (ifmu *InvalidFieldMessageUpdate) Where(ps ...predicate.InvalidFieldMessage
 	return ifmu
 }
 
-// SetJSON sets the "json" field.
-func (ifmu *InvalidFieldMessageUpdate) SetJSON(sj *schema.SomeJSON) *InvalidFieldMessageUpdate {
-	ifmu.mutation.SetJSON(sj)
+// SetOther sets the "other" field.
+func (ifmu *InvalidFieldMessageUpdate) SetOther(ss *sql.NullString) *InvalidFieldMessageUpdate {
+	ifmu.mutation.SetOther(ss)
 	return ifmu
 }
 
@@ -110,11 +109,11 @@ func (ifmu *InvalidFieldMessageUpdate) sqlSave(ctx context.Context) (n int, err
 			}
 		}
 	}
-	if value, ok := ifmu.mutation.JSON(); ok {
+	if value, ok := ifmu.mutation.Other(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeJSON,
+			Type:   field.TypeOther,
 			Value:  value,
-			Column: invalidfieldmessage.FieldJSON,
+			Column: invalidfieldmessage.FieldOther,
 		})
 	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ifmu.driver, _spec); err != nil {
@@ -136,9 +135,9 @@ type InvalidFieldMessageUpdateOne struct {
 	mutation *InvalidFieldMessageMutation
 }
 
-// SetJSON sets the "json" field.
-func (ifmuo *InvalidFieldMessageUpdateOne) SetJSON(sj *schema.SomeJSON) *InvalidFieldMessageUpdateOne {
-	ifmuo.mutation.SetJSON(sj)
+// SetOther sets the "other" field.
+func (ifmuo *InvalidFieldMessageUpdateOne) SetOther(ss *sql.NullString) *InvalidFieldMessageUpdateOne {
+	ifmuo.mutation.SetOther(ss)
 	return ifmuo
 }
 
@@ -243,11 +242,11 @@ func (ifmuo *InvalidFieldMessageUpdateOne) sqlSave(ctx context.Context) (_node *
 			}
 		}
 	}
-	if value, ok := ifmuo.mutation.JSON(); ok {
+	if value, ok := ifmuo.mutation.Other(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
-			Type:   field.TypeJSON,
+			Type:   field.TypeOther,
 			Value:  value,
-			Column: invalidfieldmessage.FieldJSON,
+			Column: invalidfieldmessage.FieldOther,
 		})
 	}
 	_node = &InvalidFieldMessage{config: ifmuo.config}
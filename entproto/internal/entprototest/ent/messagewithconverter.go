@@ -0,0 +1,110 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithconverter"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MessageWithConverter is the model entity for the MessageWithConverter schema.
+type MessageWithConverter struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Duration holds the value of the "duration" field.
+	Duration time.Duration `json:"duration,omitempty"`
+	// Other holds the value of the "other" field.
+	Other *sql.NullString `json:"other,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageWithConverter) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messagewithconverter.FieldID, messagewithconverter.FieldDuration:
+			values[i] = new(sql.NullInt64)
+		case messagewithconverter.FieldOther:
+			values[i] = new(sql.NullString)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type MessageWithConverter", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageWithConverter fields.
+func (mwc *MessageWithConverter) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messagewithconverter.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mwc.ID = int(value.Int64)
+		case messagewithconverter.FieldDuration:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration", values[i])
+			} else if value.Valid {
+				mwc.Duration = time.Duration(value.Int64)
+			}
+		case messagewithconverter.FieldOther:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field other", values[i])
+			} else if value.Valid {
+				mwc.Other = value
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this MessageWithConverter.
+// Note that you need to call MessageWithConverter.Unwrap() before calling this method if this MessageWithConverter
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mwc *MessageWithConverter) Update() *MessageWithConverterUpdateOne {
+	return (&MessageWithConverterClient{config: mwc.config}).UpdateOne(mwc)
+}
+
+// Unwrap unwraps the MessageWithConverter entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mwc *MessageWithConverter) Unwrap() *MessageWithConverter {
+	tx, ok := mwc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessageWithConverter is not a transactional entity")
+	}
+	mwc.config.driver = tx.drv
+	return mwc
+}
+
+// String implements the fmt.Stringer.
+func (mwc *MessageWithConverter) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageWithConverter(")
+	builder.WriteString(fmt.Sprintf("id=%v", mwc.ID))
+	builder.WriteString(", duration=")
+	builder.WriteString(fmt.Sprintf("%v", mwc.Duration))
+	builder.WriteString(", other=")
+	builder.WriteString(fmt.Sprintf("%v", mwc.Other))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageWithConverters is a parsable slice of MessageWithConverter.
+type MessageWithConverters []*MessageWithConverter
+
+func (mwc MessageWithConverters) config(cfg config) {
+	for _i := range mwc {
+		mwc[_i].config = cfg
+	}
+}
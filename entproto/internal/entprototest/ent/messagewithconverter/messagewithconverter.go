@@ -0,0 +1,33 @@
+// Code generated by entc, DO NOT EDIT.
+
+package messagewithconverter
+
+const (
+	// Label holds the string label denoting the messagewithconverter type in the database.
+	Label = "message_with_converter"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDuration holds the string denoting the duration field in the database.
+	FieldDuration = "duration"
+	// FieldOther holds the string denoting the other field in the database.
+	FieldOther = "other"
+	// Table holds the table name of the messagewithconverter in the database.
+	Table = "message_with_converters"
+)
+
+// Columns holds all SQL columns for messagewithconverter fields.
+var Columns = []string{
+	FieldID,
+	FieldDuration,
+	FieldOther,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
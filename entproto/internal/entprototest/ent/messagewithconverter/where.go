@@ -0,0 +1,298 @@
+// Code generated by entc, DO NOT EDIT.
+
+package messagewithconverter
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
+func Duration(v time.Duration) predicate.MessageWithConverter {
+	vc := int64(v)
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDuration), vc))
+	})
+}
+
+// Other applies equality check predicate on the "other" field. It's identical to OtherEQ.
+func Other(v *sql.NullString) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOther), v))
+	})
+}
+
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v time.Duration) predicate.MessageWithConverter {
+	vc := int64(v)
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDuration), vc))
+	})
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v time.Duration) predicate.MessageWithConverter {
+	vc := int64(v)
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDuration), vc))
+	})
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...time.Duration) predicate.MessageWithConverter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDuration), v...))
+	})
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...time.Duration) predicate.MessageWithConverter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDuration), v...))
+	})
+}
+
+// DurationGT applies the GT predicate on the "duration" field.
+func DurationGT(v time.Duration) predicate.MessageWithConverter {
+	vc := int64(v)
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDuration), vc))
+	})
+}
+
+// DurationGTE applies the GTE predicate on the "duration" field.
+func DurationGTE(v time.Duration) predicate.MessageWithConverter {
+	vc := int64(v)
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDuration), vc))
+	})
+}
+
+// DurationLT applies the LT predicate on the "duration" field.
+func DurationLT(v time.Duration) predicate.MessageWithConverter {
+	vc := int64(v)
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDuration), vc))
+	})
+}
+
+// DurationLTE applies the LTE predicate on the "duration" field.
+func DurationLTE(v time.Duration) predicate.MessageWithConverter {
+	vc := int64(v)
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDuration), vc))
+	})
+}
+
+// OtherEQ applies the EQ predicate on the "other" field.
+func OtherEQ(v *sql.NullString) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOther), v))
+	})
+}
+
+// OtherNEQ applies the NEQ predicate on the "other" field.
+func OtherNEQ(v *sql.NullString) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOther), v))
+	})
+}
+
+// OtherIn applies the In predicate on the "other" field.
+func OtherIn(vs ...*sql.NullString) predicate.MessageWithConverter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOther), v...))
+	})
+}
+
+// OtherNotIn applies the NotIn predicate on the "other" field.
+func OtherNotIn(vs ...*sql.NullString) predicate.MessageWithConverter {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOther), v...))
+	})
+}
+
+// OtherGT applies the GT predicate on the "other" field.
+func OtherGT(v *sql.NullString) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOther), v))
+	})
+}
+
+// OtherGTE applies the GTE predicate on the "other" field.
+func OtherGTE(v *sql.NullString) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOther), v))
+	})
+}
+
+// OtherLT applies the LT predicate on the "other" field.
+func OtherLT(v *sql.NullString) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOther), v))
+	})
+}
+
+// OtherLTE applies the LTE predicate on the "other" field.
+func OtherLTE(v *sql.NullString) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOther), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MessageWithConverter) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MessageWithConverter) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MessageWithConverter) predicate.MessageWithConverter {
+	return predicate.MessageWithConverter(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,239 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithconverter"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageWithConverterCreate is the builder for creating a MessageWithConverter entity.
+type MessageWithConverterCreate struct {
+	config
+	mutation *MessageWithConverterMutation
+	hooks    []Hook
+}
+
+// SetDuration sets the "duration" field.
+func (mwcc *MessageWithConverterCreate) SetDuration(t time.Duration) *MessageWithConverterCreate {
+	mwcc.mutation.SetDuration(t)
+	return mwcc
+}
+
+// SetOther sets the "other" field.
+func (mwcc *MessageWithConverterCreate) SetOther(ss *sql.NullString) *MessageWithConverterCreate {
+	mwcc.mutation.SetOther(ss)
+	return mwcc
+}
+
+// Mutation returns the MessageWithConverterMutation object of the builder.
+func (mwcc *MessageWithConverterCreate) Mutation() *MessageWithConverterMutation {
+	return mwcc.mutation
+}
+
+// Save creates the MessageWithConverter in the database.
+func (mwcc *MessageWithConverterCreate) Save(ctx context.Context) (*MessageWithConverter, error) {
+	var (
+		err  error
+		node *MessageWithConverter
+	)
+	if len(mwcc.hooks) == 0 {
+		if err = mwcc.check(); err != nil {
+			return nil, err
+		}
+		node, err = mwcc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MessageWithConverterMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mwcc.check(); err != nil {
+				return nil, err
+			}
+			mwcc.mutation = mutation
+			if node, err = mwcc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mwcc.hooks) - 1; i >= 0; i-- {
+			if mwcc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mwcc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mwcc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mwcc *MessageWithConverterCreate) SaveX(ctx context.Context) *MessageWithConverter {
+	v, err := mwcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mwcc *MessageWithConverterCreate) Exec(ctx context.Context) error {
+	_, err := mwcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwcc *MessageWithConverterCreate) ExecX(ctx context.Context) {
+	if err := mwcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mwcc *MessageWithConverterCreate) check() error {
+	if _, ok := mwcc.mutation.Duration(); !ok {
+		return &ValidationError{Name: "duration", err: errors.New(`ent: missing required field "duration"`)}
+	}
+	if _, ok := mwcc.mutation.Other(); !ok {
+		return &ValidationError{Name: "other", err: errors.New(`ent: missing required field "other"`)}
+	}
+	return nil
+}
+
+func (mwcc *MessageWithConverterCreate) sqlSave(ctx context.Context) (*MessageWithConverter, error) {
+	_node, _spec := mwcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mwcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (mwcc *MessageWithConverterCreate) createSpec() (*MessageWithConverter, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageWithConverter{config: mwcc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: messagewithconverter.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: messagewithconverter.FieldID,
+			},
+		}
+	)
+	if value, ok := mwcc.mutation.Duration(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: messagewithconverter.FieldDuration,
+		})
+		_node.Duration = value
+	}
+	if value, ok := mwcc.mutation.Other(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Value:  value,
+			Column: messagewithconverter.FieldOther,
+		})
+		_node.Other = value
+	}
+	return _node, _spec
+}
+
+// MessageWithConverterCreateBulk is the builder for creating many MessageWithConverter entities in bulk.
+type MessageWithConverterCreateBulk struct {
+	config
+	builders []*MessageWithConverterCreate
+}
+
+// Save creates the MessageWithConverter entities in the database.
+func (mwccb *MessageWithConverterCreateBulk) Save(ctx context.Context) ([]*MessageWithConverter, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(mwccb.builders))
+	nodes := make([]*MessageWithConverter, len(mwccb.builders))
+	mutators := make([]Mutator, len(mwccb.builders))
+	for i := range mwccb.builders {
+		func(i int, root context.Context) {
+			builder := mwccb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageWithConverterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mwccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mwccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mwccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwccb *MessageWithConverterCreateBulk) SaveX(ctx context.Context) []*MessageWithConverter {
+	v, err := mwccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mwccb *MessageWithConverterCreateBulk) Exec(ctx context.Context) error {
+	_, err := mwccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwccb *MessageWithConverterCreateBulk) ExecX(ctx context.Context) {
+	if err := mwccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithconverter"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageWithConverterDelete is the builder for deleting a MessageWithConverter entity.
+type MessageWithConverterDelete struct {
+	config
+	hooks    []Hook
+	mutation *MessageWithConverterMutation
+}
+
+// Where appends a list predicates to the MessageWithConverterDelete builder.
+func (mwcd *MessageWithConverterDelete) Where(ps ...predicate.MessageWithConverter) *MessageWithConverterDelete {
+	mwcd.mutation.Where(ps...)
+	return mwcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mwcd *MessageWithConverterDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mwcd.hooks) == 0 {
+		affected, err = mwcd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MessageWithConverterMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mwcd.mutation = mutation
+			affected, err = mwcd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mwcd.hooks) - 1; i >= 0; i-- {
+			if mwcd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mwcd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mwcd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwcd *MessageWithConverterDelete) ExecX(ctx context.Context) int {
+	n, err := mwcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mwcd *MessageWithConverterDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: messagewithconverter.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: messagewithconverter.FieldID,
+			},
+		},
+	}
+	if ps := mwcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, mwcd.driver, _spec)
+}
+
+// MessageWithConverterDeleteOne is the builder for deleting a single MessageWithConverter entity.
+type MessageWithConverterDeleteOne struct {
+	mwcd *MessageWithConverterDelete
+}
+
+// Exec executes the deletion query.
+func (mwcdo *MessageWithConverterDeleteOne) Exec(ctx context.Context) error {
+	n, err := mwcdo.mwcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{messagewithconverter.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwcdo *MessageWithConverterDeleteOne) ExecX(ctx context.Context) {
+	mwcdo.mwcd.ExecX(ctx)
+}
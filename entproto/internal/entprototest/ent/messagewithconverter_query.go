@@ -0,0 +1,913 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithconverter"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageWithConverterQuery is the builder for querying MessageWithConverter entities.
+type MessageWithConverterQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.MessageWithConverter
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MessageWithConverterQuery builder.
+func (mwcq *MessageWithConverterQuery) Where(ps ...predicate.MessageWithConverter) *MessageWithConverterQuery {
+	mwcq.predicates = append(mwcq.predicates, ps...)
+	return mwcq
+}
+
+// Limit adds a limit step to the query.
+func (mwcq *MessageWithConverterQuery) Limit(limit int) *MessageWithConverterQuery {
+	mwcq.limit = &limit
+	return mwcq
+}
+
+// Offset adds an offset step to the query.
+func (mwcq *MessageWithConverterQuery) Offset(offset int) *MessageWithConverterQuery {
+	mwcq.offset = &offset
+	return mwcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mwcq *MessageWithConverterQuery) Unique(unique bool) *MessageWithConverterQuery {
+	mwcq.unique = &unique
+	return mwcq
+}
+
+// Order adds an order step to the query.
+func (mwcq *MessageWithConverterQuery) Order(o ...OrderFunc) *MessageWithConverterQuery {
+	mwcq.order = append(mwcq.order, o...)
+	return mwcq
+}
+
+// First returns the first MessageWithConverter entity from the query.
+// Returns a *NotFoundError when no MessageWithConverter was found.
+func (mwcq *MessageWithConverterQuery) First(ctx context.Context) (*MessageWithConverter, error) {
+	nodes, err := mwcq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{messagewithconverter.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mwcq *MessageWithConverterQuery) FirstX(ctx context.Context) *MessageWithConverter {
+	node, err := mwcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MessageWithConverter ID from the query.
+// Returns a *NotFoundError when no MessageWithConverter ID was found.
+func (mwcq *MessageWithConverterQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mwcq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{messagewithconverter.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mwcq *MessageWithConverterQuery) FirstIDX(ctx context.Context) int {
+	id, err := mwcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MessageWithConverter entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one MessageWithConverter entity is not found.
+// Returns a *NotFoundError when no MessageWithConverter entities are found.
+func (mwcq *MessageWithConverterQuery) Only(ctx context.Context) (*MessageWithConverter, error) {
+	nodes, err := mwcq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{messagewithconverter.Label}
+	default:
+		return nil, &NotSingularError{messagewithconverter.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mwcq *MessageWithConverterQuery) OnlyX(ctx context.Context) *MessageWithConverter {
+	node, err := mwcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MessageWithConverter ID in the query.
+// Returns a *NotSingularError when exactly one MessageWithConverter ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (mwcq *MessageWithConverterQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mwcq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{messagewithconverter.Label}
+	default:
+		err = &NotSingularError{messagewithconverter.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mwcq *MessageWithConverterQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mwcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MessageWithConverters.
+func (mwcq *MessageWithConverterQuery) All(ctx context.Context) ([]*MessageWithConverter, error) {
+	if err := mwcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return mwcq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mwcq *MessageWithConverterQuery) AllX(ctx context.Context) []*MessageWithConverter {
+	nodes, err := mwcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MessageWithConverter IDs.
+func (mwcq *MessageWithConverterQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := mwcq.Select(messagewithconverter.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mwcq *MessageWithConverterQuery) IDsX(ctx context.Context) []int {
+	ids, err := mwcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mwcq *MessageWithConverterQuery) Count(ctx context.Context) (int, error) {
+	if err := mwcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return mwcq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mwcq *MessageWithConverterQuery) CountX(ctx context.Context) int {
+	count, err := mwcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mwcq *MessageWithConverterQuery) Exist(ctx context.Context) (bool, error) {
+	if err := mwcq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return mwcq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mwcq *MessageWithConverterQuery) ExistX(ctx context.Context) bool {
+	exist, err := mwcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MessageWithConverterQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mwcq *MessageWithConverterQuery) Clone() *MessageWithConverterQuery {
+	if mwcq == nil {
+		return nil
+	}
+	return &MessageWithConverterQuery{
+		config:     mwcq.config,
+		limit:      mwcq.limit,
+		offset:     mwcq.offset,
+		order:      append([]OrderFunc{}, mwcq.order...),
+		predicates: append([]predicate.MessageWithConverter{}, mwcq.predicates...),
+		// clone intermediate query.
+		sql:  mwcq.sql.Clone(),
+		path: mwcq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Duration time.Duration `json:"duration,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MessageWithConverter.Query().
+//		GroupBy(messagewithconverter.FieldDuration).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mwcq *MessageWithConverterQuery) GroupBy(field string, fields ...string) *MessageWithConverterGroupBy {
+	group := &MessageWithConverterGroupBy{config: mwcq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := mwcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return mwcq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Duration time.Duration `json:"duration,omitempty"`
+//	}
+//
+//	client.MessageWithConverter.Query().
+//		Select(messagewithconverter.FieldDuration).
+//		Scan(ctx, &v)
+func (mwcq *MessageWithConverterQuery) Select(fields ...string) *MessageWithConverterSelect {
+	mwcq.fields = append(mwcq.fields, fields...)
+	return &MessageWithConverterSelect{MessageWithConverterQuery: mwcq}
+}
+
+func (mwcq *MessageWithConverterQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range mwcq.fields {
+		if !messagewithconverter.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mwcq.path != nil {
+		prev, err := mwcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mwcq.sql = prev
+	}
+	return nil
+}
+
+func (mwcq *MessageWithConverterQuery) sqlAll(ctx context.Context) ([]*MessageWithConverter, error) {
+	var (
+		nodes = []*MessageWithConverter{}
+		_spec = mwcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &MessageWithConverter{config: mwcq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mwcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mwcq *MessageWithConverterQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mwcq.querySpec()
+	return sqlgraph.CountNodes(ctx, mwcq.driver, _spec)
+}
+
+func (mwcq *MessageWithConverterQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := mwcq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (mwcq *MessageWithConverterQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   messagewithconverter.Table,
+			Columns: messagewithconverter.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: messagewithconverter.FieldID,
+			},
+		},
+		From:   mwcq.sql,
+		Unique: true,
+	}
+	if unique := mwcq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := mwcq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagewithconverter.FieldID)
+		for i := range fields {
+			if fields[i] != messagewithconverter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mwcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mwcq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mwcq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mwcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mwcq *MessageWithConverterQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mwcq.driver.Dialect())
+	t1 := builder.Table(messagewithconverter.Table)
+	columns := mwcq.fields
+	if len(columns) == 0 {
+		columns = messagewithconverter.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mwcq.sql != nil {
+		selector = mwcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, p := range mwcq.predicates {
+		p(selector)
+	}
+	for _, p := range mwcq.order {
+		p(selector)
+	}
+	if offset := mwcq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mwcq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MessageWithConverterGroupBy is the group-by builder for MessageWithConverter entities.
+type MessageWithConverterGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mwcgb *MessageWithConverterGroupBy) Aggregate(fns ...AggregateFunc) *MessageWithConverterGroupBy {
+	mwcgb.fns = append(mwcgb.fns, fns...)
+	return mwcgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (mwcgb *MessageWithConverterGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := mwcgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	mwcgb.sql = query
+	return mwcgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (mwcgb *MessageWithConverterGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := mwcgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mwcgb *MessageWithConverterGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(mwcgb.fields) > 1 {
+		return nil, errors.New("ent: MessageWithConverterGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := mwcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (mwcgb *MessageWithConverterGroupBy) StringsX(ctx context.Context) []string {
+	v, err := mwcgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mwcgb *MessageWithConverterGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = mwcgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithconverter.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithConverterGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (mwcgb *MessageWithConverterGroupBy) StringX(ctx context.Context) string {
+	v, err := mwcgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mwcgb *MessageWithConverterGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(mwcgb.fields) > 1 {
+		return nil, errors.New("ent: MessageWithConverterGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := mwcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (mwcgb *MessageWithConverterGroupBy) IntsX(ctx context.Context) []int {
+	v, err := mwcgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mwcgb *MessageWithConverterGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = mwcgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithconverter.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithConverterGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (mwcgb *MessageWithConverterGroupBy) IntX(ctx context.Context) int {
+	v, err := mwcgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mwcgb *MessageWithConverterGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(mwcgb.fields) > 1 {
+		return nil, errors.New("ent: MessageWithConverterGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := mwcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (mwcgb *MessageWithConverterGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := mwcgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mwcgb *MessageWithConverterGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = mwcgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithconverter.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithConverterGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (mwcgb *MessageWithConverterGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := mwcgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mwcgb *MessageWithConverterGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(mwcgb.fields) > 1 {
+		return nil, errors.New("ent: MessageWithConverterGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := mwcgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (mwcgb *MessageWithConverterGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := mwcgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mwcgb *MessageWithConverterGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = mwcgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithconverter.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithConverterGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (mwcgb *MessageWithConverterGroupBy) BoolX(ctx context.Context) bool {
+	v, err := mwcgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (mwcgb *MessageWithConverterGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range mwcgb.fields {
+		if !messagewithconverter.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := mwcgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mwcgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (mwcgb *MessageWithConverterGroupBy) sqlQuery() *sql.Selector {
+	selector := mwcgb.sql.Select()
+	aggregation := make([]string, 0, len(mwcgb.fns))
+	for _, fn := range mwcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(mwcgb.fields)+len(mwcgb.fns))
+		for _, f := range mwcgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(mwcgb.fields...)...)
+}
+
+// MessageWithConverterSelect is the builder for selecting fields of MessageWithConverter entities.
+type MessageWithConverterSelect struct {
+	*MessageWithConverterQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mwcs *MessageWithConverterSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := mwcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	mwcs.sql = mwcs.MessageWithConverterQuery.sqlQuery(ctx)
+	return mwcs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (mwcs *MessageWithConverterSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := mwcs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (mwcs *MessageWithConverterSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(mwcs.fields) > 1 {
+		return nil, errors.New("ent: MessageWithConverterSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := mwcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (mwcs *MessageWithConverterSelect) StringsX(ctx context.Context) []string {
+	v, err := mwcs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (mwcs *MessageWithConverterSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = mwcs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithconverter.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithConverterSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (mwcs *MessageWithConverterSelect) StringX(ctx context.Context) string {
+	v, err := mwcs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (mwcs *MessageWithConverterSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(mwcs.fields) > 1 {
+		return nil, errors.New("ent: MessageWithConverterSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := mwcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (mwcs *MessageWithConverterSelect) IntsX(ctx context.Context) []int {
+	v, err := mwcs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (mwcs *MessageWithConverterSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = mwcs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithconverter.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithConverterSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (mwcs *MessageWithConverterSelect) IntX(ctx context.Context) int {
+	v, err := mwcs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (mwcs *MessageWithConverterSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(mwcs.fields) > 1 {
+		return nil, errors.New("ent: MessageWithConverterSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := mwcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (mwcs *MessageWithConverterSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := mwcs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (mwcs *MessageWithConverterSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = mwcs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithconverter.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithConverterSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (mwcs *MessageWithConverterSelect) Float64X(ctx context.Context) float64 {
+	v, err := mwcs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (mwcs *MessageWithConverterSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(mwcs.fields) > 1 {
+		return nil, errors.New("ent: MessageWithConverterSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := mwcs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (mwcs *MessageWithConverterSelect) BoolsX(ctx context.Context) []bool {
+	v, err := mwcs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (mwcs *MessageWithConverterSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = mwcs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithconverter.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithConverterSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (mwcs *MessageWithConverterSelect) BoolX(ctx context.Context) bool {
+	v, err := mwcs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (mwcs *MessageWithConverterSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := mwcs.sql.Query()
+	if err := mwcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
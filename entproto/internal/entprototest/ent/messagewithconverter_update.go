@@ -0,0 +1,319 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithconverter"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageWithConverterUpdate is the builder for updating MessageWithConverter entities.
+type MessageWithConverterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageWithConverterMutation
+}
+
+// Where appends a list predicates to the MessageWithConverterUpdate builder.
+func (mwcu *MessageWithConverterUpdate) Where(ps ...predicate.MessageWithConverter) *MessageWithConverterUpdate {
+	mwcu.mutation.Where(ps...)
+	return mwcu
+}
+
+// SetDuration sets the "duration" field.
+func (mwcu *MessageWithConverterUpdate) SetDuration(t time.Duration) *MessageWithConverterUpdate {
+	mwcu.mutation.ResetDuration()
+	mwcu.mutation.SetDuration(t)
+	return mwcu
+}
+
+// AddDuration adds t to the "duration" field.
+func (mwcu *MessageWithConverterUpdate) AddDuration(t time.Duration) *MessageWithConverterUpdate {
+	mwcu.mutation.AddDuration(t)
+	return mwcu
+}
+
+// SetOther sets the "other" field.
+func (mwcu *MessageWithConverterUpdate) SetOther(ss *sql.NullString) *MessageWithConverterUpdate {
+	mwcu.mutation.SetOther(ss)
+	return mwcu
+}
+
+// Mutation returns the MessageWithConverterMutation object of the builder.
+func (mwcu *MessageWithConverterUpdate) Mutation() *MessageWithConverterMutation {
+	return mwcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mwcu *MessageWithConverterUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mwcu.hooks) == 0 {
+		affected, err = mwcu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MessageWithConverterMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mwcu.mutation = mutation
+			affected, err = mwcu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mwcu.hooks) - 1; i >= 0; i-- {
+			if mwcu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mwcu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mwcu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwcu *MessageWithConverterUpdate) SaveX(ctx context.Context) int {
+	affected, err := mwcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mwcu *MessageWithConverterUpdate) Exec(ctx context.Context) error {
+	_, err := mwcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwcu *MessageWithConverterUpdate) ExecX(ctx context.Context) {
+	if err := mwcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mwcu *MessageWithConverterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   messagewithconverter.Table,
+			Columns: messagewithconverter.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: messagewithconverter.FieldID,
+			},
+		},
+	}
+	if ps := mwcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mwcu.mutation.Duration(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: messagewithconverter.FieldDuration,
+		})
+	}
+	if value, ok := mwcu.mutation.AddedDuration(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: messagewithconverter.FieldDuration,
+		})
+	}
+	if value, ok := mwcu.mutation.Other(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Value:  value,
+			Column: messagewithconverter.FieldOther,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mwcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagewithconverter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// MessageWithConverterUpdateOne is the builder for updating a single MessageWithConverter entity.
+type MessageWithConverterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageWithConverterMutation
+}
+
+// SetDuration sets the "duration" field.
+func (mwcuo *MessageWithConverterUpdateOne) SetDuration(t time.Duration) *MessageWithConverterUpdateOne {
+	mwcuo.mutation.ResetDuration()
+	mwcuo.mutation.SetDuration(t)
+	return mwcuo
+}
+
+// AddDuration adds t to the "duration" field.
+func (mwcuo *MessageWithConverterUpdateOne) AddDuration(t time.Duration) *MessageWithConverterUpdateOne {
+	mwcuo.mutation.AddDuration(t)
+	return mwcuo
+}
+
+// SetOther sets the "other" field.
+func (mwcuo *MessageWithConverterUpdateOne) SetOther(ss *sql.NullString) *MessageWithConverterUpdateOne {
+	mwcuo.mutation.SetOther(ss)
+	return mwcuo
+}
+
+// Mutation returns the MessageWithConverterMutation object of the builder.
+func (mwcuo *MessageWithConverterUpdateOne) Mutation() *MessageWithConverterMutation {
+	return mwcuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mwcuo *MessageWithConverterUpdateOne) Select(field string, fields ...string) *MessageWithConverterUpdateOne {
+	mwcuo.fields = append([]string{field}, fields...)
+	return mwcuo
+}
+
+// Save executes the query and returns the updated MessageWithConverter entity.
+func (mwcuo *MessageWithConverterUpdateOne) Save(ctx context.Context) (*MessageWithConverter, error) {
+	var (
+		err  error
+		node *MessageWithConverter
+	)
+	if len(mwcuo.hooks) == 0 {
+		node, err = mwcuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MessageWithConverterMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mwcuo.mutation = mutation
+			node, err = mwcuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mwcuo.hooks) - 1; i >= 0; i-- {
+			if mwcuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mwcuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mwcuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwcuo *MessageWithConverterUpdateOne) SaveX(ctx context.Context) *MessageWithConverter {
+	node, err := mwcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mwcuo *MessageWithConverterUpdateOne) Exec(ctx context.Context) error {
+	_, err := mwcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwcuo *MessageWithConverterUpdateOne) ExecX(ctx context.Context) {
+	if err := mwcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mwcuo *MessageWithConverterUpdateOne) sqlSave(ctx context.Context) (_node *MessageWithConverter, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   messagewithconverter.Table,
+			Columns: messagewithconverter.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: messagewithconverter.FieldID,
+			},
+		},
+	}
+	id, ok := mwcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing MessageWithConverter.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mwcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagewithconverter.FieldID)
+		for _, f := range fields {
+			if !messagewithconverter.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagewithconverter.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mwcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mwcuo.mutation.Duration(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: messagewithconverter.FieldDuration,
+		})
+	}
+	if value, ok := mwcuo.mutation.AddedDuration(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: messagewithconverter.FieldDuration,
+		})
+	}
+	if value, ok := mwcuo.mutation.Other(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Value:  value,
+			Column: messagewithconverter.FieldOther,
+		})
+	}
+	_node = &MessageWithConverter{config: mwcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mwcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagewithconverter.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
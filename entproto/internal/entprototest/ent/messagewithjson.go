@@ -0,0 +1,188 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"encoding/json/jsontext"
+	"fmt"
+	"strings"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithjson"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/schema"
+	"entgo.io/ent/dialect/sql"
+)
+
+// MessageWithJSON is the model entity for the MessageWithJSON schema.
+type MessageWithJSON struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Strings holds the value of the "strings" field.
+	Strings []string `json:"strings,omitempty"`
+	// Ints holds the value of the "ints" field.
+	Ints []int `json:"ints,omitempty"`
+	// Floats holds the value of the "floats" field.
+	Floats []float64 `json:"floats,omitempty"`
+	// Object holds the value of the "object" field.
+	Object map[string]interface{} `json:"object,omitempty"`
+	// List holds the value of the "list" field.
+	List []schema.SomeJSON `json:"list,omitempty"`
+	// Value holds the value of the "value" field.
+	Value *schema.SomeJSON `json:"value,omitempty"`
+	// Raw holds the value of the "raw" field.
+	Raw jsontext.Value `json:"raw,omitempty"`
+	// Named holds the value of the "named" field.
+	Named *schema.Address `json:"named,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageWithJSON) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messagewithjson.FieldStrings, messagewithjson.FieldInts, messagewithjson.FieldFloats, messagewithjson.FieldObject, messagewithjson.FieldList, messagewithjson.FieldValue, messagewithjson.FieldRaw, messagewithjson.FieldNamed:
+			values[i] = new([]byte)
+		case messagewithjson.FieldID:
+			values[i] = new(sql.NullInt64)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type MessageWithJSON", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageWithJSON fields.
+func (mwj *MessageWithJSON) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messagewithjson.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mwj.ID = int(value.Int64)
+		case messagewithjson.FieldStrings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field strings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mwj.Strings); err != nil {
+					return fmt.Errorf("unmarshal field strings: %w", err)
+				}
+			}
+		case messagewithjson.FieldInts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ints", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mwj.Ints); err != nil {
+					return fmt.Errorf("unmarshal field ints: %w", err)
+				}
+			}
+		case messagewithjson.FieldFloats:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field floats", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mwj.Floats); err != nil {
+					return fmt.Errorf("unmarshal field floats: %w", err)
+				}
+			}
+		case messagewithjson.FieldObject:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field object", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mwj.Object); err != nil {
+					return fmt.Errorf("unmarshal field object: %w", err)
+				}
+			}
+		case messagewithjson.FieldList:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field list", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mwj.List); err != nil {
+					return fmt.Errorf("unmarshal field list: %w", err)
+				}
+			}
+		case messagewithjson.FieldValue:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mwj.Value); err != nil {
+					return fmt.Errorf("unmarshal field value: %w", err)
+				}
+			}
+		case messagewithjson.FieldRaw:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field raw", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mwj.Raw); err != nil {
+					return fmt.Errorf("unmarshal field raw: %w", err)
+				}
+			}
+		case messagewithjson.FieldNamed:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field named", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mwj.Named); err != nil {
+					return fmt.Errorf("unmarshal field named: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this MessageWithJSON.
+// Note that you need to call MessageWithJSON.Unwrap() before calling this method if this MessageWithJSON
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mwj *MessageWithJSON) Update() *MessageWithJSONUpdateOne {
+	return (&MessageWithJSONClient{config: mwj.config}).UpdateOne(mwj)
+}
+
+// Unwrap unwraps the MessageWithJSON entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mwj *MessageWithJSON) Unwrap() *MessageWithJSON {
+	tx, ok := mwj.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessageWithJSON is not a transactional entity")
+	}
+	mwj.config.driver = tx.drv
+	return mwj
+}
+
+// String implements the fmt.Stringer.
+func (mwj *MessageWithJSON) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageWithJSON(")
+	builder.WriteString(fmt.Sprintf("id=%v", mwj.ID))
+	builder.WriteString(", strings=")
+	builder.WriteString(fmt.Sprintf("%v", mwj.Strings))
+	builder.WriteString(", ints=")
+	builder.WriteString(fmt.Sprintf("%v", mwj.Ints))
+	builder.WriteString(", floats=")
+	builder.WriteString(fmt.Sprintf("%v", mwj.Floats))
+	builder.WriteString(", object=")
+	builder.WriteString(fmt.Sprintf("%v", mwj.Object))
+	builder.WriteString(", list=")
+	builder.WriteString(fmt.Sprintf("%v", mwj.List))
+	builder.WriteString(", value=")
+	builder.WriteString(fmt.Sprintf("%v", mwj.Value))
+	builder.WriteString(", raw=")
+	builder.WriteString(fmt.Sprintf("%v", mwj.Raw))
+	builder.WriteString(", named=")
+	builder.WriteString(fmt.Sprintf("%v", mwj.Named))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageWithJSONs is a parsable slice of MessageWithJSON.
+type MessageWithJSONs []*MessageWithJSON
+
+func (mwj MessageWithJSONs) config(cfg config) {
+	for _i := range mwj {
+		mwj[_i].config = cfg
+	}
+}
@@ -0,0 +1,51 @@
+// Code generated by entc, DO NOT EDIT.
+
+package messagewithjson
+
+const (
+	// Label holds the string label denoting the messagewithjson type in the database.
+	Label = "message_with_json"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStrings holds the string denoting the strings field in the database.
+	FieldStrings = "strings"
+	// FieldInts holds the string denoting the ints field in the database.
+	FieldInts = "ints"
+	// FieldFloats holds the string denoting the floats field in the database.
+	FieldFloats = "floats"
+	// FieldObject holds the string denoting the object field in the database.
+	FieldObject = "object"
+	// FieldList holds the string denoting the list field in the database.
+	FieldList = "list"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldRaw holds the string denoting the raw field in the database.
+	FieldRaw = "raw"
+	// FieldNamed holds the string denoting the named field in the database.
+	FieldNamed = "named"
+	// Table holds the table name of the messagewithjson in the database.
+	Table = "message_with_jso_ns"
+)
+
+// Columns holds all SQL columns for messagewithjson fields.
+var Columns = []string{
+	FieldID,
+	FieldStrings,
+	FieldInts,
+	FieldFloats,
+	FieldObject,
+	FieldList,
+	FieldValue,
+	FieldRaw,
+	FieldNamed,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
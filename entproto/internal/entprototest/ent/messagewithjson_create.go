@@ -0,0 +1,338 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithjson"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/schema"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageWithJSONCreate is the builder for creating a MessageWithJSON entity.
+type MessageWithJSONCreate struct {
+	config
+	mutation *MessageWithJSONMutation
+	hooks    []Hook
+}
+
+// SetStrings sets the "strings" field.
+func (mwjc *MessageWithJSONCreate) SetStrings(s []string) *MessageWithJSONCreate {
+	mwjc.mutation.SetStrings(s)
+	return mwjc
+}
+
+// SetInts sets the "ints" field.
+func (mwjc *MessageWithJSONCreate) SetInts(i []int) *MessageWithJSONCreate {
+	mwjc.mutation.SetInts(i)
+	return mwjc
+}
+
+// SetFloats sets the "floats" field.
+func (mwjc *MessageWithJSONCreate) SetFloats(f []float64) *MessageWithJSONCreate {
+	mwjc.mutation.SetFloats(f)
+	return mwjc
+}
+
+// SetObject sets the "object" field.
+func (mwjc *MessageWithJSONCreate) SetObject(m map[string]interface{}) *MessageWithJSONCreate {
+	mwjc.mutation.SetObject(m)
+	return mwjc
+}
+
+// SetList sets the "list" field.
+func (mwjc *MessageWithJSONCreate) SetList(sj []schema.SomeJSON) *MessageWithJSONCreate {
+	mwjc.mutation.SetList(sj)
+	return mwjc
+}
+
+// SetValue sets the "value" field.
+func (mwjc *MessageWithJSONCreate) SetValue(sj *schema.SomeJSON) *MessageWithJSONCreate {
+	mwjc.mutation.SetValue(sj)
+	return mwjc
+}
+
+// SetRaw sets the "raw" field.
+func (mwjc *MessageWithJSONCreate) SetRaw(j jsontext.Value) *MessageWithJSONCreate {
+	mwjc.mutation.SetRaw(j)
+	return mwjc
+}
+
+// SetNamed sets the "named" field.
+func (mwjc *MessageWithJSONCreate) SetNamed(s *schema.Address) *MessageWithJSONCreate {
+	mwjc.mutation.SetNamed(s)
+	return mwjc
+}
+
+// Mutation returns the MessageWithJSONMutation object of the builder.
+func (mwjc *MessageWithJSONCreate) Mutation() *MessageWithJSONMutation {
+	return mwjc.mutation
+}
+
+// Save creates the MessageWithJSON in the database.
+func (mwjc *MessageWithJSONCreate) Save(ctx context.Context) (*MessageWithJSON, error) {
+	var (
+		err  error
+		node *MessageWithJSON
+	)
+	if len(mwjc.hooks) == 0 {
+		if err = mwjc.check(); err != nil {
+			return nil, err
+		}
+		node, err = mwjc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MessageWithJSONMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mwjc.check(); err != nil {
+				return nil, err
+			}
+			mwjc.mutation = mutation
+			if node, err = mwjc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mwjc.hooks) - 1; i >= 0; i-- {
+			if mwjc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mwjc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mwjc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mwjc *MessageWithJSONCreate) SaveX(ctx context.Context) *MessageWithJSON {
+	v, err := mwjc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mwjc *MessageWithJSONCreate) Exec(ctx context.Context) error {
+	_, err := mwjc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwjc *MessageWithJSONCreate) ExecX(ctx context.Context) {
+	if err := mwjc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mwjc *MessageWithJSONCreate) check() error {
+	if _, ok := mwjc.mutation.Strings(); !ok {
+		return &ValidationError{Name: "strings", err: errors.New(`ent: missing required field "strings"`)}
+	}
+	if _, ok := mwjc.mutation.Ints(); !ok {
+		return &ValidationError{Name: "ints", err: errors.New(`ent: missing required field "ints"`)}
+	}
+	if _, ok := mwjc.mutation.Object(); !ok {
+		return &ValidationError{Name: "object", err: errors.New(`ent: missing required field "object"`)}
+	}
+	if _, ok := mwjc.mutation.List(); !ok {
+		return &ValidationError{Name: "list", err: errors.New(`ent: missing required field "list"`)}
+	}
+	if _, ok := mwjc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "value"`)}
+	}
+	if _, ok := mwjc.mutation.Raw(); !ok {
+		return &ValidationError{Name: "raw", err: errors.New(`ent: missing required field "raw"`)}
+	}
+	if _, ok := mwjc.mutation.Named(); !ok {
+		return &ValidationError{Name: "named", err: errors.New(`ent: missing required field "named"`)}
+	}
+	return nil
+}
+
+func (mwjc *MessageWithJSONCreate) sqlSave(ctx context.Context) (*MessageWithJSON, error) {
+	_node, _spec := mwjc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mwjc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (mwjc *MessageWithJSONCreate) createSpec() (*MessageWithJSON, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageWithJSON{config: mwjc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: messagewithjson.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: messagewithjson.FieldID,
+			},
+		}
+	)
+	if value, ok := mwjc.mutation.Strings(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldStrings,
+		})
+		_node.Strings = value
+	}
+	if value, ok := mwjc.mutation.Ints(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldInts,
+		})
+		_node.Ints = value
+	}
+	if value, ok := mwjc.mutation.Floats(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldFloats,
+		})
+		_node.Floats = value
+	}
+	if value, ok := mwjc.mutation.Object(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldObject,
+		})
+		_node.Object = value
+	}
+	if value, ok := mwjc.mutation.List(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldList,
+		})
+		_node.List = value
+	}
+	if value, ok := mwjc.mutation.Value(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldValue,
+		})
+		_node.Value = value
+	}
+	if value, ok := mwjc.mutation.Raw(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldRaw,
+		})
+		_node.Raw = value
+	}
+	if value, ok := mwjc.mutation.Named(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldNamed,
+		})
+		_node.Named = value
+	}
+	return _node, _spec
+}
+
+// MessageWithJSONCreateBulk is the builder for creating many MessageWithJSON entities in bulk.
+type MessageWithJSONCreateBulk struct {
+	config
+	builders []*MessageWithJSONCreate
+}
+
+// Save creates the MessageWithJSON entities in the database.
+func (mwjcb *MessageWithJSONCreateBulk) Save(ctx context.Context) ([]*MessageWithJSON, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(mwjcb.builders))
+	nodes := make([]*MessageWithJSON, len(mwjcb.builders))
+	mutators := make([]Mutator, len(mwjcb.builders))
+	for i := range mwjcb.builders {
+		func(i int, root context.Context) {
+			builder := mwjcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageWithJSONMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mwjcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mwjcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mwjcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwjcb *MessageWithJSONCreateBulk) SaveX(ctx context.Context) []*MessageWithJSON {
+	v, err := mwjcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mwjcb *MessageWithJSONCreateBulk) Exec(ctx context.Context) error {
+	_, err := mwjcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwjcb *MessageWithJSONCreateBulk) ExecX(ctx context.Context) {
+	if err := mwjcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
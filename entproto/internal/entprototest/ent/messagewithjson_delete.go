@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithjson"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageWithJSONDelete is the builder for deleting a MessageWithJSON entity.
+type MessageWithJSONDelete struct {
+	config
+	hooks    []Hook
+	mutation *MessageWithJSONMutation
+}
+
+// Where appends a list predicates to the MessageWithJSONDelete builder.
+func (mwjd *MessageWithJSONDelete) Where(ps ...predicate.MessageWithJSON) *MessageWithJSONDelete {
+	mwjd.mutation.Where(ps...)
+	return mwjd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mwjd *MessageWithJSONDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mwjd.hooks) == 0 {
+		affected, err = mwjd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MessageWithJSONMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mwjd.mutation = mutation
+			affected, err = mwjd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mwjd.hooks) - 1; i >= 0; i-- {
+			if mwjd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mwjd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mwjd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwjd *MessageWithJSONDelete) ExecX(ctx context.Context) int {
+	n, err := mwjd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mwjd *MessageWithJSONDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: messagewithjson.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: messagewithjson.FieldID,
+			},
+		},
+	}
+	if ps := mwjd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, mwjd.driver, _spec)
+}
+
+// MessageWithJSONDeleteOne is the builder for deleting a single MessageWithJSON entity.
+type MessageWithJSONDeleteOne struct {
+	mwjd *MessageWithJSONDelete
+}
+
+// Exec executes the deletion query.
+func (mwjdo *MessageWithJSONDeleteOne) Exec(ctx context.Context) error {
+	n, err := mwjdo.mwjd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{messagewithjson.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwjdo *MessageWithJSONDeleteOne) ExecX(ctx context.Context) {
+	mwjdo.mwjd.ExecX(ctx)
+}
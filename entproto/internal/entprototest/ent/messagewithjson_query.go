@@ -0,0 +1,913 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithjson"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageWithJSONQuery is the builder for querying MessageWithJSON entities.
+type MessageWithJSONQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.MessageWithJSON
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MessageWithJSONQuery builder.
+func (mwjq *MessageWithJSONQuery) Where(ps ...predicate.MessageWithJSON) *MessageWithJSONQuery {
+	mwjq.predicates = append(mwjq.predicates, ps...)
+	return mwjq
+}
+
+// Limit adds a limit step to the query.
+func (mwjq *MessageWithJSONQuery) Limit(limit int) *MessageWithJSONQuery {
+	mwjq.limit = &limit
+	return mwjq
+}
+
+// Offset adds an offset step to the query.
+func (mwjq *MessageWithJSONQuery) Offset(offset int) *MessageWithJSONQuery {
+	mwjq.offset = &offset
+	return mwjq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mwjq *MessageWithJSONQuery) Unique(unique bool) *MessageWithJSONQuery {
+	mwjq.unique = &unique
+	return mwjq
+}
+
+// Order adds an order step to the query.
+func (mwjq *MessageWithJSONQuery) Order(o ...OrderFunc) *MessageWithJSONQuery {
+	mwjq.order = append(mwjq.order, o...)
+	return mwjq
+}
+
+// First returns the first MessageWithJSON entity from the query.
+// Returns a *NotFoundError when no MessageWithJSON was found.
+func (mwjq *MessageWithJSONQuery) First(ctx context.Context) (*MessageWithJSON, error) {
+	nodes, err := mwjq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{messagewithjson.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mwjq *MessageWithJSONQuery) FirstX(ctx context.Context) *MessageWithJSON {
+	node, err := mwjq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MessageWithJSON ID from the query.
+// Returns a *NotFoundError when no MessageWithJSON ID was found.
+func (mwjq *MessageWithJSONQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mwjq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{messagewithjson.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mwjq *MessageWithJSONQuery) FirstIDX(ctx context.Context) int {
+	id, err := mwjq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MessageWithJSON entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one MessageWithJSON entity is not found.
+// Returns a *NotFoundError when no MessageWithJSON entities are found.
+func (mwjq *MessageWithJSONQuery) Only(ctx context.Context) (*MessageWithJSON, error) {
+	nodes, err := mwjq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{messagewithjson.Label}
+	default:
+		return nil, &NotSingularError{messagewithjson.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mwjq *MessageWithJSONQuery) OnlyX(ctx context.Context) *MessageWithJSON {
+	node, err := mwjq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MessageWithJSON ID in the query.
+// Returns a *NotSingularError when exactly one MessageWithJSON ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (mwjq *MessageWithJSONQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mwjq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{messagewithjson.Label}
+	default:
+		err = &NotSingularError{messagewithjson.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mwjq *MessageWithJSONQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mwjq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MessageWithJSONs.
+func (mwjq *MessageWithJSONQuery) All(ctx context.Context) ([]*MessageWithJSON, error) {
+	if err := mwjq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return mwjq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mwjq *MessageWithJSONQuery) AllX(ctx context.Context) []*MessageWithJSON {
+	nodes, err := mwjq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MessageWithJSON IDs.
+func (mwjq *MessageWithJSONQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := mwjq.Select(messagewithjson.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mwjq *MessageWithJSONQuery) IDsX(ctx context.Context) []int {
+	ids, err := mwjq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mwjq *MessageWithJSONQuery) Count(ctx context.Context) (int, error) {
+	if err := mwjq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return mwjq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mwjq *MessageWithJSONQuery) CountX(ctx context.Context) int {
+	count, err := mwjq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mwjq *MessageWithJSONQuery) Exist(ctx context.Context) (bool, error) {
+	if err := mwjq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return mwjq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mwjq *MessageWithJSONQuery) ExistX(ctx context.Context) bool {
+	exist, err := mwjq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MessageWithJSONQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mwjq *MessageWithJSONQuery) Clone() *MessageWithJSONQuery {
+	if mwjq == nil {
+		return nil
+	}
+	return &MessageWithJSONQuery{
+		config:     mwjq.config,
+		limit:      mwjq.limit,
+		offset:     mwjq.offset,
+		order:      append([]OrderFunc{}, mwjq.order...),
+		predicates: append([]predicate.MessageWithJSON{}, mwjq.predicates...),
+		// clone intermediate query.
+		sql:  mwjq.sql.Clone(),
+		path: mwjq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Strings []string `json:"strings,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MessageWithJSON.Query().
+//		GroupBy(messagewithjson.FieldStrings).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mwjq *MessageWithJSONQuery) GroupBy(field string, fields ...string) *MessageWithJSONGroupBy {
+	group := &MessageWithJSONGroupBy{config: mwjq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := mwjq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return mwjq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Strings []string `json:"strings,omitempty"`
+//	}
+//
+//	client.MessageWithJSON.Query().
+//		Select(messagewithjson.FieldStrings).
+//		Scan(ctx, &v)
+func (mwjq *MessageWithJSONQuery) Select(fields ...string) *MessageWithJSONSelect {
+	mwjq.fields = append(mwjq.fields, fields...)
+	return &MessageWithJSONSelect{MessageWithJSONQuery: mwjq}
+}
+
+func (mwjq *MessageWithJSONQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range mwjq.fields {
+		if !messagewithjson.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mwjq.path != nil {
+		prev, err := mwjq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mwjq.sql = prev
+	}
+	return nil
+}
+
+func (mwjq *MessageWithJSONQuery) sqlAll(ctx context.Context) ([]*MessageWithJSON, error) {
+	var (
+		nodes = []*MessageWithJSON{}
+		_spec = mwjq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &MessageWithJSON{config: mwjq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mwjq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mwjq *MessageWithJSONQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mwjq.querySpec()
+	return sqlgraph.CountNodes(ctx, mwjq.driver, _spec)
+}
+
+func (mwjq *MessageWithJSONQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := mwjq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (mwjq *MessageWithJSONQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   messagewithjson.Table,
+			Columns: messagewithjson.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: messagewithjson.FieldID,
+			},
+		},
+		From:   mwjq.sql,
+		Unique: true,
+	}
+	if unique := mwjq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := mwjq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagewithjson.FieldID)
+		for i := range fields {
+			if fields[i] != messagewithjson.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mwjq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mwjq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mwjq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mwjq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mwjq *MessageWithJSONQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mwjq.driver.Dialect())
+	t1 := builder.Table(messagewithjson.Table)
+	columns := mwjq.fields
+	if len(columns) == 0 {
+		columns = messagewithjson.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mwjq.sql != nil {
+		selector = mwjq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, p := range mwjq.predicates {
+		p(selector)
+	}
+	for _, p := range mwjq.order {
+		p(selector)
+	}
+	if offset := mwjq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mwjq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MessageWithJSONGroupBy is the group-by builder for MessageWithJSON entities.
+type MessageWithJSONGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mwjgb *MessageWithJSONGroupBy) Aggregate(fns ...AggregateFunc) *MessageWithJSONGroupBy {
+	mwjgb.fns = append(mwjgb.fns, fns...)
+	return mwjgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (mwjgb *MessageWithJSONGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := mwjgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	mwjgb.sql = query
+	return mwjgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (mwjgb *MessageWithJSONGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := mwjgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mwjgb *MessageWithJSONGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(mwjgb.fields) > 1 {
+		return nil, errors.New("ent: MessageWithJSONGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := mwjgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (mwjgb *MessageWithJSONGroupBy) StringsX(ctx context.Context) []string {
+	v, err := mwjgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mwjgb *MessageWithJSONGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = mwjgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithjson.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithJSONGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (mwjgb *MessageWithJSONGroupBy) StringX(ctx context.Context) string {
+	v, err := mwjgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mwjgb *MessageWithJSONGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(mwjgb.fields) > 1 {
+		return nil, errors.New("ent: MessageWithJSONGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := mwjgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (mwjgb *MessageWithJSONGroupBy) IntsX(ctx context.Context) []int {
+	v, err := mwjgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mwjgb *MessageWithJSONGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = mwjgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithjson.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithJSONGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (mwjgb *MessageWithJSONGroupBy) IntX(ctx context.Context) int {
+	v, err := mwjgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mwjgb *MessageWithJSONGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(mwjgb.fields) > 1 {
+		return nil, errors.New("ent: MessageWithJSONGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := mwjgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (mwjgb *MessageWithJSONGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := mwjgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mwjgb *MessageWithJSONGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = mwjgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithjson.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithJSONGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (mwjgb *MessageWithJSONGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := mwjgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mwjgb *MessageWithJSONGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(mwjgb.fields) > 1 {
+		return nil, errors.New("ent: MessageWithJSONGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := mwjgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (mwjgb *MessageWithJSONGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := mwjgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mwjgb *MessageWithJSONGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = mwjgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithjson.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithJSONGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (mwjgb *MessageWithJSONGroupBy) BoolX(ctx context.Context) bool {
+	v, err := mwjgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (mwjgb *MessageWithJSONGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range mwjgb.fields {
+		if !messagewithjson.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := mwjgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mwjgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (mwjgb *MessageWithJSONGroupBy) sqlQuery() *sql.Selector {
+	selector := mwjgb.sql.Select()
+	aggregation := make([]string, 0, len(mwjgb.fns))
+	for _, fn := range mwjgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(mwjgb.fields)+len(mwjgb.fns))
+		for _, f := range mwjgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(mwjgb.fields...)...)
+}
+
+// MessageWithJSONSelect is the builder for selecting fields of MessageWithJSON entities.
+type MessageWithJSONSelect struct {
+	*MessageWithJSONQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mwjs *MessageWithJSONSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := mwjs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	mwjs.sql = mwjs.MessageWithJSONQuery.sqlQuery(ctx)
+	return mwjs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (mwjs *MessageWithJSONSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := mwjs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (mwjs *MessageWithJSONSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(mwjs.fields) > 1 {
+		return nil, errors.New("ent: MessageWithJSONSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := mwjs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (mwjs *MessageWithJSONSelect) StringsX(ctx context.Context) []string {
+	v, err := mwjs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (mwjs *MessageWithJSONSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = mwjs.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithjson.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithJSONSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (mwjs *MessageWithJSONSelect) StringX(ctx context.Context) string {
+	v, err := mwjs.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (mwjs *MessageWithJSONSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(mwjs.fields) > 1 {
+		return nil, errors.New("ent: MessageWithJSONSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := mwjs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (mwjs *MessageWithJSONSelect) IntsX(ctx context.Context) []int {
+	v, err := mwjs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (mwjs *MessageWithJSONSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = mwjs.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithjson.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithJSONSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (mwjs *MessageWithJSONSelect) IntX(ctx context.Context) int {
+	v, err := mwjs.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (mwjs *MessageWithJSONSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(mwjs.fields) > 1 {
+		return nil, errors.New("ent: MessageWithJSONSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := mwjs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (mwjs *MessageWithJSONSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := mwjs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (mwjs *MessageWithJSONSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = mwjs.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithjson.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithJSONSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (mwjs *MessageWithJSONSelect) Float64X(ctx context.Context) float64 {
+	v, err := mwjs.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (mwjs *MessageWithJSONSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(mwjs.fields) > 1 {
+		return nil, errors.New("ent: MessageWithJSONSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := mwjs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (mwjs *MessageWithJSONSelect) BoolsX(ctx context.Context) []bool {
+	v, err := mwjs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (mwjs *MessageWithJSONSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = mwjs.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithjson.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithJSONSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (mwjs *MessageWithJSONSelect) BoolX(ctx context.Context) bool {
+	v, err := mwjs.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (mwjs *MessageWithJSONSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := mwjs.sql.Query()
+	if err := mwjs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
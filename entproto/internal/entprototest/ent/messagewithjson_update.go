@@ -0,0 +1,472 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json/jsontext"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithjson"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/schema"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageWithJSONUpdate is the builder for updating MessageWithJSON entities.
+type MessageWithJSONUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageWithJSONMutation
+}
+
+// Where appends a list predicates to the MessageWithJSONUpdate builder.
+func (mwju *MessageWithJSONUpdate) Where(ps ...predicate.MessageWithJSON) *MessageWithJSONUpdate {
+	mwju.mutation.Where(ps...)
+	return mwju
+}
+
+// SetStrings sets the "strings" field.
+func (mwju *MessageWithJSONUpdate) SetStrings(s []string) *MessageWithJSONUpdate {
+	mwju.mutation.SetStrings(s)
+	return mwju
+}
+
+// SetInts sets the "ints" field.
+func (mwju *MessageWithJSONUpdate) SetInts(i []int) *MessageWithJSONUpdate {
+	mwju.mutation.SetInts(i)
+	return mwju
+}
+
+// SetFloats sets the "floats" field.
+func (mwju *MessageWithJSONUpdate) SetFloats(f []float64) *MessageWithJSONUpdate {
+	mwju.mutation.SetFloats(f)
+	return mwju
+}
+
+// ClearFloats clears the value of the "floats" field.
+func (mwju *MessageWithJSONUpdate) ClearFloats() *MessageWithJSONUpdate {
+	mwju.mutation.ClearFloats()
+	return mwju
+}
+
+// SetObject sets the "object" field.
+func (mwju *MessageWithJSONUpdate) SetObject(m map[string]interface{}) *MessageWithJSONUpdate {
+	mwju.mutation.SetObject(m)
+	return mwju
+}
+
+// SetList sets the "list" field.
+func (mwju *MessageWithJSONUpdate) SetList(sj []schema.SomeJSON) *MessageWithJSONUpdate {
+	mwju.mutation.SetList(sj)
+	return mwju
+}
+
+// SetValue sets the "value" field.
+func (mwju *MessageWithJSONUpdate) SetValue(sj *schema.SomeJSON) *MessageWithJSONUpdate {
+	mwju.mutation.SetValue(sj)
+	return mwju
+}
+
+// SetRaw sets the "raw" field.
+func (mwju *MessageWithJSONUpdate) SetRaw(j jsontext.Value) *MessageWithJSONUpdate {
+	mwju.mutation.SetRaw(j)
+	return mwju
+}
+
+// SetNamed sets the "named" field.
+func (mwju *MessageWithJSONUpdate) SetNamed(s *schema.Address) *MessageWithJSONUpdate {
+	mwju.mutation.SetNamed(s)
+	return mwju
+}
+
+// Mutation returns the MessageWithJSONMutation object of the builder.
+func (mwju *MessageWithJSONUpdate) Mutation() *MessageWithJSONMutation {
+	return mwju.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mwju *MessageWithJSONUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mwju.hooks) == 0 {
+		affected, err = mwju.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MessageWithJSONMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mwju.mutation = mutation
+			affected, err = mwju.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mwju.hooks) - 1; i >= 0; i-- {
+			if mwju.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mwju.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mwju.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwju *MessageWithJSONUpdate) SaveX(ctx context.Context) int {
+	affected, err := mwju.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mwju *MessageWithJSONUpdate) Exec(ctx context.Context) error {
+	_, err := mwju.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwju *MessageWithJSONUpdate) ExecX(ctx context.Context) {
+	if err := mwju.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mwju *MessageWithJSONUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   messagewithjson.Table,
+			Columns: messagewithjson.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: messagewithjson.FieldID,
+			},
+		},
+	}
+	if ps := mwju.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mwju.mutation.Strings(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldStrings,
+		})
+	}
+	if value, ok := mwju.mutation.Ints(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldInts,
+		})
+	}
+	if value, ok := mwju.mutation.Floats(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldFloats,
+		})
+	}
+	if mwju.mutation.FloatsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: messagewithjson.FieldFloats,
+		})
+	}
+	if value, ok := mwju.mutation.Object(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldObject,
+		})
+	}
+	if value, ok := mwju.mutation.List(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldList,
+		})
+	}
+	if value, ok := mwju.mutation.Value(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldValue,
+		})
+	}
+	if value, ok := mwju.mutation.Raw(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldRaw,
+		})
+	}
+	if value, ok := mwju.mutation.Named(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldNamed,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mwju.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagewithjson.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// MessageWithJSONUpdateOne is the builder for updating a single MessageWithJSON entity.
+type MessageWithJSONUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageWithJSONMutation
+}
+
+// SetStrings sets the "strings" field.
+func (mwjuo *MessageWithJSONUpdateOne) SetStrings(s []string) *MessageWithJSONUpdateOne {
+	mwjuo.mutation.SetStrings(s)
+	return mwjuo
+}
+
+// SetInts sets the "ints" field.
+func (mwjuo *MessageWithJSONUpdateOne) SetInts(i []int) *MessageWithJSONUpdateOne {
+	mwjuo.mutation.SetInts(i)
+	return mwjuo
+}
+
+// SetFloats sets the "floats" field.
+func (mwjuo *MessageWithJSONUpdateOne) SetFloats(f []float64) *MessageWithJSONUpdateOne {
+	mwjuo.mutation.SetFloats(f)
+	return mwjuo
+}
+
+// ClearFloats clears the value of the "floats" field.
+func (mwjuo *MessageWithJSONUpdateOne) ClearFloats() *MessageWithJSONUpdateOne {
+	mwjuo.mutation.ClearFloats()
+	return mwjuo
+}
+
+// SetObject sets the "object" field.
+func (mwjuo *MessageWithJSONUpdateOne) SetObject(m map[string]interface{}) *MessageWithJSONUpdateOne {
+	mwjuo.mutation.SetObject(m)
+	return mwjuo
+}
+
+// SetList sets the "list" field.
+func (mwjuo *MessageWithJSONUpdateOne) SetList(sj []schema.SomeJSON) *MessageWithJSONUpdateOne {
+	mwjuo.mutation.SetList(sj)
+	return mwjuo
+}
+
+// SetValue sets the "value" field.
+func (mwjuo *MessageWithJSONUpdateOne) SetValue(sj *schema.SomeJSON) *MessageWithJSONUpdateOne {
+	mwjuo.mutation.SetValue(sj)
+	return mwjuo
+}
+
+// SetRaw sets the "raw" field.
+func (mwjuo *MessageWithJSONUpdateOne) SetRaw(j jsontext.Value) *MessageWithJSONUpdateOne {
+	mwjuo.mutation.SetRaw(j)
+	return mwjuo
+}
+
+// SetNamed sets the "named" field.
+func (mwjuo *MessageWithJSONUpdateOne) SetNamed(s *schema.Address) *MessageWithJSONUpdateOne {
+	mwjuo.mutation.SetNamed(s)
+	return mwjuo
+}
+
+// Mutation returns the MessageWithJSONMutation object of the builder.
+func (mwjuo *MessageWithJSONUpdateOne) Mutation() *MessageWithJSONMutation {
+	return mwjuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mwjuo *MessageWithJSONUpdateOne) Select(field string, fields ...string) *MessageWithJSONUpdateOne {
+	mwjuo.fields = append([]string{field}, fields...)
+	return mwjuo
+}
+
+// Save executes the query and returns the updated MessageWithJSON entity.
+func (mwjuo *MessageWithJSONUpdateOne) Save(ctx context.Context) (*MessageWithJSON, error) {
+	var (
+		err  error
+		node *MessageWithJSON
+	)
+	if len(mwjuo.hooks) == 0 {
+		node, err = mwjuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MessageWithJSONMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mwjuo.mutation = mutation
+			node, err = mwjuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mwjuo.hooks) - 1; i >= 0; i-- {
+			if mwjuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mwjuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mwjuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwjuo *MessageWithJSONUpdateOne) SaveX(ctx context.Context) *MessageWithJSON {
+	node, err := mwjuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mwjuo *MessageWithJSONUpdateOne) Exec(ctx context.Context) error {
+	_, err := mwjuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwjuo *MessageWithJSONUpdateOne) ExecX(ctx context.Context) {
+	if err := mwjuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mwjuo *MessageWithJSONUpdateOne) sqlSave(ctx context.Context) (_node *MessageWithJSON, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   messagewithjson.Table,
+			Columns: messagewithjson.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: messagewithjson.FieldID,
+			},
+		},
+	}
+	id, ok := mwjuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing MessageWithJSON.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mwjuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagewithjson.FieldID)
+		for _, f := range fields {
+			if !messagewithjson.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagewithjson.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mwjuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mwjuo.mutation.Strings(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldStrings,
+		})
+	}
+	if value, ok := mwjuo.mutation.Ints(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldInts,
+		})
+	}
+	if value, ok := mwjuo.mutation.Floats(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldFloats,
+		})
+	}
+	if mwjuo.mutation.FloatsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: messagewithjson.FieldFloats,
+		})
+	}
+	if value, ok := mwjuo.mutation.Object(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldObject,
+		})
+	}
+	if value, ok := mwjuo.mutation.List(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldList,
+		})
+	}
+	if value, ok := mwjuo.mutation.Value(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldValue,
+		})
+	}
+	if value, ok := mwjuo.mutation.Raw(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldRaw,
+		})
+	}
+	if value, ok := mwjuo.mutation.Named(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: messagewithjson.FieldNamed,
+		})
+	}
+	_node = &MessageWithJSON{config: mwjuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mwjuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagewithjson.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
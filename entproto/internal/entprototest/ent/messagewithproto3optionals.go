@@ -0,0 +1,145 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithproto3optionals"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// MessageWithProto3Optionals is the model entity for the MessageWithProto3Optionals schema.
+type MessageWithProto3Optionals struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// StrOptional holds the value of the "str_optional" field.
+	StrOptional string `json:"str_optional,omitempty"`
+	// IntOptional holds the value of the "int_optional" field.
+	IntOptional int8 `json:"int_optional,omitempty"`
+	// UUIDOptional holds the value of the "uuid_optional" field.
+	UUIDOptional uuid.UUID `json:"uuid_optional,omitempty"`
+	// TimeOptional holds the value of the "time_optional" field.
+	TimeOptional time.Time `json:"time_optional,omitempty"`
+	// Str holds the value of the "str" field.
+	Str string `json:"str,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MessageWithProto3Optionals) scanValues(columns []string) ([]interface{}, error) {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case messagewithproto3optionals.FieldID, messagewithproto3optionals.FieldIntOptional:
+			values[i] = new(sql.NullInt64)
+		case messagewithproto3optionals.FieldStrOptional, messagewithproto3optionals.FieldStr:
+			values[i] = new(sql.NullString)
+		case messagewithproto3optionals.FieldTimeOptional:
+			values[i] = new(sql.NullTime)
+		case messagewithproto3optionals.FieldUUIDOptional:
+			values[i] = new(uuid.UUID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type MessageWithProto3Optionals", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MessageWithProto3Optionals fields.
+func (mwp *MessageWithProto3Optionals) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case messagewithproto3optionals.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			mwp.ID = int(value.Int64)
+		case messagewithproto3optionals.FieldStrOptional:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field str_optional", values[i])
+			} else if value.Valid {
+				mwp.StrOptional = value.String
+			}
+		case messagewithproto3optionals.FieldIntOptional:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field int_optional", values[i])
+			} else if value.Valid {
+				mwp.IntOptional = int8(value.Int64)
+			}
+		case messagewithproto3optionals.FieldUUIDOptional:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field uuid_optional", values[i])
+			} else if value != nil {
+				mwp.UUIDOptional = *value
+			}
+		case messagewithproto3optionals.FieldTimeOptional:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field time_optional", values[i])
+			} else if value.Valid {
+				mwp.TimeOptional = value.Time
+			}
+		case messagewithproto3optionals.FieldStr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field str", values[i])
+			} else if value.Valid {
+				mwp.Str = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this MessageWithProto3Optionals.
+// Note that you need to call MessageWithProto3Optionals.Unwrap() before calling this method if this MessageWithProto3Optionals
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mwp *MessageWithProto3Optionals) Update() *MessageWithProto3OptionalsUpdateOne {
+	return (&MessageWithProto3OptionalsClient{config: mwp.config}).UpdateOne(mwp)
+}
+
+// Unwrap unwraps the MessageWithProto3Optionals entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mwp *MessageWithProto3Optionals) Unwrap() *MessageWithProto3Optionals {
+	tx, ok := mwp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MessageWithProto3Optionals is not a transactional entity")
+	}
+	mwp.config.driver = tx.drv
+	return mwp
+}
+
+// String implements the fmt.Stringer.
+func (mwp *MessageWithProto3Optionals) String() string {
+	var builder strings.Builder
+	builder.WriteString("MessageWithProto3Optionals(")
+	builder.WriteString(fmt.Sprintf("id=%v", mwp.ID))
+	builder.WriteString(", str_optional=")
+	builder.WriteString(mwp.StrOptional)
+	builder.WriteString(", int_optional=")
+	builder.WriteString(fmt.Sprintf("%v", mwp.IntOptional))
+	builder.WriteString(", uuid_optional=")
+	builder.WriteString(fmt.Sprintf("%v", mwp.UUIDOptional))
+	builder.WriteString(", time_optional=")
+	builder.WriteString(mwp.TimeOptional.Format(time.ANSIC))
+	builder.WriteString(", str=")
+	builder.WriteString(mwp.Str)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MessageWithProto3OptionalsSlice is a parsable slice of MessageWithProto3Optionals.
+type MessageWithProto3OptionalsSlice []*MessageWithProto3Optionals
+
+func (mwp MessageWithProto3OptionalsSlice) config(cfg config) {
+	for _i := range mwp {
+		mwp[_i].config = cfg
+	}
+}
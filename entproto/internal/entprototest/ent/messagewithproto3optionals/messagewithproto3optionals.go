@@ -0,0 +1,42 @@
+// Code generated by entc, DO NOT EDIT.
+
+package messagewithproto3optionals
+
+const (
+	// Label holds the string label denoting the messagewithproto3optionals type in the database.
+	Label = "message_with_proto3optionals"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStrOptional holds the string denoting the str_optional field in the database.
+	FieldStrOptional = "str_optional"
+	// FieldIntOptional holds the string denoting the int_optional field in the database.
+	FieldIntOptional = "int_optional"
+	// FieldUUIDOptional holds the string denoting the uuid_optional field in the database.
+	FieldUUIDOptional = "uuid_optional"
+	// FieldTimeOptional holds the string denoting the time_optional field in the database.
+	FieldTimeOptional = "time_optional"
+	// FieldStr holds the string denoting the str field in the database.
+	FieldStr = "str"
+	// Table holds the table name of the messagewithproto3optionals in the database.
+	Table = "message_with_proto3optionals"
+)
+
+// Columns holds all SQL columns for messagewithproto3optionals fields.
+var Columns = []string{
+	FieldID,
+	FieldStrOptional,
+	FieldIntOptional,
+	FieldUUIDOptional,
+	FieldTimeOptional,
+	FieldStr,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
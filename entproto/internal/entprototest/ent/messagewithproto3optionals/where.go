@@ -0,0 +1,667 @@
+// Code generated by entc, DO NOT EDIT.
+
+package messagewithproto3optionals
+
+import (
+	"time"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// StrOptional applies equality check predicate on the "str_optional" field. It's identical to StrOptionalEQ.
+func StrOptional(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStrOptional), v))
+	})
+}
+
+// IntOptional applies equality check predicate on the "int_optional" field. It's identical to IntOptionalEQ.
+func IntOptional(v int8) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIntOptional), v))
+	})
+}
+
+// UUIDOptional applies equality check predicate on the "uuid_optional" field. It's identical to UUIDOptionalEQ.
+func UUIDOptional(v uuid.UUID) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUUIDOptional), v))
+	})
+}
+
+// TimeOptional applies equality check predicate on the "time_optional" field. It's identical to TimeOptionalEQ.
+func TimeOptional(v time.Time) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimeOptional), v))
+	})
+}
+
+// Str applies equality check predicate on the "str" field. It's identical to StrEQ.
+func Str(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStr), v))
+	})
+}
+
+// StrOptionalEQ applies the EQ predicate on the "str_optional" field.
+func StrOptionalEQ(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStrOptional), v))
+	})
+}
+
+// StrOptionalNEQ applies the NEQ predicate on the "str_optional" field.
+func StrOptionalNEQ(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStrOptional), v))
+	})
+}
+
+// StrOptionalIn applies the In predicate on the "str_optional" field.
+func StrOptionalIn(vs ...string) predicate.MessageWithProto3Optionals {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStrOptional), v...))
+	})
+}
+
+// StrOptionalNotIn applies the NotIn predicate on the "str_optional" field.
+func StrOptionalNotIn(vs ...string) predicate.MessageWithProto3Optionals {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStrOptional), v...))
+	})
+}
+
+// StrOptionalGT applies the GT predicate on the "str_optional" field.
+func StrOptionalGT(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStrOptional), v))
+	})
+}
+
+// StrOptionalGTE applies the GTE predicate on the "str_optional" field.
+func StrOptionalGTE(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStrOptional), v))
+	})
+}
+
+// StrOptionalLT applies the LT predicate on the "str_optional" field.
+func StrOptionalLT(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStrOptional), v))
+	})
+}
+
+// StrOptionalLTE applies the LTE predicate on the "str_optional" field.
+func StrOptionalLTE(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStrOptional), v))
+	})
+}
+
+// StrOptionalContains applies the Contains predicate on the "str_optional" field.
+func StrOptionalContains(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStrOptional), v))
+	})
+}
+
+// StrOptionalHasPrefix applies the HasPrefix predicate on the "str_optional" field.
+func StrOptionalHasPrefix(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStrOptional), v))
+	})
+}
+
+// StrOptionalHasSuffix applies the HasSuffix predicate on the "str_optional" field.
+func StrOptionalHasSuffix(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStrOptional), v))
+	})
+}
+
+// StrOptionalIsNil applies the IsNil predicate on the "str_optional" field.
+func StrOptionalIsNil() predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldStrOptional)))
+	})
+}
+
+// StrOptionalNotNil applies the NotNil predicate on the "str_optional" field.
+func StrOptionalNotNil() predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldStrOptional)))
+	})
+}
+
+// StrOptionalEqualFold applies the EqualFold predicate on the "str_optional" field.
+func StrOptionalEqualFold(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStrOptional), v))
+	})
+}
+
+// StrOptionalContainsFold applies the ContainsFold predicate on the "str_optional" field.
+func StrOptionalContainsFold(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStrOptional), v))
+	})
+}
+
+// IntOptionalEQ applies the EQ predicate on the "int_optional" field.
+func IntOptionalEQ(v int8) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIntOptional), v))
+	})
+}
+
+// IntOptionalNEQ applies the NEQ predicate on the "int_optional" field.
+func IntOptionalNEQ(v int8) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIntOptional), v))
+	})
+}
+
+// IntOptionalIn applies the In predicate on the "int_optional" field.
+func IntOptionalIn(vs ...int8) predicate.MessageWithProto3Optionals {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldIntOptional), v...))
+	})
+}
+
+// IntOptionalNotIn applies the NotIn predicate on the "int_optional" field.
+func IntOptionalNotIn(vs ...int8) predicate.MessageWithProto3Optionals {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldIntOptional), v...))
+	})
+}
+
+// IntOptionalGT applies the GT predicate on the "int_optional" field.
+func IntOptionalGT(v int8) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIntOptional), v))
+	})
+}
+
+// IntOptionalGTE applies the GTE predicate on the "int_optional" field.
+func IntOptionalGTE(v int8) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIntOptional), v))
+	})
+}
+
+// IntOptionalLT applies the LT predicate on the "int_optional" field.
+func IntOptionalLT(v int8) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIntOptional), v))
+	})
+}
+
+// IntOptionalLTE applies the LTE predicate on the "int_optional" field.
+func IntOptionalLTE(v int8) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIntOptional), v))
+	})
+}
+
+// IntOptionalIsNil applies the IsNil predicate on the "int_optional" field.
+func IntOptionalIsNil() predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldIntOptional)))
+	})
+}
+
+// IntOptionalNotNil applies the NotNil predicate on the "int_optional" field.
+func IntOptionalNotNil() predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldIntOptional)))
+	})
+}
+
+// UUIDOptionalEQ applies the EQ predicate on the "uuid_optional" field.
+func UUIDOptionalEQ(v uuid.UUID) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldUUIDOptional), v))
+	})
+}
+
+// UUIDOptionalNEQ applies the NEQ predicate on the "uuid_optional" field.
+func UUIDOptionalNEQ(v uuid.UUID) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldUUIDOptional), v))
+	})
+}
+
+// UUIDOptionalIn applies the In predicate on the "uuid_optional" field.
+func UUIDOptionalIn(vs ...uuid.UUID) predicate.MessageWithProto3Optionals {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldUUIDOptional), v...))
+	})
+}
+
+// UUIDOptionalNotIn applies the NotIn predicate on the "uuid_optional" field.
+func UUIDOptionalNotIn(vs ...uuid.UUID) predicate.MessageWithProto3Optionals {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldUUIDOptional), v...))
+	})
+}
+
+// UUIDOptionalGT applies the GT predicate on the "uuid_optional" field.
+func UUIDOptionalGT(v uuid.UUID) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldUUIDOptional), v))
+	})
+}
+
+// UUIDOptionalGTE applies the GTE predicate on the "uuid_optional" field.
+func UUIDOptionalGTE(v uuid.UUID) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldUUIDOptional), v))
+	})
+}
+
+// UUIDOptionalLT applies the LT predicate on the "uuid_optional" field.
+func UUIDOptionalLT(v uuid.UUID) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldUUIDOptional), v))
+	})
+}
+
+// UUIDOptionalLTE applies the LTE predicate on the "uuid_optional" field.
+func UUIDOptionalLTE(v uuid.UUID) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldUUIDOptional), v))
+	})
+}
+
+// UUIDOptionalIsNil applies the IsNil predicate on the "uuid_optional" field.
+func UUIDOptionalIsNil() predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldUUIDOptional)))
+	})
+}
+
+// UUIDOptionalNotNil applies the NotNil predicate on the "uuid_optional" field.
+func UUIDOptionalNotNil() predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldUUIDOptional)))
+	})
+}
+
+// TimeOptionalEQ applies the EQ predicate on the "time_optional" field.
+func TimeOptionalEQ(v time.Time) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTimeOptional), v))
+	})
+}
+
+// TimeOptionalNEQ applies the NEQ predicate on the "time_optional" field.
+func TimeOptionalNEQ(v time.Time) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTimeOptional), v))
+	})
+}
+
+// TimeOptionalIn applies the In predicate on the "time_optional" field.
+func TimeOptionalIn(vs ...time.Time) predicate.MessageWithProto3Optionals {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTimeOptional), v...))
+	})
+}
+
+// TimeOptionalNotIn applies the NotIn predicate on the "time_optional" field.
+func TimeOptionalNotIn(vs ...time.Time) predicate.MessageWithProto3Optionals {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTimeOptional), v...))
+	})
+}
+
+// TimeOptionalGT applies the GT predicate on the "time_optional" field.
+func TimeOptionalGT(v time.Time) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTimeOptional), v))
+	})
+}
+
+// TimeOptionalGTE applies the GTE predicate on the "time_optional" field.
+func TimeOptionalGTE(v time.Time) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTimeOptional), v))
+	})
+}
+
+// TimeOptionalLT applies the LT predicate on the "time_optional" field.
+func TimeOptionalLT(v time.Time) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTimeOptional), v))
+	})
+}
+
+// TimeOptionalLTE applies the LTE predicate on the "time_optional" field.
+func TimeOptionalLTE(v time.Time) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTimeOptional), v))
+	})
+}
+
+// TimeOptionalIsNil applies the IsNil predicate on the "time_optional" field.
+func TimeOptionalIsNil() predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTimeOptional)))
+	})
+}
+
+// TimeOptionalNotNil applies the NotNil predicate on the "time_optional" field.
+func TimeOptionalNotNil() predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTimeOptional)))
+	})
+}
+
+// StrEQ applies the EQ predicate on the "str" field.
+func StrEQ(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldStr), v))
+	})
+}
+
+// StrNEQ applies the NEQ predicate on the "str" field.
+func StrNEQ(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldStr), v))
+	})
+}
+
+// StrIn applies the In predicate on the "str" field.
+func StrIn(vs ...string) predicate.MessageWithProto3Optionals {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldStr), v...))
+	})
+}
+
+// StrNotIn applies the NotIn predicate on the "str" field.
+func StrNotIn(vs ...string) predicate.MessageWithProto3Optionals {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldStr), v...))
+	})
+}
+
+// StrGT applies the GT predicate on the "str" field.
+func StrGT(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldStr), v))
+	})
+}
+
+// StrGTE applies the GTE predicate on the "str" field.
+func StrGTE(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldStr), v))
+	})
+}
+
+// StrLT applies the LT predicate on the "str" field.
+func StrLT(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldStr), v))
+	})
+}
+
+// StrLTE applies the LTE predicate on the "str" field.
+func StrLTE(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldStr), v))
+	})
+}
+
+// StrContains applies the Contains predicate on the "str" field.
+func StrContains(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldStr), v))
+	})
+}
+
+// StrHasPrefix applies the HasPrefix predicate on the "str" field.
+func StrHasPrefix(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldStr), v))
+	})
+}
+
+// StrHasSuffix applies the HasSuffix predicate on the "str" field.
+func StrHasSuffix(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldStr), v))
+	})
+}
+
+// StrEqualFold applies the EqualFold predicate on the "str" field.
+func StrEqualFold(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldStr), v))
+	})
+}
+
+// StrContainsFold applies the ContainsFold predicate on the "str" field.
+func StrContainsFold(v string) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldStr), v))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MessageWithProto3Optionals) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MessageWithProto3Optionals) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MessageWithProto3Optionals) predicate.MessageWithProto3Optionals {
+	return predicate.MessageWithProto3Optionals(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,302 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithproto3optionals"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MessageWithProto3OptionalsCreate is the builder for creating a MessageWithProto3Optionals entity.
+type MessageWithProto3OptionalsCreate struct {
+	config
+	mutation *MessageWithProto3OptionalsMutation
+	hooks    []Hook
+}
+
+// SetStrOptional sets the "str_optional" field.
+func (mwpc *MessageWithProto3OptionalsCreate) SetStrOptional(s string) *MessageWithProto3OptionalsCreate {
+	mwpc.mutation.SetStrOptional(s)
+	return mwpc
+}
+
+// SetNillableStrOptional sets the "str_optional" field if the given value is not nil.
+func (mwpc *MessageWithProto3OptionalsCreate) SetNillableStrOptional(s *string) *MessageWithProto3OptionalsCreate {
+	if s != nil {
+		mwpc.SetStrOptional(*s)
+	}
+	return mwpc
+}
+
+// SetIntOptional sets the "int_optional" field.
+func (mwpc *MessageWithProto3OptionalsCreate) SetIntOptional(i int8) *MessageWithProto3OptionalsCreate {
+	mwpc.mutation.SetIntOptional(i)
+	return mwpc
+}
+
+// SetNillableIntOptional sets the "int_optional" field if the given value is not nil.
+func (mwpc *MessageWithProto3OptionalsCreate) SetNillableIntOptional(i *int8) *MessageWithProto3OptionalsCreate {
+	if i != nil {
+		mwpc.SetIntOptional(*i)
+	}
+	return mwpc
+}
+
+// SetUUIDOptional sets the "uuid_optional" field.
+func (mwpc *MessageWithProto3OptionalsCreate) SetUUIDOptional(u uuid.UUID) *MessageWithProto3OptionalsCreate {
+	mwpc.mutation.SetUUIDOptional(u)
+	return mwpc
+}
+
+// SetTimeOptional sets the "time_optional" field.
+func (mwpc *MessageWithProto3OptionalsCreate) SetTimeOptional(t time.Time) *MessageWithProto3OptionalsCreate {
+	mwpc.mutation.SetTimeOptional(t)
+	return mwpc
+}
+
+// SetNillableTimeOptional sets the "time_optional" field if the given value is not nil.
+func (mwpc *MessageWithProto3OptionalsCreate) SetNillableTimeOptional(t *time.Time) *MessageWithProto3OptionalsCreate {
+	if t != nil {
+		mwpc.SetTimeOptional(*t)
+	}
+	return mwpc
+}
+
+// SetStr sets the "str" field.
+func (mwpc *MessageWithProto3OptionalsCreate) SetStr(s string) *MessageWithProto3OptionalsCreate {
+	mwpc.mutation.SetStr(s)
+	return mwpc
+}
+
+// Mutation returns the MessageWithProto3OptionalsMutation object of the builder.
+func (mwpc *MessageWithProto3OptionalsCreate) Mutation() *MessageWithProto3OptionalsMutation {
+	return mwpc.mutation
+}
+
+// Save creates the MessageWithProto3Optionals in the database.
+func (mwpc *MessageWithProto3OptionalsCreate) Save(ctx context.Context) (*MessageWithProto3Optionals, error) {
+	var (
+		err  error
+		node *MessageWithProto3Optionals
+	)
+	if len(mwpc.hooks) == 0 {
+		if err = mwpc.check(); err != nil {
+			return nil, err
+		}
+		node, err = mwpc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MessageWithProto3OptionalsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = mwpc.check(); err != nil {
+				return nil, err
+			}
+			mwpc.mutation = mutation
+			if node, err = mwpc.sqlSave(ctx); err != nil {
+				return nil, err
+			}
+			mutation.id = &node.ID
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mwpc.hooks) - 1; i >= 0; i-- {
+			if mwpc.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mwpc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mwpc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mwpc *MessageWithProto3OptionalsCreate) SaveX(ctx context.Context) *MessageWithProto3Optionals {
+	v, err := mwpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mwpc *MessageWithProto3OptionalsCreate) Exec(ctx context.Context) error {
+	_, err := mwpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwpc *MessageWithProto3OptionalsCreate) ExecX(ctx context.Context) {
+	if err := mwpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mwpc *MessageWithProto3OptionalsCreate) check() error {
+	if _, ok := mwpc.mutation.Str(); !ok {
+		return &ValidationError{Name: "str", err: errors.New(`ent: missing required field "str"`)}
+	}
+	return nil
+}
+
+func (mwpc *MessageWithProto3OptionalsCreate) sqlSave(ctx context.Context) (*MessageWithProto3Optionals, error) {
+	_node, _spec := mwpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mwpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	return _node, nil
+}
+
+func (mwpc *MessageWithProto3OptionalsCreate) createSpec() (*MessageWithProto3Optionals, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageWithProto3Optionals{config: mwpc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: messagewithproto3optionals.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: messagewithproto3optionals.FieldID,
+			},
+		}
+	)
+	if value, ok := mwpc.mutation.StrOptional(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldStrOptional,
+		})
+		_node.StrOptional = value
+	}
+	if value, ok := mwpc.mutation.IntOptional(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldIntOptional,
+		})
+		_node.IntOptional = value
+	}
+	if value, ok := mwpc.mutation.UUIDOptional(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldUUIDOptional,
+		})
+		_node.UUIDOptional = value
+	}
+	if value, ok := mwpc.mutation.TimeOptional(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldTimeOptional,
+		})
+		_node.TimeOptional = value
+	}
+	if value, ok := mwpc.mutation.Str(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldStr,
+		})
+		_node.Str = value
+	}
+	return _node, _spec
+}
+
+// MessageWithProto3OptionalsCreateBulk is the builder for creating many MessageWithProto3Optionals entities in bulk.
+type MessageWithProto3OptionalsCreateBulk struct {
+	config
+	builders []*MessageWithProto3OptionalsCreate
+}
+
+// Save creates the MessageWithProto3Optionals entities in the database.
+func (mwpcb *MessageWithProto3OptionalsCreateBulk) Save(ctx context.Context) ([]*MessageWithProto3Optionals, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(mwpcb.builders))
+	nodes := make([]*MessageWithProto3Optionals, len(mwpcb.builders))
+	mutators := make([]Mutator, len(mwpcb.builders))
+	for i := range mwpcb.builders {
+		func(i int, root context.Context) {
+			builder := mwpcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageWithProto3OptionalsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mwpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mwpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{err.Error(), err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mwpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwpcb *MessageWithProto3OptionalsCreateBulk) SaveX(ctx context.Context) []*MessageWithProto3Optionals {
+	v, err := mwpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mwpcb *MessageWithProto3OptionalsCreateBulk) Exec(ctx context.Context) error {
+	_, err := mwpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwpcb *MessageWithProto3OptionalsCreateBulk) ExecX(ctx context.Context) {
+	if err := mwpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithproto3optionals"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageWithProto3OptionalsDelete is the builder for deleting a MessageWithProto3Optionals entity.
+type MessageWithProto3OptionalsDelete struct {
+	config
+	hooks    []Hook
+	mutation *MessageWithProto3OptionalsMutation
+}
+
+// Where appends a list predicates to the MessageWithProto3OptionalsDelete builder.
+func (mwpd *MessageWithProto3OptionalsDelete) Where(ps ...predicate.MessageWithProto3Optionals) *MessageWithProto3OptionalsDelete {
+	mwpd.mutation.Where(ps...)
+	return mwpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mwpd *MessageWithProto3OptionalsDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mwpd.hooks) == 0 {
+		affected, err = mwpd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MessageWithProto3OptionalsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mwpd.mutation = mutation
+			affected, err = mwpd.sqlExec(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mwpd.hooks) - 1; i >= 0; i-- {
+			if mwpd.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mwpd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mwpd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwpd *MessageWithProto3OptionalsDelete) ExecX(ctx context.Context) int {
+	n, err := mwpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mwpd *MessageWithProto3OptionalsDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: messagewithproto3optionals.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: messagewithproto3optionals.FieldID,
+			},
+		},
+	}
+	if ps := mwpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, mwpd.driver, _spec)
+}
+
+// MessageWithProto3OptionalsDeleteOne is the builder for deleting a single MessageWithProto3Optionals entity.
+type MessageWithProto3OptionalsDeleteOne struct {
+	mwpd *MessageWithProto3OptionalsDelete
+}
+
+// Exec executes the deletion query.
+func (mwpdo *MessageWithProto3OptionalsDeleteOne) Exec(ctx context.Context) error {
+	n, err := mwpdo.mwpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{messagewithproto3optionals.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwpdo *MessageWithProto3OptionalsDeleteOne) ExecX(ctx context.Context) {
+	mwpdo.mwpd.ExecX(ctx)
+}
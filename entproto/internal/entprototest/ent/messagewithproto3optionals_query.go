@@ -0,0 +1,913 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithproto3optionals"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageWithProto3OptionalsQuery is the builder for querying MessageWithProto3Optionals entities.
+type MessageWithProto3OptionalsQuery struct {
+	config
+	limit      *int
+	offset     *int
+	unique     *bool
+	order      []OrderFunc
+	fields     []string
+	predicates []predicate.MessageWithProto3Optionals
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the MessageWithProto3OptionalsQuery builder.
+func (mwpq *MessageWithProto3OptionalsQuery) Where(ps ...predicate.MessageWithProto3Optionals) *MessageWithProto3OptionalsQuery {
+	mwpq.predicates = append(mwpq.predicates, ps...)
+	return mwpq
+}
+
+// Limit adds a limit step to the query.
+func (mwpq *MessageWithProto3OptionalsQuery) Limit(limit int) *MessageWithProto3OptionalsQuery {
+	mwpq.limit = &limit
+	return mwpq
+}
+
+// Offset adds an offset step to the query.
+func (mwpq *MessageWithProto3OptionalsQuery) Offset(offset int) *MessageWithProto3OptionalsQuery {
+	mwpq.offset = &offset
+	return mwpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mwpq *MessageWithProto3OptionalsQuery) Unique(unique bool) *MessageWithProto3OptionalsQuery {
+	mwpq.unique = &unique
+	return mwpq
+}
+
+// Order adds an order step to the query.
+func (mwpq *MessageWithProto3OptionalsQuery) Order(o ...OrderFunc) *MessageWithProto3OptionalsQuery {
+	mwpq.order = append(mwpq.order, o...)
+	return mwpq
+}
+
+// First returns the first MessageWithProto3Optionals entity from the query.
+// Returns a *NotFoundError when no MessageWithProto3Optionals was found.
+func (mwpq *MessageWithProto3OptionalsQuery) First(ctx context.Context) (*MessageWithProto3Optionals, error) {
+	nodes, err := mwpq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{messagewithproto3optionals.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mwpq *MessageWithProto3OptionalsQuery) FirstX(ctx context.Context) *MessageWithProto3Optionals {
+	node, err := mwpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first MessageWithProto3Optionals ID from the query.
+// Returns a *NotFoundError when no MessageWithProto3Optionals ID was found.
+func (mwpq *MessageWithProto3OptionalsQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mwpq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{messagewithproto3optionals.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mwpq *MessageWithProto3OptionalsQuery) FirstIDX(ctx context.Context) int {
+	id, err := mwpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single MessageWithProto3Optionals entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when exactly one MessageWithProto3Optionals entity is not found.
+// Returns a *NotFoundError when no MessageWithProto3Optionals entities are found.
+func (mwpq *MessageWithProto3OptionalsQuery) Only(ctx context.Context) (*MessageWithProto3Optionals, error) {
+	nodes, err := mwpq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{messagewithproto3optionals.Label}
+	default:
+		return nil, &NotSingularError{messagewithproto3optionals.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mwpq *MessageWithProto3OptionalsQuery) OnlyX(ctx context.Context) *MessageWithProto3Optionals {
+	node, err := mwpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only MessageWithProto3Optionals ID in the query.
+// Returns a *NotSingularError when exactly one MessageWithProto3Optionals ID is not found.
+// Returns a *NotFoundError when no entities are found.
+func (mwpq *MessageWithProto3OptionalsQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = mwpq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{messagewithproto3optionals.Label}
+	default:
+		err = &NotSingularError{messagewithproto3optionals.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mwpq *MessageWithProto3OptionalsQuery) OnlyIDX(ctx context.Context) int {
+	id, err := mwpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of MessageWithProto3OptionalsSlice.
+func (mwpq *MessageWithProto3OptionalsQuery) All(ctx context.Context) ([]*MessageWithProto3Optionals, error) {
+	if err := mwpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return mwpq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mwpq *MessageWithProto3OptionalsQuery) AllX(ctx context.Context) []*MessageWithProto3Optionals {
+	nodes, err := mwpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of MessageWithProto3Optionals IDs.
+func (mwpq *MessageWithProto3OptionalsQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := mwpq.Select(messagewithproto3optionals.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mwpq *MessageWithProto3OptionalsQuery) IDsX(ctx context.Context) []int {
+	ids, err := mwpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mwpq *MessageWithProto3OptionalsQuery) Count(ctx context.Context) (int, error) {
+	if err := mwpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return mwpq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mwpq *MessageWithProto3OptionalsQuery) CountX(ctx context.Context) int {
+	count, err := mwpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mwpq *MessageWithProto3OptionalsQuery) Exist(ctx context.Context) (bool, error) {
+	if err := mwpq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return mwpq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mwpq *MessageWithProto3OptionalsQuery) ExistX(ctx context.Context) bool {
+	exist, err := mwpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the MessageWithProto3OptionalsQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mwpq *MessageWithProto3OptionalsQuery) Clone() *MessageWithProto3OptionalsQuery {
+	if mwpq == nil {
+		return nil
+	}
+	return &MessageWithProto3OptionalsQuery{
+		config:     mwpq.config,
+		limit:      mwpq.limit,
+		offset:     mwpq.offset,
+		order:      append([]OrderFunc{}, mwpq.order...),
+		predicates: append([]predicate.MessageWithProto3Optionals{}, mwpq.predicates...),
+		// clone intermediate query.
+		sql:  mwpq.sql.Clone(),
+		path: mwpq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		StrOptional string `json:"str_optional,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.MessageWithProto3Optionals.Query().
+//		GroupBy(messagewithproto3optionals.FieldStrOptional).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (mwpq *MessageWithProto3OptionalsQuery) GroupBy(field string, fields ...string) *MessageWithProto3OptionalsGroupBy {
+	group := &MessageWithProto3OptionalsGroupBy{config: mwpq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := mwpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return mwpq.sqlQuery(ctx), nil
+	}
+	return group
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		StrOptional string `json:"str_optional,omitempty"`
+//	}
+//
+//	client.MessageWithProto3Optionals.Query().
+//		Select(messagewithproto3optionals.FieldStrOptional).
+//		Scan(ctx, &v)
+func (mwpq *MessageWithProto3OptionalsQuery) Select(fields ...string) *MessageWithProto3OptionalsSelect {
+	mwpq.fields = append(mwpq.fields, fields...)
+	return &MessageWithProto3OptionalsSelect{MessageWithProto3OptionalsQuery: mwpq}
+}
+
+func (mwpq *MessageWithProto3OptionalsQuery) prepareQuery(ctx context.Context) error {
+	for _, f := range mwpq.fields {
+		if !messagewithproto3optionals.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if mwpq.path != nil {
+		prev, err := mwpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mwpq.sql = prev
+	}
+	return nil
+}
+
+func (mwpq *MessageWithProto3OptionalsQuery) sqlAll(ctx context.Context) ([]*MessageWithProto3Optionals, error) {
+	var (
+		nodes = []*MessageWithProto3Optionals{}
+		_spec = mwpq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+		node := &MessageWithProto3Optionals{config: mwpq.config}
+		nodes = append(nodes, node)
+		return node.scanValues(columns)
+	}
+	_spec.Assign = func(columns []string, values []interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mwpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mwpq *MessageWithProto3OptionalsQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mwpq.querySpec()
+	return sqlgraph.CountNodes(ctx, mwpq.driver, _spec)
+}
+
+func (mwpq *MessageWithProto3OptionalsQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := mwpq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (mwpq *MessageWithProto3OptionalsQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   messagewithproto3optionals.Table,
+			Columns: messagewithproto3optionals.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: messagewithproto3optionals.FieldID,
+			},
+		},
+		From:   mwpq.sql,
+		Unique: true,
+	}
+	if unique := mwpq.unique; unique != nil {
+		_spec.Unique = *unique
+	}
+	if fields := mwpq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagewithproto3optionals.FieldID)
+		for i := range fields {
+			if fields[i] != messagewithproto3optionals.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mwpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mwpq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mwpq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mwpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mwpq *MessageWithProto3OptionalsQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mwpq.driver.Dialect())
+	t1 := builder.Table(messagewithproto3optionals.Table)
+	columns := mwpq.fields
+	if len(columns) == 0 {
+		columns = messagewithproto3optionals.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mwpq.sql != nil {
+		selector = mwpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	for _, p := range mwpq.predicates {
+		p(selector)
+	}
+	for _, p := range mwpq.order {
+		p(selector)
+	}
+	if offset := mwpq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mwpq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// MessageWithProto3OptionalsGroupBy is the group-by builder for MessageWithProto3Optionals entities.
+type MessageWithProto3OptionalsGroupBy struct {
+	config
+	fields []string
+	fns    []AggregateFunc
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) Aggregate(fns ...AggregateFunc) *MessageWithProto3OptionalsGroupBy {
+	mwpgb.fns = append(mwpgb.fns, fns...)
+	return mwpgb
+}
+
+// Scan applies the group-by query and scans the result into the given value.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := mwpgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	mwpgb.sql = query
+	return mwpgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := mwpgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(mwpgb.fields) > 1 {
+		return nil, errors.New("ent: MessageWithProto3OptionalsGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := mwpgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) StringsX(ctx context.Context) []string {
+	v, err := mwpgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = mwpgb.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithproto3optionals.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithProto3OptionalsGroupBy.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) StringX(ctx context.Context) string {
+	v, err := mwpgb.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(mwpgb.fields) > 1 {
+		return nil, errors.New("ent: MessageWithProto3OptionalsGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := mwpgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) IntsX(ctx context.Context) []int {
+	v, err := mwpgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = mwpgb.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithproto3optionals.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithProto3OptionalsGroupBy.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) IntX(ctx context.Context) int {
+	v, err := mwpgb.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(mwpgb.fields) > 1 {
+		return nil, errors.New("ent: MessageWithProto3OptionalsGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := mwpgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := mwpgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = mwpgb.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithproto3optionals.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithProto3OptionalsGroupBy.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) Float64X(ctx context.Context) float64 {
+	v, err := mwpgb.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by.
+// It is only allowed when executing a group-by query with one field.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(mwpgb.fields) > 1 {
+		return nil, errors.New("ent: MessageWithProto3OptionalsGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := mwpgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := mwpgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a group-by query.
+// It is only allowed when executing a group-by query with one field.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = mwpgb.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithproto3optionals.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithProto3OptionalsGroupBy.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (mwpgb *MessageWithProto3OptionalsGroupBy) BoolX(ctx context.Context) bool {
+	v, err := mwpgb.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (mwpgb *MessageWithProto3OptionalsGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	for _, f := range mwpgb.fields {
+		if !messagewithproto3optionals.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("invalid field %q for group-by", f)}
+		}
+	}
+	selector := mwpgb.sqlQuery()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mwpgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (mwpgb *MessageWithProto3OptionalsGroupBy) sqlQuery() *sql.Selector {
+	selector := mwpgb.sql.Select()
+	aggregation := make([]string, 0, len(mwpgb.fns))
+	for _, fn := range mwpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	// If no columns were selected in a custom aggregation function, the default
+	// selection is the fields used for "group-by", and the aggregation functions.
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(mwpgb.fields)+len(mwpgb.fns))
+		for _, f := range mwpgb.fields {
+			columns = append(columns, selector.C(f))
+		}
+		for _, c := range aggregation {
+			columns = append(columns, c)
+		}
+		selector.Select(columns...)
+	}
+	return selector.GroupBy(selector.Columns(mwpgb.fields...)...)
+}
+
+// MessageWithProto3OptionalsSelect is the builder for selecting fields of MessageWithProto3Optionals entities.
+type MessageWithProto3OptionalsSelect struct {
+	*MessageWithProto3OptionalsQuery
+	// intermediate query (i.e. traversal path).
+	sql *sql.Selector
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mwps *MessageWithProto3OptionalsSelect) Scan(ctx context.Context, v interface{}) error {
+	if err := mwps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	mwps.sql = mwps.MessageWithProto3OptionalsQuery.sqlQuery(ctx)
+	return mwps.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (mwps *MessageWithProto3OptionalsSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := mwps.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from a selector. It is only allowed when selecting one field.
+func (mwps *MessageWithProto3OptionalsSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(mwps.fields) > 1 {
+		return nil, errors.New("ent: MessageWithProto3OptionalsSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := mwps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (mwps *MessageWithProto3OptionalsSelect) StringsX(ctx context.Context) []string {
+	v, err := mwps.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// String returns a single string from a selector. It is only allowed when selecting one field.
+func (mwps *MessageWithProto3OptionalsSelect) String(ctx context.Context) (_ string, err error) {
+	var v []string
+	if v, err = mwps.Strings(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithproto3optionals.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithProto3OptionalsSelect.Strings returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// StringX is like String, but panics if an error occurs.
+func (mwps *MessageWithProto3OptionalsSelect) StringX(ctx context.Context) string {
+	v, err := mwps.String(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from a selector. It is only allowed when selecting one field.
+func (mwps *MessageWithProto3OptionalsSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(mwps.fields) > 1 {
+		return nil, errors.New("ent: MessageWithProto3OptionalsSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := mwps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (mwps *MessageWithProto3OptionalsSelect) IntsX(ctx context.Context) []int {
+	v, err := mwps.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Int returns a single int from a selector. It is only allowed when selecting one field.
+func (mwps *MessageWithProto3OptionalsSelect) Int(ctx context.Context) (_ int, err error) {
+	var v []int
+	if v, err = mwps.Ints(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithproto3optionals.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithProto3OptionalsSelect.Ints returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// IntX is like Int, but panics if an error occurs.
+func (mwps *MessageWithProto3OptionalsSelect) IntX(ctx context.Context) int {
+	v, err := mwps.Int(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from a selector. It is only allowed when selecting one field.
+func (mwps *MessageWithProto3OptionalsSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(mwps.fields) > 1 {
+		return nil, errors.New("ent: MessageWithProto3OptionalsSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := mwps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (mwps *MessageWithProto3OptionalsSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := mwps.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64 returns a single float64 from a selector. It is only allowed when selecting one field.
+func (mwps *MessageWithProto3OptionalsSelect) Float64(ctx context.Context) (_ float64, err error) {
+	var v []float64
+	if v, err = mwps.Float64s(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithproto3optionals.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithProto3OptionalsSelect.Float64s returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// Float64X is like Float64, but panics if an error occurs.
+func (mwps *MessageWithProto3OptionalsSelect) Float64X(ctx context.Context) float64 {
+	v, err := mwps.Float64(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from a selector. It is only allowed when selecting one field.
+func (mwps *MessageWithProto3OptionalsSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(mwps.fields) > 1 {
+		return nil, errors.New("ent: MessageWithProto3OptionalsSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := mwps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (mwps *MessageWithProto3OptionalsSelect) BoolsX(ctx context.Context) []bool {
+	v, err := mwps.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bool returns a single bool from a selector. It is only allowed when selecting one field.
+func (mwps *MessageWithProto3OptionalsSelect) Bool(ctx context.Context) (_ bool, err error) {
+	var v []bool
+	if v, err = mwps.Bools(ctx); err != nil {
+		return
+	}
+	switch len(v) {
+	case 1:
+		return v[0], nil
+	case 0:
+		err = &NotFoundError{messagewithproto3optionals.Label}
+	default:
+		err = fmt.Errorf("ent: MessageWithProto3OptionalsSelect.Bools returned %d results when one was expected", len(v))
+	}
+	return
+}
+
+// BoolX is like Bool, but panics if an error occurs.
+func (mwps *MessageWithProto3OptionalsSelect) BoolX(ctx context.Context) bool {
+	v, err := mwps.Bool(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (mwps *MessageWithProto3OptionalsSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := mwps.sql.Query()
+	if err := mwps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,542 @@
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithproto3optionals"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// MessageWithProto3OptionalsUpdate is the builder for updating MessageWithProto3Optionals entities.
+type MessageWithProto3OptionalsUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MessageWithProto3OptionalsMutation
+}
+
+// Where appends a list predicates to the MessageWithProto3OptionalsUpdate builder.
+func (mwpu *MessageWithProto3OptionalsUpdate) Where(ps ...predicate.MessageWithProto3Optionals) *MessageWithProto3OptionalsUpdate {
+	mwpu.mutation.Where(ps...)
+	return mwpu
+}
+
+// SetStrOptional sets the "str_optional" field.
+func (mwpu *MessageWithProto3OptionalsUpdate) SetStrOptional(s string) *MessageWithProto3OptionalsUpdate {
+	mwpu.mutation.SetStrOptional(s)
+	return mwpu
+}
+
+// SetNillableStrOptional sets the "str_optional" field if the given value is not nil.
+func (mwpu *MessageWithProto3OptionalsUpdate) SetNillableStrOptional(s *string) *MessageWithProto3OptionalsUpdate {
+	if s != nil {
+		mwpu.SetStrOptional(*s)
+	}
+	return mwpu
+}
+
+// ClearStrOptional clears the value of the "str_optional" field.
+func (mwpu *MessageWithProto3OptionalsUpdate) ClearStrOptional() *MessageWithProto3OptionalsUpdate {
+	mwpu.mutation.ClearStrOptional()
+	return mwpu
+}
+
+// SetIntOptional sets the "int_optional" field.
+func (mwpu *MessageWithProto3OptionalsUpdate) SetIntOptional(i int8) *MessageWithProto3OptionalsUpdate {
+	mwpu.mutation.ResetIntOptional()
+	mwpu.mutation.SetIntOptional(i)
+	return mwpu
+}
+
+// SetNillableIntOptional sets the "int_optional" field if the given value is not nil.
+func (mwpu *MessageWithProto3OptionalsUpdate) SetNillableIntOptional(i *int8) *MessageWithProto3OptionalsUpdate {
+	if i != nil {
+		mwpu.SetIntOptional(*i)
+	}
+	return mwpu
+}
+
+// AddIntOptional adds i to the "int_optional" field.
+func (mwpu *MessageWithProto3OptionalsUpdate) AddIntOptional(i int8) *MessageWithProto3OptionalsUpdate {
+	mwpu.mutation.AddIntOptional(i)
+	return mwpu
+}
+
+// ClearIntOptional clears the value of the "int_optional" field.
+func (mwpu *MessageWithProto3OptionalsUpdate) ClearIntOptional() *MessageWithProto3OptionalsUpdate {
+	mwpu.mutation.ClearIntOptional()
+	return mwpu
+}
+
+// SetUUIDOptional sets the "uuid_optional" field.
+func (mwpu *MessageWithProto3OptionalsUpdate) SetUUIDOptional(u uuid.UUID) *MessageWithProto3OptionalsUpdate {
+	mwpu.mutation.SetUUIDOptional(u)
+	return mwpu
+}
+
+// ClearUUIDOptional clears the value of the "uuid_optional" field.
+func (mwpu *MessageWithProto3OptionalsUpdate) ClearUUIDOptional() *MessageWithProto3OptionalsUpdate {
+	mwpu.mutation.ClearUUIDOptional()
+	return mwpu
+}
+
+// SetTimeOptional sets the "time_optional" field.
+func (mwpu *MessageWithProto3OptionalsUpdate) SetTimeOptional(t time.Time) *MessageWithProto3OptionalsUpdate {
+	mwpu.mutation.SetTimeOptional(t)
+	return mwpu
+}
+
+// SetNillableTimeOptional sets the "time_optional" field if the given value is not nil.
+func (mwpu *MessageWithProto3OptionalsUpdate) SetNillableTimeOptional(t *time.Time) *MessageWithProto3OptionalsUpdate {
+	if t != nil {
+		mwpu.SetTimeOptional(*t)
+	}
+	return mwpu
+}
+
+// ClearTimeOptional clears the value of the "time_optional" field.
+func (mwpu *MessageWithProto3OptionalsUpdate) ClearTimeOptional() *MessageWithProto3OptionalsUpdate {
+	mwpu.mutation.ClearTimeOptional()
+	return mwpu
+}
+
+// SetStr sets the "str" field.
+func (mwpu *MessageWithProto3OptionalsUpdate) SetStr(s string) *MessageWithProto3OptionalsUpdate {
+	mwpu.mutation.SetStr(s)
+	return mwpu
+}
+
+// Mutation returns the MessageWithProto3OptionalsMutation object of the builder.
+func (mwpu *MessageWithProto3OptionalsUpdate) Mutation() *MessageWithProto3OptionalsMutation {
+	return mwpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mwpu *MessageWithProto3OptionalsUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(mwpu.hooks) == 0 {
+		affected, err = mwpu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MessageWithProto3OptionalsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mwpu.mutation = mutation
+			affected, err = mwpu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(mwpu.hooks) - 1; i >= 0; i-- {
+			if mwpu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mwpu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mwpu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwpu *MessageWithProto3OptionalsUpdate) SaveX(ctx context.Context) int {
+	affected, err := mwpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mwpu *MessageWithProto3OptionalsUpdate) Exec(ctx context.Context) error {
+	_, err := mwpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwpu *MessageWithProto3OptionalsUpdate) ExecX(ctx context.Context) {
+	if err := mwpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mwpu *MessageWithProto3OptionalsUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   messagewithproto3optionals.Table,
+			Columns: messagewithproto3optionals.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: messagewithproto3optionals.FieldID,
+			},
+		},
+	}
+	if ps := mwpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mwpu.mutation.StrOptional(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldStrOptional,
+		})
+	}
+	if mwpu.mutation.StrOptionalCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: messagewithproto3optionals.FieldStrOptional,
+		})
+	}
+	if value, ok := mwpu.mutation.IntOptional(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldIntOptional,
+		})
+	}
+	if value, ok := mwpu.mutation.AddedIntOptional(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldIntOptional,
+		})
+	}
+	if mwpu.mutation.IntOptionalCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Column: messagewithproto3optionals.FieldIntOptional,
+		})
+	}
+	if value, ok := mwpu.mutation.UUIDOptional(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldUUIDOptional,
+		})
+	}
+	if mwpu.mutation.UUIDOptionalCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: messagewithproto3optionals.FieldUUIDOptional,
+		})
+	}
+	if value, ok := mwpu.mutation.TimeOptional(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldTimeOptional,
+		})
+	}
+	if mwpu.mutation.TimeOptionalCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: messagewithproto3optionals.FieldTimeOptional,
+		})
+	}
+	if value, ok := mwpu.mutation.Str(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldStr,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mwpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagewithproto3optionals.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// MessageWithProto3OptionalsUpdateOne is the builder for updating a single MessageWithProto3Optionals entity.
+type MessageWithProto3OptionalsUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MessageWithProto3OptionalsMutation
+}
+
+// SetStrOptional sets the "str_optional" field.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) SetStrOptional(s string) *MessageWithProto3OptionalsUpdateOne {
+	mwpuo.mutation.SetStrOptional(s)
+	return mwpuo
+}
+
+// SetNillableStrOptional sets the "str_optional" field if the given value is not nil.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) SetNillableStrOptional(s *string) *MessageWithProto3OptionalsUpdateOne {
+	if s != nil {
+		mwpuo.SetStrOptional(*s)
+	}
+	return mwpuo
+}
+
+// ClearStrOptional clears the value of the "str_optional" field.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) ClearStrOptional() *MessageWithProto3OptionalsUpdateOne {
+	mwpuo.mutation.ClearStrOptional()
+	return mwpuo
+}
+
+// SetIntOptional sets the "int_optional" field.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) SetIntOptional(i int8) *MessageWithProto3OptionalsUpdateOne {
+	mwpuo.mutation.ResetIntOptional()
+	mwpuo.mutation.SetIntOptional(i)
+	return mwpuo
+}
+
+// SetNillableIntOptional sets the "int_optional" field if the given value is not nil.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) SetNillableIntOptional(i *int8) *MessageWithProto3OptionalsUpdateOne {
+	if i != nil {
+		mwpuo.SetIntOptional(*i)
+	}
+	return mwpuo
+}
+
+// AddIntOptional adds i to the "int_optional" field.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) AddIntOptional(i int8) *MessageWithProto3OptionalsUpdateOne {
+	mwpuo.mutation.AddIntOptional(i)
+	return mwpuo
+}
+
+// ClearIntOptional clears the value of the "int_optional" field.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) ClearIntOptional() *MessageWithProto3OptionalsUpdateOne {
+	mwpuo.mutation.ClearIntOptional()
+	return mwpuo
+}
+
+// SetUUIDOptional sets the "uuid_optional" field.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) SetUUIDOptional(u uuid.UUID) *MessageWithProto3OptionalsUpdateOne {
+	mwpuo.mutation.SetUUIDOptional(u)
+	return mwpuo
+}
+
+// ClearUUIDOptional clears the value of the "uuid_optional" field.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) ClearUUIDOptional() *MessageWithProto3OptionalsUpdateOne {
+	mwpuo.mutation.ClearUUIDOptional()
+	return mwpuo
+}
+
+// SetTimeOptional sets the "time_optional" field.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) SetTimeOptional(t time.Time) *MessageWithProto3OptionalsUpdateOne {
+	mwpuo.mutation.SetTimeOptional(t)
+	return mwpuo
+}
+
+// SetNillableTimeOptional sets the "time_optional" field if the given value is not nil.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) SetNillableTimeOptional(t *time.Time) *MessageWithProto3OptionalsUpdateOne {
+	if t != nil {
+		mwpuo.SetTimeOptional(*t)
+	}
+	return mwpuo
+}
+
+// ClearTimeOptional clears the value of the "time_optional" field.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) ClearTimeOptional() *MessageWithProto3OptionalsUpdateOne {
+	mwpuo.mutation.ClearTimeOptional()
+	return mwpuo
+}
+
+// SetStr sets the "str" field.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) SetStr(s string) *MessageWithProto3OptionalsUpdateOne {
+	mwpuo.mutation.SetStr(s)
+	return mwpuo
+}
+
+// Mutation returns the MessageWithProto3OptionalsMutation object of the builder.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) Mutation() *MessageWithProto3OptionalsMutation {
+	return mwpuo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) Select(field string, fields ...string) *MessageWithProto3OptionalsUpdateOne {
+	mwpuo.fields = append([]string{field}, fields...)
+	return mwpuo
+}
+
+// Save executes the query and returns the updated MessageWithProto3Optionals entity.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) Save(ctx context.Context) (*MessageWithProto3Optionals, error) {
+	var (
+		err  error
+		node *MessageWithProto3Optionals
+	)
+	if len(mwpuo.hooks) == 0 {
+		node, err = mwpuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*MessageWithProto3OptionalsMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			mwpuo.mutation = mutation
+			node, err = mwpuo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(mwpuo.hooks) - 1; i >= 0; i-- {
+			if mwpuo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = mwpuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, mwpuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) SaveX(ctx context.Context) *MessageWithProto3Optionals {
+	node, err := mwpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) Exec(ctx context.Context) error {
+	_, err := mwpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) ExecX(ctx context.Context) {
+	if err := mwpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (mwpuo *MessageWithProto3OptionalsUpdateOne) sqlSave(ctx context.Context) (_node *MessageWithProto3Optionals, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   messagewithproto3optionals.Table,
+			Columns: messagewithproto3optionals.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: messagewithproto3optionals.FieldID,
+			},
+		},
+	}
+	id, ok := mwpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "ID", err: fmt.Errorf("missing MessageWithProto3Optionals.ID for update")}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mwpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagewithproto3optionals.FieldID)
+		for _, f := range fields {
+			if !messagewithproto3optionals.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagewithproto3optionals.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mwpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mwpuo.mutation.StrOptional(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldStrOptional,
+		})
+	}
+	if mwpuo.mutation.StrOptionalCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: messagewithproto3optionals.FieldStrOptional,
+		})
+	}
+	if value, ok := mwpuo.mutation.IntOptional(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldIntOptional,
+		})
+	}
+	if value, ok := mwpuo.mutation.AddedIntOptional(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldIntOptional,
+		})
+	}
+	if mwpuo.mutation.IntOptionalCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Column: messagewithproto3optionals.FieldIntOptional,
+		})
+	}
+	if value, ok := mwpuo.mutation.UUIDOptional(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldUUIDOptional,
+		})
+	}
+	if mwpuo.mutation.UUIDOptionalCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: messagewithproto3optionals.FieldUUIDOptional,
+		})
+	}
+	if value, ok := mwpuo.mutation.TimeOptional(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldTimeOptional,
+		})
+	}
+	if mwpuo.mutation.TimeOptionalCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: messagewithproto3optionals.FieldTimeOptional,
+		})
+	}
+	if value, ok := mwpuo.mutation.Str(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: messagewithproto3optionals.FieldStr,
+		})
+	}
+	_node = &MessageWithProto3Optionals{config: mwpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mwpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagewithproto3optionals.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
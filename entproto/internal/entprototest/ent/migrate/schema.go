@@ -108,7 +108,7 @@ var (
 	// InvalidFieldMessagesColumns holds the columns for the "invalid_field_messages" table.
 	InvalidFieldMessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
-		{Name: "json", Type: field.TypeJSON},
+		{Name: "other", Type: field.TypeOther, SchemaType: map[string]string{"sqlite3": "text"}},
 	}
 	// InvalidFieldMessagesTable holds the schema information for the "invalid_field_messages" table.
 	InvalidFieldMessagesTable = &schema.Table{
@@ -116,6 +116,18 @@ var (
 		Columns:    InvalidFieldMessagesColumns,
 		PrimaryKey: []*schema.Column{InvalidFieldMessagesColumns[0]},
 	}
+	// MessageWithConvertersColumns holds the columns for the "message_with_converters" table.
+	MessageWithConvertersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "duration", Type: field.TypeInt64},
+		{Name: "other", Type: field.TypeOther, SchemaType: map[string]string{"sqlite3": "text"}},
+	}
+	// MessageWithConvertersTable holds the schema information for the "message_with_converters" table.
+	MessageWithConvertersTable = &schema.Table{
+		Name:       "message_with_converters",
+		Columns:    MessageWithConvertersColumns,
+		PrimaryKey: []*schema.Column{MessageWithConvertersColumns[0]},
+	}
 	// MessageWithEnumsColumns holds the columns for the "message_with_enums" table.
 	MessageWithEnumsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -149,6 +161,24 @@ var (
 		Columns:    MessageWithIdsColumns,
 		PrimaryKey: []*schema.Column{MessageWithIdsColumns[0]},
 	}
+	// MessageWithJsoNsColumns holds the columns for the "message_with_jso_ns" table.
+	MessageWithJsoNsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "strings", Type: field.TypeJSON},
+		{Name: "ints", Type: field.TypeJSON},
+		{Name: "floats", Type: field.TypeJSON, Nullable: true},
+		{Name: "object", Type: field.TypeJSON},
+		{Name: "list", Type: field.TypeJSON},
+		{Name: "value", Type: field.TypeJSON},
+		{Name: "raw", Type: field.TypeJSON},
+		{Name: "named", Type: field.TypeJSON},
+	}
+	// MessageWithJsoNsTable holds the schema information for the "message_with_jso_ns" table.
+	MessageWithJsoNsTable = &schema.Table{
+		Name:       "message_with_jso_ns",
+		Columns:    MessageWithJsoNsColumns,
+		PrimaryKey: []*schema.Column{MessageWithJsoNsColumns[0]},
+	}
 	// MessageWithOptionalsColumns holds the columns for the "message_with_optionals" table.
 	MessageWithOptionalsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -178,6 +208,21 @@ var (
 		Columns:    MessageWithPackageNamesColumns,
 		PrimaryKey: []*schema.Column{MessageWithPackageNamesColumns[0]},
 	}
+	// MessageWithProto3optionalsColumns holds the columns for the "message_with_proto3optionals" table.
+	MessageWithProto3optionalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "str_optional", Type: field.TypeString, Nullable: true},
+		{Name: "int_optional", Type: field.TypeInt8, Nullable: true},
+		{Name: "uuid_optional", Type: field.TypeUUID, Nullable: true},
+		{Name: "time_optional", Type: field.TypeTime, Nullable: true},
+		{Name: "str", Type: field.TypeString},
+	}
+	// MessageWithProto3optionalsTable holds the schema information for the "message_with_proto3optionals" table.
+	MessageWithProto3optionalsTable = &schema.Table{
+		Name:       "message_with_proto3optionals",
+		Columns:    MessageWithProto3optionalsColumns,
+		PrimaryKey: []*schema.Column{MessageWithProto3optionalsColumns[0]},
+	}
 	// PortalsColumns holds the columns for the "portals" table.
 	PortalsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -270,11 +315,14 @@ var (
 		ImagesTable,
 		ImplicitSkippedMessagesTable,
 		InvalidFieldMessagesTable,
+		MessageWithConvertersTable,
 		MessageWithEnumsTable,
 		MessageWithFieldOnesTable,
 		MessageWithIdsTable,
+		MessageWithJsoNsTable,
 		MessageWithOptionalsTable,
 		MessageWithPackageNamesTable,
+		MessageWithProto3optionalsTable,
 		PortalsTable,
 		UsersTable,
 		ValidMessagesTable,
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json/jsontext"
 	"fmt"
 	"sync"
 	"time"
@@ -14,15 +15,19 @@ import (
 	"entgo.io/contrib/entproto/internal/entprototest/ent/duplicatenumbermessage"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/image"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/invalidfieldmessage"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithconverter"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithenum"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithfieldone"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithjson"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithoptionals"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithpackagename"
+	"entgo.io/contrib/entproto/internal/entprototest/ent/messagewithproto3optionals"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/portal"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/predicate"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/schema"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/user"
 	"entgo.io/contrib/entproto/internal/entprototest/ent/validmessage"
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 
 	"entgo.io/ent"
@@ -37,22 +42,25 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeBlogPost               = "BlogPost"
-	TypeCategory               = "Category"
-	TypeDependsOnSkipped       = "DependsOnSkipped"
-	TypeDuplicateNumberMessage = "DuplicateNumberMessage"
-	TypeExplicitSkippedMessage = "ExplicitSkippedMessage"
-	TypeImage                  = "Image"
-	TypeImplicitSkippedMessage = "ImplicitSkippedMessage"
-	TypeInvalidFieldMessage    = "InvalidFieldMessage"
-	TypeMessageWithEnum        = "MessageWithEnum"
-	TypeMessageWithFieldOne    = "MessageWithFieldOne"
-	TypeMessageWithID          = "MessageWithID"
-	TypeMessageWithOptionals   = "MessageWithOptionals"
-	TypeMessageWithPackageName = "MessageWithPackageName"
-	TypePortal                 = "Portal"
-	TypeUser                   = "User"
-	TypeValidMessage           = "ValidMessage"
+	TypeBlogPost                   = "BlogPost"
+	TypeCategory                   = "Category"
+	TypeDependsOnSkipped           = "DependsOnSkipped"
+	TypeDuplicateNumberMessage     = "DuplicateNumberMessage"
+	TypeExplicitSkippedMessage     = "ExplicitSkippedMessage"
+	TypeImage                      = "Image"
+	TypeImplicitSkippedMessage     = "ImplicitSkippedMessage"
+	TypeInvalidFieldMessage        = "InvalidFieldMessage"
+	TypeMessageWithConverter       = "MessageWithConverter"
+	TypeMessageWithEnum            = "MessageWithEnum"
+	TypeMessageWithFieldOne        = "MessageWithFieldOne"
+	TypeMessageWithID              = "MessageWithID"
+	TypeMessageWithJSON            = "MessageWithJSON"
+	TypeMessageWithOptionals       = "MessageWithOptionals"
+	TypeMessageWithPackageName     = "MessageWithPackageName"
+	TypeMessageWithProto3Optionals = "MessageWithProto3Optionals"
+	TypePortal                     = "Portal"
+	TypeUser                       = "User"
+	TypeValidMessage               = "ValidMessage"
 )
 
 // BlogPostMutation represents an operation that mutates the BlogPost nodes in the graph.
@@ -2670,7 +2678,7 @@ type InvalidFieldMessageMutation struct {
 	op            Op
 	typ           string
 	id            *int
-	json          **schema.SomeJSON
+	other         **sql.NullString
 	clearedFields map[string]struct{}
 	done          bool
 	oldValue      func(context.Context) (*InvalidFieldMessage, error)
@@ -2756,40 +2764,40 @@ func (m *InvalidFieldMessageMutation) ID() (id int, exists bool) {
 	return *m.id, true
 }
 
-// SetJSON sets the "json" field.
-func (m *InvalidFieldMessageMutation) SetJSON(sj *schema.SomeJSON) {
-	m.json = &sj
+// SetOther sets the "other" field.
+func (m *InvalidFieldMessageMutation) SetOther(ss *sql.NullString) {
+	m.other = &ss
 }
 
-// JSON returns the value of the "json" field in the mutation.
-func (m *InvalidFieldMessageMutation) JSON() (r *schema.SomeJSON, exists bool) {
-	v := m.json
+// Other returns the value of the "other" field in the mutation.
+func (m *InvalidFieldMessageMutation) Other() (r *sql.NullString, exists bool) {
+	v := m.other
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJSON returns the old "json" field's value of the InvalidFieldMessage entity.
+// OldOther returns the old "other" field's value of the InvalidFieldMessage entity.
 // If the InvalidFieldMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InvalidFieldMessageMutation) OldJSON(ctx context.Context) (v *schema.SomeJSON, err error) {
+func (m *InvalidFieldMessageMutation) OldOther(ctx context.Context) (v *sql.NullString, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldJSON is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldOther is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldJSON requires an ID field in the mutation")
+		return v, fmt.Errorf("OldOther requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJSON: %w", err)
+		return v, fmt.Errorf("querying old value for OldOther: %w", err)
 	}
-	return oldValue.JSON, nil
+	return oldValue.Other, nil
 }
 
-// ResetJSON resets all changes to the "json" field.
-func (m *InvalidFieldMessageMutation) ResetJSON() {
-	m.json = nil
+// ResetOther resets all changes to the "other" field.
+func (m *InvalidFieldMessageMutation) ResetOther() {
+	m.other = nil
 }
 
 // Where appends a list predicates to the InvalidFieldMessageMutation builder.
@@ -2812,8 +2820,8 @@ func (m *InvalidFieldMessageMutation) Type() string {
 // AddedFields().
 func (m *InvalidFieldMessageMutation) Fields() []string {
 	fields := make([]string, 0, 1)
-	if m.json != nil {
-		fields = append(fields, invalidfieldmessage.FieldJSON)
+	if m.other != nil {
+		fields = append(fields, invalidfieldmessage.FieldOther)
 	}
 	return fields
 }
@@ -2823,8 +2831,8 @@ func (m *InvalidFieldMessageMutation) Fields() []string {
 // schema.
 func (m *InvalidFieldMessageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case invalidfieldmessage.FieldJSON:
-		return m.JSON()
+	case invalidfieldmessage.FieldOther:
+		return m.Other()
 	}
 	return nil, false
 }
@@ -2834,8 +2842,8 @@ func (m *InvalidFieldMessageMutation) Field(name string) (ent.Value, bool) {
 // database failed.
 func (m *InvalidFieldMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case invalidfieldmessage.FieldJSON:
-		return m.OldJSON(ctx)
+	case invalidfieldmessage.FieldOther:
+		return m.OldOther(ctx)
 	}
 	return nil, fmt.Errorf("unknown InvalidFieldMessage field %s", name)
 }
@@ -2845,12 +2853,12 @@ func (m *InvalidFieldMessageMutation) OldField(ctx context.Context, name string)
 // type.
 func (m *InvalidFieldMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case invalidfieldmessage.FieldJSON:
-		v, ok := value.(*schema.SomeJSON)
+	case invalidfieldmessage.FieldOther:
+		v, ok := value.(*sql.NullString)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJSON(v)
+		m.SetOther(v)
 		return nil
 	}
 	return fmt.Errorf("unknown InvalidFieldMessage field %s", name)
@@ -2901,8 +2909,8 @@ func (m *InvalidFieldMessageMutation) ClearField(name string) error {
 // It returns an error if the field is not defined in the schema.
 func (m *InvalidFieldMessageMutation) ResetField(name string) error {
 	switch name {
-	case invalidfieldmessage.FieldJSON:
-		m.ResetJSON()
+	case invalidfieldmessage.FieldOther:
+		m.ResetOther()
 		return nil
 	}
 	return fmt.Errorf("unknown InvalidFieldMessage field %s", name)
@@ -2956,6 +2964,388 @@ func (m *InvalidFieldMessageMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown InvalidFieldMessage edge %s", name)
 }
 
+// MessageWithConverterMutation represents an operation that mutates the MessageWithConverter nodes in the graph.
+type MessageWithConverterMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	duration      *time.Duration
+	addduration   *time.Duration
+	other         **sql.NullString
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MessageWithConverter, error)
+	predicates    []predicate.MessageWithConverter
+}
+
+var _ ent.Mutation = (*MessageWithConverterMutation)(nil)
+
+// messagewithconverterOption allows management of the mutation configuration using functional options.
+type messagewithconverterOption func(*MessageWithConverterMutation)
+
+// newMessageWithConverterMutation creates new mutation for the MessageWithConverter entity.
+func newMessageWithConverterMutation(c config, op Op, opts ...messagewithconverterOption) *MessageWithConverterMutation {
+	m := &MessageWithConverterMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageWithConverter,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageWithConverterID sets the ID field of the mutation.
+func withMessageWithConverterID(id int) messagewithconverterOption {
+	return func(m *MessageWithConverterMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageWithConverter
+		)
+		m.oldValue = func(ctx context.Context) (*MessageWithConverter, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageWithConverter.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageWithConverter sets the old MessageWithConverter of the mutation.
+func withMessageWithConverter(node *MessageWithConverter) messagewithconverterOption {
+	return func(m *MessageWithConverterMutation) {
+		m.oldValue = func(context.Context) (*MessageWithConverter, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageWithConverterMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageWithConverterMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageWithConverterMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetDuration sets the "duration" field.
+func (m *MessageWithConverterMutation) SetDuration(t time.Duration) {
+	m.duration = &t
+	m.addduration = nil
+}
+
+// Duration returns the value of the "duration" field in the mutation.
+func (m *MessageWithConverterMutation) Duration() (r time.Duration, exists bool) {
+	v := m.duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDuration returns the old "duration" field's value of the MessageWithConverter entity.
+// If the MessageWithConverter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithConverterMutation) OldDuration(ctx context.Context) (v time.Duration, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldDuration is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldDuration requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDuration: %w", err)
+	}
+	return oldValue.Duration, nil
+}
+
+// AddDuration adds t to the "duration" field.
+func (m *MessageWithConverterMutation) AddDuration(t time.Duration) {
+	if m.addduration != nil {
+		*m.addduration += t
+	} else {
+		m.addduration = &t
+	}
+}
+
+// AddedDuration returns the value that was added to the "duration" field in this mutation.
+func (m *MessageWithConverterMutation) AddedDuration() (r time.Duration, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDuration resets all changes to the "duration" field.
+func (m *MessageWithConverterMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
+}
+
+// SetOther sets the "other" field.
+func (m *MessageWithConverterMutation) SetOther(ss *sql.NullString) {
+	m.other = &ss
+}
+
+// Other returns the value of the "other" field in the mutation.
+func (m *MessageWithConverterMutation) Other() (r *sql.NullString, exists bool) {
+	v := m.other
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOther returns the old "other" field's value of the MessageWithConverter entity.
+// If the MessageWithConverter object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithConverterMutation) OldOther(ctx context.Context) (v *sql.NullString, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldOther is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldOther requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOther: %w", err)
+	}
+	return oldValue.Other, nil
+}
+
+// ResetOther resets all changes to the "other" field.
+func (m *MessageWithConverterMutation) ResetOther() {
+	m.other = nil
+}
+
+// Where appends a list predicates to the MessageWithConverterMutation builder.
+func (m *MessageWithConverterMutation) Where(ps ...predicate.MessageWithConverter) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *MessageWithConverterMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (MessageWithConverter).
+func (m *MessageWithConverterMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageWithConverterMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.duration != nil {
+		fields = append(fields, messagewithconverter.FieldDuration)
+	}
+	if m.other != nil {
+		fields = append(fields, messagewithconverter.FieldOther)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageWithConverterMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagewithconverter.FieldDuration:
+		return m.Duration()
+	case messagewithconverter.FieldOther:
+		return m.Other()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageWithConverterMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagewithconverter.FieldDuration:
+		return m.OldDuration(ctx)
+	case messagewithconverter.FieldOther:
+		return m.OldOther(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageWithConverter field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithConverterMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagewithconverter.FieldDuration:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
+	case messagewithconverter.FieldOther:
+		v, ok := value.(*sql.NullString)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOther(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithConverter field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageWithConverterMutation) AddedFields() []string {
+	var fields []string
+	if m.addduration != nil {
+		fields = append(fields, messagewithconverter.FieldDuration)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageWithConverterMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messagewithconverter.FieldDuration:
+		return m.AddedDuration()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithConverterMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case messagewithconverter.FieldDuration:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDuration(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithConverter numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageWithConverterMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageWithConverterMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageWithConverterMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageWithConverter nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageWithConverterMutation) ResetField(name string) error {
+	switch name {
+	case messagewithconverter.FieldDuration:
+		m.ResetDuration()
+		return nil
+	case messagewithconverter.FieldOther:
+		m.ResetOther()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithConverter field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageWithConverterMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageWithConverterMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageWithConverterMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageWithConverterMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageWithConverterMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageWithConverterMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageWithConverterMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithConverter unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageWithConverterMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithConverter edge %s", name)
+}
+
 // MessageWithEnumMutation represents an operation that mutates the MessageWithEnum nodes in the graph.
 type MessageWithEnumMutation struct {
 	config
@@ -3866,40 +4256,37 @@ func (m *MessageWithIDMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown MessageWithID edge %s", name)
 }
 
-// MessageWithOptionalsMutation represents an operation that mutates the MessageWithOptionals nodes in the graph.
-type MessageWithOptionalsMutation struct {
+// MessageWithJSONMutation represents an operation that mutates the MessageWithJSON nodes in the graph.
+type MessageWithJSONMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	str_optional      *string
-	int_optional      *int8
-	addint_optional   *int8
-	uint_optional     *uint8
-	adduint_optional  *uint8
-	float_optional    *float32
-	addfloat_optional *float32
-	bool_optional     *bool
-	bytes_optional    *[]byte
-	uuid_optional     *uuid.UUID
-	time_optional     *time.Time
-	clearedFields     map[string]struct{}
-	done              bool
-	oldValue          func(context.Context) (*MessageWithOptionals, error)
-	predicates        []predicate.MessageWithOptionals
+	op            Op
+	typ           string
+	id            *int
+	strings       *[]string
+	ints          *[]int
+	floats        *[]float64
+	object        *map[string]interface{}
+	list          *[]schema.SomeJSON
+	value         **schema.SomeJSON
+	raw           *jsontext.Value
+	named         **schema.Address
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MessageWithJSON, error)
+	predicates    []predicate.MessageWithJSON
 }
 
-var _ ent.Mutation = (*MessageWithOptionalsMutation)(nil)
+var _ ent.Mutation = (*MessageWithJSONMutation)(nil)
 
-// messagewithoptionalsOption allows management of the mutation configuration using functional options.
-type messagewithoptionalsOption func(*MessageWithOptionalsMutation)
+// messagewithjsonOption allows management of the mutation configuration using functional options.
+type messagewithjsonOption func(*MessageWithJSONMutation)
 
-// newMessageWithOptionalsMutation creates new mutation for the MessageWithOptionals entity.
-func newMessageWithOptionalsMutation(c config, op Op, opts ...messagewithoptionalsOption) *MessageWithOptionalsMutation {
-	m := &MessageWithOptionalsMutation{
+// newMessageWithJSONMutation creates new mutation for the MessageWithJSON entity.
+func newMessageWithJSONMutation(c config, op Op, opts ...messagewithjsonOption) *MessageWithJSONMutation {
+	m := &MessageWithJSONMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessageWithOptionals,
+		typ:           TypeMessageWithJSON,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3908,20 +4295,20 @@ func newMessageWithOptionalsMutation(c config, op Op, opts ...messagewithoptiona
 	return m
 }
 
-// withMessageWithOptionalsID sets the ID field of the mutation.
-func withMessageWithOptionalsID(id int) messagewithoptionalsOption {
-	return func(m *MessageWithOptionalsMutation) {
+// withMessageWithJSONID sets the ID field of the mutation.
+func withMessageWithJSONID(id int) messagewithjsonOption {
+	return func(m *MessageWithJSONMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessageWithOptionals
+			value *MessageWithJSON
 		)
-		m.oldValue = func(ctx context.Context) (*MessageWithOptionals, error) {
+		m.oldValue = func(ctx context.Context) (*MessageWithJSON, error) {
 			once.Do(func() {
 				if m.done {
 					err = fmt.Errorf("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessageWithOptionals.Get(ctx, id)
+					value, err = m.Client().MessageWithJSON.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3930,10 +4317,10 @@ func withMessageWithOptionalsID(id int) messagewithoptionalsOption {
 	}
 }
 
-// withMessageWithOptionals sets the old MessageWithOptionals of the mutation.
-func withMessageWithOptionals(node *MessageWithOptionals) messagewithoptionalsOption {
-	return func(m *MessageWithOptionalsMutation) {
-		m.oldValue = func(context.Context) (*MessageWithOptionals, error) {
+// withMessageWithJSON sets the old MessageWithJSON of the mutation.
+func withMessageWithJSON(node *MessageWithJSON) messagewithjsonOption {
+	return func(m *MessageWithJSONMutation) {
+		m.oldValue = func(context.Context) (*MessageWithJSON, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3942,7 +4329,7 @@ func withMessageWithOptionals(node *MessageWithOptionals) messagewithoptionalsOp
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageWithOptionalsMutation) Client() *Client {
+func (m MessageWithJSONMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3950,7 +4337,7 @@ func (m MessageWithOptionalsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageWithOptionalsMutation) Tx() (*Tx, error) {
+func (m MessageWithJSONMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -3961,511 +4348,357 @@ func (m MessageWithOptionalsMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageWithOptionalsMutation) ID() (id int, exists bool) {
+func (m *MessageWithJSONMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
 	return *m.id, true
 }
 
-// SetStrOptional sets the "str_optional" field.
-func (m *MessageWithOptionalsMutation) SetStrOptional(s string) {
-	m.str_optional = &s
+// SetStrings sets the "strings" field.
+func (m *MessageWithJSONMutation) SetStrings(s []string) {
+	m.strings = &s
 }
 
-// StrOptional returns the value of the "str_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) StrOptional() (r string, exists bool) {
-	v := m.str_optional
+// Strings returns the value of the "strings" field in the mutation.
+func (m *MessageWithJSONMutation) Strings() (r []string, exists bool) {
+	v := m.strings
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStrOptional returns the old "str_optional" field's value of the MessageWithOptionals entity.
-// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// OldStrings returns the old "strings" field's value of the MessageWithJSON entity.
+// If the MessageWithJSON object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldStrOptional(ctx context.Context) (v string, err error) {
+func (m *MessageWithJSONMutation) OldStrings(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldStrOptional is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldStrings is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldStrOptional requires an ID field in the mutation")
+		return v, fmt.Errorf("OldStrings requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStrOptional: %w", err)
+		return v, fmt.Errorf("querying old value for OldStrings: %w", err)
 	}
-	return oldValue.StrOptional, nil
-}
-
-// ClearStrOptional clears the value of the "str_optional" field.
-func (m *MessageWithOptionalsMutation) ClearStrOptional() {
-	m.str_optional = nil
-	m.clearedFields[messagewithoptionals.FieldStrOptional] = struct{}{}
-}
-
-// StrOptionalCleared returns if the "str_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) StrOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldStrOptional]
-	return ok
+	return oldValue.Strings, nil
 }
 
-// ResetStrOptional resets all changes to the "str_optional" field.
-func (m *MessageWithOptionalsMutation) ResetStrOptional() {
-	m.str_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldStrOptional)
+// ResetStrings resets all changes to the "strings" field.
+func (m *MessageWithJSONMutation) ResetStrings() {
+	m.strings = nil
 }
 
-// SetIntOptional sets the "int_optional" field.
-func (m *MessageWithOptionalsMutation) SetIntOptional(i int8) {
-	m.int_optional = &i
-	m.addint_optional = nil
+// SetInts sets the "ints" field.
+func (m *MessageWithJSONMutation) SetInts(i []int) {
+	m.ints = &i
 }
 
-// IntOptional returns the value of the "int_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) IntOptional() (r int8, exists bool) {
-	v := m.int_optional
+// Ints returns the value of the "ints" field in the mutation.
+func (m *MessageWithJSONMutation) Ints() (r []int, exists bool) {
+	v := m.ints
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIntOptional returns the old "int_optional" field's value of the MessageWithOptionals entity.
-// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// OldInts returns the old "ints" field's value of the MessageWithJSON entity.
+// If the MessageWithJSON object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldIntOptional(ctx context.Context) (v int8, err error) {
+func (m *MessageWithJSONMutation) OldInts(ctx context.Context) (v []int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldIntOptional is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldInts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldIntOptional requires an ID field in the mutation")
+		return v, fmt.Errorf("OldInts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIntOptional: %w", err)
+		return v, fmt.Errorf("querying old value for OldInts: %w", err)
 	}
-	return oldValue.IntOptional, nil
+	return oldValue.Ints, nil
 }
 
-// AddIntOptional adds i to the "int_optional" field.
-func (m *MessageWithOptionalsMutation) AddIntOptional(i int8) {
-	if m.addint_optional != nil {
-		*m.addint_optional += i
-	} else {
-		m.addint_optional = &i
-	}
+// ResetInts resets all changes to the "ints" field.
+func (m *MessageWithJSONMutation) ResetInts() {
+	m.ints = nil
 }
 
-// AddedIntOptional returns the value that was added to the "int_optional" field in this mutation.
-func (m *MessageWithOptionalsMutation) AddedIntOptional() (r int8, exists bool) {
-	v := m.addint_optional
+// SetFloats sets the "floats" field.
+func (m *MessageWithJSONMutation) SetFloats(f []float64) {
+	m.floats = &f
+}
+
+// Floats returns the value of the "floats" field in the mutation.
+func (m *MessageWithJSONMutation) Floats() (r []float64, exists bool) {
+	v := m.floats
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearIntOptional clears the value of the "int_optional" field.
-func (m *MessageWithOptionalsMutation) ClearIntOptional() {
-	m.int_optional = nil
-	m.addint_optional = nil
-	m.clearedFields[messagewithoptionals.FieldIntOptional] = struct{}{}
+// OldFloats returns the old "floats" field's value of the MessageWithJSON entity.
+// If the MessageWithJSON object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithJSONMutation) OldFloats(ctx context.Context) (v []float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldFloats is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldFloats requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFloats: %w", err)
+	}
+	return oldValue.Floats, nil
 }
 
-// IntOptionalCleared returns if the "int_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) IntOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldIntOptional]
+// ClearFloats clears the value of the "floats" field.
+func (m *MessageWithJSONMutation) ClearFloats() {
+	m.floats = nil
+	m.clearedFields[messagewithjson.FieldFloats] = struct{}{}
+}
+
+// FloatsCleared returns if the "floats" field was cleared in this mutation.
+func (m *MessageWithJSONMutation) FloatsCleared() bool {
+	_, ok := m.clearedFields[messagewithjson.FieldFloats]
 	return ok
 }
 
-// ResetIntOptional resets all changes to the "int_optional" field.
-func (m *MessageWithOptionalsMutation) ResetIntOptional() {
-	m.int_optional = nil
-	m.addint_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldIntOptional)
+// ResetFloats resets all changes to the "floats" field.
+func (m *MessageWithJSONMutation) ResetFloats() {
+	m.floats = nil
+	delete(m.clearedFields, messagewithjson.FieldFloats)
 }
 
-// SetUintOptional sets the "uint_optional" field.
-func (m *MessageWithOptionalsMutation) SetUintOptional(u uint8) {
-	m.uint_optional = &u
-	m.adduint_optional = nil
+// SetObject sets the "object" field.
+func (m *MessageWithJSONMutation) SetObject(value map[string]interface{}) {
+	m.object = &value
 }
 
-// UintOptional returns the value of the "uint_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) UintOptional() (r uint8, exists bool) {
-	v := m.uint_optional
+// Object returns the value of the "object" field in the mutation.
+func (m *MessageWithJSONMutation) Object() (r map[string]interface{}, exists bool) {
+	v := m.object
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUintOptional returns the old "uint_optional" field's value of the MessageWithOptionals entity.
-// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// OldObject returns the old "object" field's value of the MessageWithJSON entity.
+// If the MessageWithJSON object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldUintOptional(ctx context.Context) (v uint8, err error) {
+func (m *MessageWithJSONMutation) OldObject(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldUintOptional is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldObject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldUintOptional requires an ID field in the mutation")
+		return v, fmt.Errorf("OldObject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUintOptional: %w", err)
+		return v, fmt.Errorf("querying old value for OldObject: %w", err)
 	}
-	return oldValue.UintOptional, nil
+	return oldValue.Object, nil
 }
 
-// AddUintOptional adds u to the "uint_optional" field.
-func (m *MessageWithOptionalsMutation) AddUintOptional(u uint8) {
-	if m.adduint_optional != nil {
-		*m.adduint_optional += u
-	} else {
-		m.adduint_optional = &u
-	}
+// ResetObject resets all changes to the "object" field.
+func (m *MessageWithJSONMutation) ResetObject() {
+	m.object = nil
 }
 
-// AddedUintOptional returns the value that was added to the "uint_optional" field in this mutation.
-func (m *MessageWithOptionalsMutation) AddedUintOptional() (r uint8, exists bool) {
-	v := m.adduint_optional
+// SetList sets the "list" field.
+func (m *MessageWithJSONMutation) SetList(sj []schema.SomeJSON) {
+	m.list = &sj
+}
+
+// List returns the value of the "list" field in the mutation.
+func (m *MessageWithJSONMutation) List() (r []schema.SomeJSON, exists bool) {
+	v := m.list
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearUintOptional clears the value of the "uint_optional" field.
-func (m *MessageWithOptionalsMutation) ClearUintOptional() {
-	m.uint_optional = nil
-	m.adduint_optional = nil
-	m.clearedFields[messagewithoptionals.FieldUintOptional] = struct{}{}
-}
-
-// UintOptionalCleared returns if the "uint_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) UintOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldUintOptional]
-	return ok
+// OldList returns the old "list" field's value of the MessageWithJSON entity.
+// If the MessageWithJSON object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithJSONMutation) OldList(ctx context.Context) (v []schema.SomeJSON, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldList is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldList requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldList: %w", err)
+	}
+	return oldValue.List, nil
 }
 
-// ResetUintOptional resets all changes to the "uint_optional" field.
-func (m *MessageWithOptionalsMutation) ResetUintOptional() {
-	m.uint_optional = nil
-	m.adduint_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldUintOptional)
+// ResetList resets all changes to the "list" field.
+func (m *MessageWithJSONMutation) ResetList() {
+	m.list = nil
 }
 
-// SetFloatOptional sets the "float_optional" field.
-func (m *MessageWithOptionalsMutation) SetFloatOptional(f float32) {
-	m.float_optional = &f
-	m.addfloat_optional = nil
+// SetValue sets the "value" field.
+func (m *MessageWithJSONMutation) SetValue(sj *schema.SomeJSON) {
+	m.value = &sj
 }
 
-// FloatOptional returns the value of the "float_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) FloatOptional() (r float32, exists bool) {
-	v := m.float_optional
+// Value returns the value of the "value" field in the mutation.
+func (m *MessageWithJSONMutation) Value() (r *schema.SomeJSON, exists bool) {
+	v := m.value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFloatOptional returns the old "float_optional" field's value of the MessageWithOptionals entity.
-// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// OldValue returns the old "value" field's value of the MessageWithJSON entity.
+// If the MessageWithJSON object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldFloatOptional(ctx context.Context) (v float32, err error) {
+func (m *MessageWithJSONMutation) OldValue(ctx context.Context) (v *schema.SomeJSON, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldFloatOptional is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldFloatOptional requires an ID field in the mutation")
+		return v, fmt.Errorf("OldValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFloatOptional: %w", err)
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
 	}
-	return oldValue.FloatOptional, nil
+	return oldValue.Value, nil
 }
 
-// AddFloatOptional adds f to the "float_optional" field.
-func (m *MessageWithOptionalsMutation) AddFloatOptional(f float32) {
-	if m.addfloat_optional != nil {
-		*m.addfloat_optional += f
-	} else {
-		m.addfloat_optional = &f
-	}
+// ResetValue resets all changes to the "value" field.
+func (m *MessageWithJSONMutation) ResetValue() {
+	m.value = nil
 }
 
-// AddedFloatOptional returns the value that was added to the "float_optional" field in this mutation.
-func (m *MessageWithOptionalsMutation) AddedFloatOptional() (r float32, exists bool) {
-	v := m.addfloat_optional
+// SetRaw sets the "raw" field.
+func (m *MessageWithJSONMutation) SetRaw(j jsontext.Value) {
+	m.raw = &j
+}
+
+// Raw returns the value of the "raw" field in the mutation.
+func (m *MessageWithJSONMutation) Raw() (r jsontext.Value, exists bool) {
+	v := m.raw
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearFloatOptional clears the value of the "float_optional" field.
-func (m *MessageWithOptionalsMutation) ClearFloatOptional() {
-	m.float_optional = nil
-	m.addfloat_optional = nil
-	m.clearedFields[messagewithoptionals.FieldFloatOptional] = struct{}{}
-}
-
-// FloatOptionalCleared returns if the "float_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) FloatOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldFloatOptional]
-	return ok
+// OldRaw returns the old "raw" field's value of the MessageWithJSON entity.
+// If the MessageWithJSON object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithJSONMutation) OldRaw(ctx context.Context) (v jsontext.Value, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldRaw is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldRaw requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRaw: %w", err)
+	}
+	return oldValue.Raw, nil
 }
 
-// ResetFloatOptional resets all changes to the "float_optional" field.
-func (m *MessageWithOptionalsMutation) ResetFloatOptional() {
-	m.float_optional = nil
-	m.addfloat_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldFloatOptional)
+// ResetRaw resets all changes to the "raw" field.
+func (m *MessageWithJSONMutation) ResetRaw() {
+	m.raw = nil
 }
 
-// SetBoolOptional sets the "bool_optional" field.
-func (m *MessageWithOptionalsMutation) SetBoolOptional(b bool) {
-	m.bool_optional = &b
+// SetNamed sets the "named" field.
+func (m *MessageWithJSONMutation) SetNamed(s *schema.Address) {
+	m.named = &s
 }
 
-// BoolOptional returns the value of the "bool_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) BoolOptional() (r bool, exists bool) {
-	v := m.bool_optional
+// Named returns the value of the "named" field in the mutation.
+func (m *MessageWithJSONMutation) Named() (r *schema.Address, exists bool) {
+	v := m.named
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBoolOptional returns the old "bool_optional" field's value of the MessageWithOptionals entity.
-// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// OldNamed returns the old "named" field's value of the MessageWithJSON entity.
+// If the MessageWithJSON object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldBoolOptional(ctx context.Context) (v bool, err error) {
+func (m *MessageWithJSONMutation) OldNamed(ctx context.Context) (v *schema.Address, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldBoolOptional is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldNamed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldBoolOptional requires an ID field in the mutation")
+		return v, fmt.Errorf("OldNamed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBoolOptional: %w", err)
+		return v, fmt.Errorf("querying old value for OldNamed: %w", err)
 	}
-	return oldValue.BoolOptional, nil
+	return oldValue.Named, nil
 }
 
-// ClearBoolOptional clears the value of the "bool_optional" field.
-func (m *MessageWithOptionalsMutation) ClearBoolOptional() {
-	m.bool_optional = nil
-	m.clearedFields[messagewithoptionals.FieldBoolOptional] = struct{}{}
+// ResetNamed resets all changes to the "named" field.
+func (m *MessageWithJSONMutation) ResetNamed() {
+	m.named = nil
 }
 
-// BoolOptionalCleared returns if the "bool_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) BoolOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldBoolOptional]
-	return ok
+// Where appends a list predicates to the MessageWithJSONMutation builder.
+func (m *MessageWithJSONMutation) Where(ps ...predicate.MessageWithJSON) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetBoolOptional resets all changes to the "bool_optional" field.
-func (m *MessageWithOptionalsMutation) ResetBoolOptional() {
-	m.bool_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldBoolOptional)
+// Op returns the operation name.
+func (m *MessageWithJSONMutation) Op() Op {
+	return m.op
 }
 
-// SetBytesOptional sets the "bytes_optional" field.
-func (m *MessageWithOptionalsMutation) SetBytesOptional(b []byte) {
-	m.bytes_optional = &b
-}
-
-// BytesOptional returns the value of the "bytes_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) BytesOptional() (r []byte, exists bool) {
-	v := m.bytes_optional
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldBytesOptional returns the old "bytes_optional" field's value of the MessageWithOptionals entity.
-// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldBytesOptional(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldBytesOptional is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldBytesOptional requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBytesOptional: %w", err)
-	}
-	return oldValue.BytesOptional, nil
-}
-
-// ClearBytesOptional clears the value of the "bytes_optional" field.
-func (m *MessageWithOptionalsMutation) ClearBytesOptional() {
-	m.bytes_optional = nil
-	m.clearedFields[messagewithoptionals.FieldBytesOptional] = struct{}{}
-}
-
-// BytesOptionalCleared returns if the "bytes_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) BytesOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldBytesOptional]
-	return ok
-}
-
-// ResetBytesOptional resets all changes to the "bytes_optional" field.
-func (m *MessageWithOptionalsMutation) ResetBytesOptional() {
-	m.bytes_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldBytesOptional)
-}
-
-// SetUUIDOptional sets the "uuid_optional" field.
-func (m *MessageWithOptionalsMutation) SetUUIDOptional(u uuid.UUID) {
-	m.uuid_optional = &u
-}
-
-// UUIDOptional returns the value of the "uuid_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) UUIDOptional() (r uuid.UUID, exists bool) {
-	v := m.uuid_optional
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUUIDOptional returns the old "uuid_optional" field's value of the MessageWithOptionals entity.
-// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldUUIDOptional(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldUUIDOptional is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldUUIDOptional requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUUIDOptional: %w", err)
-	}
-	return oldValue.UUIDOptional, nil
-}
-
-// ClearUUIDOptional clears the value of the "uuid_optional" field.
-func (m *MessageWithOptionalsMutation) ClearUUIDOptional() {
-	m.uuid_optional = nil
-	m.clearedFields[messagewithoptionals.FieldUUIDOptional] = struct{}{}
-}
-
-// UUIDOptionalCleared returns if the "uuid_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) UUIDOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldUUIDOptional]
-	return ok
-}
-
-// ResetUUIDOptional resets all changes to the "uuid_optional" field.
-func (m *MessageWithOptionalsMutation) ResetUUIDOptional() {
-	m.uuid_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldUUIDOptional)
-}
-
-// SetTimeOptional sets the "time_optional" field.
-func (m *MessageWithOptionalsMutation) SetTimeOptional(t time.Time) {
-	m.time_optional = &t
-}
-
-// TimeOptional returns the value of the "time_optional" field in the mutation.
-func (m *MessageWithOptionalsMutation) TimeOptional() (r time.Time, exists bool) {
-	v := m.time_optional
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTimeOptional returns the old "time_optional" field's value of the MessageWithOptionals entity.
-// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithOptionalsMutation) OldTimeOptional(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldTimeOptional is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldTimeOptional requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimeOptional: %w", err)
-	}
-	return oldValue.TimeOptional, nil
-}
-
-// ClearTimeOptional clears the value of the "time_optional" field.
-func (m *MessageWithOptionalsMutation) ClearTimeOptional() {
-	m.time_optional = nil
-	m.clearedFields[messagewithoptionals.FieldTimeOptional] = struct{}{}
-}
-
-// TimeOptionalCleared returns if the "time_optional" field was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) TimeOptionalCleared() bool {
-	_, ok := m.clearedFields[messagewithoptionals.FieldTimeOptional]
-	return ok
-}
-
-// ResetTimeOptional resets all changes to the "time_optional" field.
-func (m *MessageWithOptionalsMutation) ResetTimeOptional() {
-	m.time_optional = nil
-	delete(m.clearedFields, messagewithoptionals.FieldTimeOptional)
-}
-
-// Where appends a list predicates to the MessageWithOptionalsMutation builder.
-func (m *MessageWithOptionalsMutation) Where(ps ...predicate.MessageWithOptionals) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// Op returns the operation name.
-func (m *MessageWithOptionalsMutation) Op() Op {
-	return m.op
-}
-
-// Type returns the node type of this mutation (MessageWithOptionals).
-func (m *MessageWithOptionalsMutation) Type() string {
-	return m.typ
+// Type returns the node type of this mutation (MessageWithJSON).
+func (m *MessageWithJSONMutation) Type() string {
+	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageWithOptionalsMutation) Fields() []string {
+func (m *MessageWithJSONMutation) Fields() []string {
 	fields := make([]string, 0, 8)
-	if m.str_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldStrOptional)
+	if m.strings != nil {
+		fields = append(fields, messagewithjson.FieldStrings)
 	}
-	if m.int_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldIntOptional)
+	if m.ints != nil {
+		fields = append(fields, messagewithjson.FieldInts)
 	}
-	if m.uint_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldUintOptional)
+	if m.floats != nil {
+		fields = append(fields, messagewithjson.FieldFloats)
 	}
-	if m.float_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldFloatOptional)
+	if m.object != nil {
+		fields = append(fields, messagewithjson.FieldObject)
 	}
-	if m.bool_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldBoolOptional)
+	if m.list != nil {
+		fields = append(fields, messagewithjson.FieldList)
 	}
-	if m.bytes_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldBytesOptional)
+	if m.value != nil {
+		fields = append(fields, messagewithjson.FieldValue)
 	}
-	if m.uuid_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldUUIDOptional)
+	if m.raw != nil {
+		fields = append(fields, messagewithjson.FieldRaw)
 	}
-	if m.time_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldTimeOptional)
+	if m.named != nil {
+		fields = append(fields, messagewithjson.FieldNamed)
 	}
 	return fields
 }
@@ -4473,24 +4706,24 @@ func (m *MessageWithOptionalsMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageWithOptionalsMutation) Field(name string) (ent.Value, bool) {
+func (m *MessageWithJSONMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case messagewithoptionals.FieldStrOptional:
-		return m.StrOptional()
-	case messagewithoptionals.FieldIntOptional:
-		return m.IntOptional()
-	case messagewithoptionals.FieldUintOptional:
-		return m.UintOptional()
-	case messagewithoptionals.FieldFloatOptional:
-		return m.FloatOptional()
-	case messagewithoptionals.FieldBoolOptional:
-		return m.BoolOptional()
-	case messagewithoptionals.FieldBytesOptional:
-		return m.BytesOptional()
-	case messagewithoptionals.FieldUUIDOptional:
-		return m.UUIDOptional()
-	case messagewithoptionals.FieldTimeOptional:
-		return m.TimeOptional()
+	case messagewithjson.FieldStrings:
+		return m.Strings()
+	case messagewithjson.FieldInts:
+		return m.Ints()
+	case messagewithjson.FieldFloats:
+		return m.Floats()
+	case messagewithjson.FieldObject:
+		return m.Object()
+	case messagewithjson.FieldList:
+		return m.List()
+	case messagewithjson.FieldValue:
+		return m.Value()
+	case messagewithjson.FieldRaw:
+		return m.Raw()
+	case messagewithjson.FieldNamed:
+		return m.Named()
 	}
 	return nil, false
 }
@@ -4498,454 +4731,1829 @@ func (m *MessageWithOptionalsMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageWithOptionalsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MessageWithJSONMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case messagewithoptionals.FieldStrOptional:
-		return m.OldStrOptional(ctx)
-	case messagewithoptionals.FieldIntOptional:
-		return m.OldIntOptional(ctx)
-	case messagewithoptionals.FieldUintOptional:
-		return m.OldUintOptional(ctx)
-	case messagewithoptionals.FieldFloatOptional:
-		return m.OldFloatOptional(ctx)
-	case messagewithoptionals.FieldBoolOptional:
-		return m.OldBoolOptional(ctx)
-	case messagewithoptionals.FieldBytesOptional:
-		return m.OldBytesOptional(ctx)
-	case messagewithoptionals.FieldUUIDOptional:
-		return m.OldUUIDOptional(ctx)
-	case messagewithoptionals.FieldTimeOptional:
-		return m.OldTimeOptional(ctx)
-	}
-	return nil, fmt.Errorf("unknown MessageWithOptionals field %s", name)
+	case messagewithjson.FieldStrings:
+		return m.OldStrings(ctx)
+	case messagewithjson.FieldInts:
+		return m.OldInts(ctx)
+	case messagewithjson.FieldFloats:
+		return m.OldFloats(ctx)
+	case messagewithjson.FieldObject:
+		return m.OldObject(ctx)
+	case messagewithjson.FieldList:
+		return m.OldList(ctx)
+	case messagewithjson.FieldValue:
+		return m.OldValue(ctx)
+	case messagewithjson.FieldRaw:
+		return m.OldRaw(ctx)
+	case messagewithjson.FieldNamed:
+		return m.OldNamed(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageWithJSON field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithOptionalsMutation) SetField(name string, value ent.Value) error {
+func (m *MessageWithJSONMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messagewithoptionals.FieldStrOptional:
-		v, ok := value.(string)
+	case messagewithjson.FieldStrings:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStrOptional(v)
+		m.SetStrings(v)
 		return nil
-	case messagewithoptionals.FieldIntOptional:
-		v, ok := value.(int8)
+	case messagewithjson.FieldInts:
+		v, ok := value.([]int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIntOptional(v)
+		m.SetInts(v)
 		return nil
-	case messagewithoptionals.FieldUintOptional:
-		v, ok := value.(uint8)
+	case messagewithjson.FieldFloats:
+		v, ok := value.([]float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUintOptional(v)
+		m.SetFloats(v)
 		return nil
-	case messagewithoptionals.FieldFloatOptional:
-		v, ok := value.(float32)
+	case messagewithjson.FieldObject:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFloatOptional(v)
+		m.SetObject(v)
 		return nil
-	case messagewithoptionals.FieldBoolOptional:
-		v, ok := value.(bool)
+	case messagewithjson.FieldList:
+		v, ok := value.([]schema.SomeJSON)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBoolOptional(v)
+		m.SetList(v)
 		return nil
-	case messagewithoptionals.FieldBytesOptional:
-		v, ok := value.([]byte)
+	case messagewithjson.FieldValue:
+		v, ok := value.(*schema.SomeJSON)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBytesOptional(v)
+		m.SetValue(v)
 		return nil
-	case messagewithoptionals.FieldUUIDOptional:
-		v, ok := value.(uuid.UUID)
+	case messagewithjson.FieldRaw:
+		v, ok := value.(jsontext.Value)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUUIDOptional(v)
+		m.SetRaw(v)
 		return nil
-	case messagewithoptionals.FieldTimeOptional:
-		v, ok := value.(time.Time)
+	case messagewithjson.FieldNamed:
+		v, ok := value.(*schema.Address)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimeOptional(v)
+		m.SetNamed(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MessageWithOptionals field %s", name)
+	return fmt.Errorf("unknown MessageWithJSON field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageWithOptionalsMutation) AddedFields() []string {
-	var fields []string
-	if m.addint_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldIntOptional)
-	}
-	if m.adduint_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldUintOptional)
-	}
-	if m.addfloat_optional != nil {
-		fields = append(fields, messagewithoptionals.FieldFloatOptional)
-	}
-	return fields
+func (m *MessageWithJSONMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageWithOptionalsMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case messagewithoptionals.FieldIntOptional:
-		return m.AddedIntOptional()
-	case messagewithoptionals.FieldUintOptional:
-		return m.AddedUintOptional()
-	case messagewithoptionals.FieldFloatOptional:
-		return m.AddedFloatOptional()
-	}
+func (m *MessageWithJSONMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithOptionalsMutation) AddField(name string, value ent.Value) error {
+func (m *MessageWithJSONMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case messagewithoptionals.FieldIntOptional:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddIntOptional(v)
-		return nil
-	case messagewithoptionals.FieldUintOptional:
-		v, ok := value.(uint8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUintOptional(v)
-		return nil
-	case messagewithoptionals.FieldFloatOptional:
-		v, ok := value.(float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddFloatOptional(v)
-		return nil
 	}
-	return fmt.Errorf("unknown MessageWithOptionals numeric field %s", name)
+	return fmt.Errorf("unknown MessageWithJSON numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageWithOptionalsMutation) ClearedFields() []string {
+func (m *MessageWithJSONMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(messagewithoptionals.FieldStrOptional) {
-		fields = append(fields, messagewithoptionals.FieldStrOptional)
-	}
-	if m.FieldCleared(messagewithoptionals.FieldIntOptional) {
-		fields = append(fields, messagewithoptionals.FieldIntOptional)
-	}
-	if m.FieldCleared(messagewithoptionals.FieldUintOptional) {
-		fields = append(fields, messagewithoptionals.FieldUintOptional)
-	}
-	if m.FieldCleared(messagewithoptionals.FieldFloatOptional) {
-		fields = append(fields, messagewithoptionals.FieldFloatOptional)
-	}
-	if m.FieldCleared(messagewithoptionals.FieldBoolOptional) {
-		fields = append(fields, messagewithoptionals.FieldBoolOptional)
-	}
-	if m.FieldCleared(messagewithoptionals.FieldBytesOptional) {
-		fields = append(fields, messagewithoptionals.FieldBytesOptional)
-	}
-	if m.FieldCleared(messagewithoptionals.FieldUUIDOptional) {
-		fields = append(fields, messagewithoptionals.FieldUUIDOptional)
-	}
-	if m.FieldCleared(messagewithoptionals.FieldTimeOptional) {
-		fields = append(fields, messagewithoptionals.FieldTimeOptional)
+	if m.FieldCleared(messagewithjson.FieldFloats) {
+		fields = append(fields, messagewithjson.FieldFloats)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageWithOptionalsMutation) FieldCleared(name string) bool {
+func (m *MessageWithJSONMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageWithOptionalsMutation) ClearField(name string) error {
+func (m *MessageWithJSONMutation) ClearField(name string) error {
 	switch name {
-	case messagewithoptionals.FieldStrOptional:
-		m.ClearStrOptional()
-		return nil
-	case messagewithoptionals.FieldIntOptional:
-		m.ClearIntOptional()
-		return nil
-	case messagewithoptionals.FieldUintOptional:
-		m.ClearUintOptional()
-		return nil
-	case messagewithoptionals.FieldFloatOptional:
-		m.ClearFloatOptional()
-		return nil
-	case messagewithoptionals.FieldBoolOptional:
-		m.ClearBoolOptional()
-		return nil
-	case messagewithoptionals.FieldBytesOptional:
-		m.ClearBytesOptional()
-		return nil
-	case messagewithoptionals.FieldUUIDOptional:
-		m.ClearUUIDOptional()
-		return nil
-	case messagewithoptionals.FieldTimeOptional:
-		m.ClearTimeOptional()
+	case messagewithjson.FieldFloats:
+		m.ClearFloats()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageWithOptionals nullable field %s", name)
+	return fmt.Errorf("unknown MessageWithJSON nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageWithOptionalsMutation) ResetField(name string) error {
+func (m *MessageWithJSONMutation) ResetField(name string) error {
 	switch name {
-	case messagewithoptionals.FieldStrOptional:
-		m.ResetStrOptional()
+	case messagewithjson.FieldStrings:
+		m.ResetStrings()
 		return nil
-	case messagewithoptionals.FieldIntOptional:
-		m.ResetIntOptional()
+	case messagewithjson.FieldInts:
+		m.ResetInts()
 		return nil
-	case messagewithoptionals.FieldUintOptional:
-		m.ResetUintOptional()
+	case messagewithjson.FieldFloats:
+		m.ResetFloats()
 		return nil
-	case messagewithoptionals.FieldFloatOptional:
-		m.ResetFloatOptional()
+	case messagewithjson.FieldObject:
+		m.ResetObject()
 		return nil
-	case messagewithoptionals.FieldBoolOptional:
-		m.ResetBoolOptional()
+	case messagewithjson.FieldList:
+		m.ResetList()
 		return nil
-	case messagewithoptionals.FieldBytesOptional:
-		m.ResetBytesOptional()
+	case messagewithjson.FieldValue:
+		m.ResetValue()
 		return nil
-	case messagewithoptionals.FieldUUIDOptional:
-		m.ResetUUIDOptional()
+	case messagewithjson.FieldRaw:
+		m.ResetRaw()
 		return nil
-	case messagewithoptionals.FieldTimeOptional:
-		m.ResetTimeOptional()
+	case messagewithjson.FieldNamed:
+		m.ResetNamed()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageWithOptionals field %s", name)
+	return fmt.Errorf("unknown MessageWithJSON field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageWithOptionalsMutation) AddedEdges() []string {
+func (m *MessageWithJSONMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageWithOptionalsMutation) AddedIDs(name string) []ent.Value {
+func (m *MessageWithJSONMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageWithOptionalsMutation) RemovedEdges() []string {
+func (m *MessageWithJSONMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageWithOptionalsMutation) RemovedIDs(name string) []ent.Value {
+func (m *MessageWithJSONMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageWithOptionalsMutation) ClearedEdges() []string {
+func (m *MessageWithJSONMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *MessageWithOptionalsMutation) EdgeCleared(name string) bool {
-	return false
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageWithJSONMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageWithJSONMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithJSON unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageWithJSONMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithJSON edge %s", name)
+}
+
+// MessageWithOptionalsMutation represents an operation that mutates the MessageWithOptionals nodes in the graph.
+type MessageWithOptionalsMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	str_optional      *string
+	int_optional      *int8
+	addint_optional   *int8
+	uint_optional     *uint8
+	adduint_optional  *uint8
+	float_optional    *float32
+	addfloat_optional *float32
+	bool_optional     *bool
+	bytes_optional    *[]byte
+	uuid_optional     *uuid.UUID
+	time_optional     *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*MessageWithOptionals, error)
+	predicates        []predicate.MessageWithOptionals
+}
+
+var _ ent.Mutation = (*MessageWithOptionalsMutation)(nil)
+
+// messagewithoptionalsOption allows management of the mutation configuration using functional options.
+type messagewithoptionalsOption func(*MessageWithOptionalsMutation)
+
+// newMessageWithOptionalsMutation creates new mutation for the MessageWithOptionals entity.
+func newMessageWithOptionalsMutation(c config, op Op, opts ...messagewithoptionalsOption) *MessageWithOptionalsMutation {
+	m := &MessageWithOptionalsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageWithOptionals,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageWithOptionalsID sets the ID field of the mutation.
+func withMessageWithOptionalsID(id int) messagewithoptionalsOption {
+	return func(m *MessageWithOptionalsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageWithOptionals
+		)
+		m.oldValue = func(ctx context.Context) (*MessageWithOptionals, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageWithOptionals.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageWithOptionals sets the old MessageWithOptionals of the mutation.
+func withMessageWithOptionals(node *MessageWithOptionals) messagewithoptionalsOption {
+	return func(m *MessageWithOptionalsMutation) {
+		m.oldValue = func(context.Context) (*MessageWithOptionals, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageWithOptionalsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageWithOptionalsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageWithOptionalsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetStrOptional sets the "str_optional" field.
+func (m *MessageWithOptionalsMutation) SetStrOptional(s string) {
+	m.str_optional = &s
+}
+
+// StrOptional returns the value of the "str_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) StrOptional() (r string, exists bool) {
+	v := m.str_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrOptional returns the old "str_optional" field's value of the MessageWithOptionals entity.
+// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithOptionalsMutation) OldStrOptional(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStrOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStrOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrOptional: %w", err)
+	}
+	return oldValue.StrOptional, nil
+}
+
+// ClearStrOptional clears the value of the "str_optional" field.
+func (m *MessageWithOptionalsMutation) ClearStrOptional() {
+	m.str_optional = nil
+	m.clearedFields[messagewithoptionals.FieldStrOptional] = struct{}{}
+}
+
+// StrOptionalCleared returns if the "str_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) StrOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldStrOptional]
+	return ok
+}
+
+// ResetStrOptional resets all changes to the "str_optional" field.
+func (m *MessageWithOptionalsMutation) ResetStrOptional() {
+	m.str_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldStrOptional)
+}
+
+// SetIntOptional sets the "int_optional" field.
+func (m *MessageWithOptionalsMutation) SetIntOptional(i int8) {
+	m.int_optional = &i
+	m.addint_optional = nil
+}
+
+// IntOptional returns the value of the "int_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) IntOptional() (r int8, exists bool) {
+	v := m.int_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntOptional returns the old "int_optional" field's value of the MessageWithOptionals entity.
+// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithOptionalsMutation) OldIntOptional(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldIntOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldIntOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntOptional: %w", err)
+	}
+	return oldValue.IntOptional, nil
+}
+
+// AddIntOptional adds i to the "int_optional" field.
+func (m *MessageWithOptionalsMutation) AddIntOptional(i int8) {
+	if m.addint_optional != nil {
+		*m.addint_optional += i
+	} else {
+		m.addint_optional = &i
+	}
+}
+
+// AddedIntOptional returns the value that was added to the "int_optional" field in this mutation.
+func (m *MessageWithOptionalsMutation) AddedIntOptional() (r int8, exists bool) {
+	v := m.addint_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIntOptional clears the value of the "int_optional" field.
+func (m *MessageWithOptionalsMutation) ClearIntOptional() {
+	m.int_optional = nil
+	m.addint_optional = nil
+	m.clearedFields[messagewithoptionals.FieldIntOptional] = struct{}{}
+}
+
+// IntOptionalCleared returns if the "int_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) IntOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldIntOptional]
+	return ok
+}
+
+// ResetIntOptional resets all changes to the "int_optional" field.
+func (m *MessageWithOptionalsMutation) ResetIntOptional() {
+	m.int_optional = nil
+	m.addint_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldIntOptional)
+}
+
+// SetUintOptional sets the "uint_optional" field.
+func (m *MessageWithOptionalsMutation) SetUintOptional(u uint8) {
+	m.uint_optional = &u
+	m.adduint_optional = nil
+}
+
+// UintOptional returns the value of the "uint_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) UintOptional() (r uint8, exists bool) {
+	v := m.uint_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUintOptional returns the old "uint_optional" field's value of the MessageWithOptionals entity.
+// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithOptionalsMutation) OldUintOptional(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUintOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUintOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUintOptional: %w", err)
+	}
+	return oldValue.UintOptional, nil
+}
+
+// AddUintOptional adds u to the "uint_optional" field.
+func (m *MessageWithOptionalsMutation) AddUintOptional(u uint8) {
+	if m.adduint_optional != nil {
+		*m.adduint_optional += u
+	} else {
+		m.adduint_optional = &u
+	}
+}
+
+// AddedUintOptional returns the value that was added to the "uint_optional" field in this mutation.
+func (m *MessageWithOptionalsMutation) AddedUintOptional() (r uint8, exists bool) {
+	v := m.adduint_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUintOptional clears the value of the "uint_optional" field.
+func (m *MessageWithOptionalsMutation) ClearUintOptional() {
+	m.uint_optional = nil
+	m.adduint_optional = nil
+	m.clearedFields[messagewithoptionals.FieldUintOptional] = struct{}{}
+}
+
+// UintOptionalCleared returns if the "uint_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) UintOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldUintOptional]
+	return ok
+}
+
+// ResetUintOptional resets all changes to the "uint_optional" field.
+func (m *MessageWithOptionalsMutation) ResetUintOptional() {
+	m.uint_optional = nil
+	m.adduint_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldUintOptional)
+}
+
+// SetFloatOptional sets the "float_optional" field.
+func (m *MessageWithOptionalsMutation) SetFloatOptional(f float32) {
+	m.float_optional = &f
+	m.addfloat_optional = nil
+}
+
+// FloatOptional returns the value of the "float_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) FloatOptional() (r float32, exists bool) {
+	v := m.float_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFloatOptional returns the old "float_optional" field's value of the MessageWithOptionals entity.
+// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithOptionalsMutation) OldFloatOptional(ctx context.Context) (v float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldFloatOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldFloatOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFloatOptional: %w", err)
+	}
+	return oldValue.FloatOptional, nil
+}
+
+// AddFloatOptional adds f to the "float_optional" field.
+func (m *MessageWithOptionalsMutation) AddFloatOptional(f float32) {
+	if m.addfloat_optional != nil {
+		*m.addfloat_optional += f
+	} else {
+		m.addfloat_optional = &f
+	}
+}
+
+// AddedFloatOptional returns the value that was added to the "float_optional" field in this mutation.
+func (m *MessageWithOptionalsMutation) AddedFloatOptional() (r float32, exists bool) {
+	v := m.addfloat_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearFloatOptional clears the value of the "float_optional" field.
+func (m *MessageWithOptionalsMutation) ClearFloatOptional() {
+	m.float_optional = nil
+	m.addfloat_optional = nil
+	m.clearedFields[messagewithoptionals.FieldFloatOptional] = struct{}{}
+}
+
+// FloatOptionalCleared returns if the "float_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) FloatOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldFloatOptional]
+	return ok
+}
+
+// ResetFloatOptional resets all changes to the "float_optional" field.
+func (m *MessageWithOptionalsMutation) ResetFloatOptional() {
+	m.float_optional = nil
+	m.addfloat_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldFloatOptional)
+}
+
+// SetBoolOptional sets the "bool_optional" field.
+func (m *MessageWithOptionalsMutation) SetBoolOptional(b bool) {
+	m.bool_optional = &b
+}
+
+// BoolOptional returns the value of the "bool_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) BoolOptional() (r bool, exists bool) {
+	v := m.bool_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoolOptional returns the old "bool_optional" field's value of the MessageWithOptionals entity.
+// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithOptionalsMutation) OldBoolOptional(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldBoolOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldBoolOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoolOptional: %w", err)
+	}
+	return oldValue.BoolOptional, nil
+}
+
+// ClearBoolOptional clears the value of the "bool_optional" field.
+func (m *MessageWithOptionalsMutation) ClearBoolOptional() {
+	m.bool_optional = nil
+	m.clearedFields[messagewithoptionals.FieldBoolOptional] = struct{}{}
+}
+
+// BoolOptionalCleared returns if the "bool_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) BoolOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldBoolOptional]
+	return ok
+}
+
+// ResetBoolOptional resets all changes to the "bool_optional" field.
+func (m *MessageWithOptionalsMutation) ResetBoolOptional() {
+	m.bool_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldBoolOptional)
+}
+
+// SetBytesOptional sets the "bytes_optional" field.
+func (m *MessageWithOptionalsMutation) SetBytesOptional(b []byte) {
+	m.bytes_optional = &b
+}
+
+// BytesOptional returns the value of the "bytes_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) BytesOptional() (r []byte, exists bool) {
+	v := m.bytes_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBytesOptional returns the old "bytes_optional" field's value of the MessageWithOptionals entity.
+// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithOptionalsMutation) OldBytesOptional(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldBytesOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldBytesOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBytesOptional: %w", err)
+	}
+	return oldValue.BytesOptional, nil
+}
+
+// ClearBytesOptional clears the value of the "bytes_optional" field.
+func (m *MessageWithOptionalsMutation) ClearBytesOptional() {
+	m.bytes_optional = nil
+	m.clearedFields[messagewithoptionals.FieldBytesOptional] = struct{}{}
+}
+
+// BytesOptionalCleared returns if the "bytes_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) BytesOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldBytesOptional]
+	return ok
+}
+
+// ResetBytesOptional resets all changes to the "bytes_optional" field.
+func (m *MessageWithOptionalsMutation) ResetBytesOptional() {
+	m.bytes_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldBytesOptional)
+}
+
+// SetUUIDOptional sets the "uuid_optional" field.
+func (m *MessageWithOptionalsMutation) SetUUIDOptional(u uuid.UUID) {
+	m.uuid_optional = &u
+}
+
+// UUIDOptional returns the value of the "uuid_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) UUIDOptional() (r uuid.UUID, exists bool) {
+	v := m.uuid_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUUIDOptional returns the old "uuid_optional" field's value of the MessageWithOptionals entity.
+// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithOptionalsMutation) OldUUIDOptional(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUUIDOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUUIDOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUUIDOptional: %w", err)
+	}
+	return oldValue.UUIDOptional, nil
+}
+
+// ClearUUIDOptional clears the value of the "uuid_optional" field.
+func (m *MessageWithOptionalsMutation) ClearUUIDOptional() {
+	m.uuid_optional = nil
+	m.clearedFields[messagewithoptionals.FieldUUIDOptional] = struct{}{}
+}
+
+// UUIDOptionalCleared returns if the "uuid_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) UUIDOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldUUIDOptional]
+	return ok
+}
+
+// ResetUUIDOptional resets all changes to the "uuid_optional" field.
+func (m *MessageWithOptionalsMutation) ResetUUIDOptional() {
+	m.uuid_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldUUIDOptional)
+}
+
+// SetTimeOptional sets the "time_optional" field.
+func (m *MessageWithOptionalsMutation) SetTimeOptional(t time.Time) {
+	m.time_optional = &t
+}
+
+// TimeOptional returns the value of the "time_optional" field in the mutation.
+func (m *MessageWithOptionalsMutation) TimeOptional() (r time.Time, exists bool) {
+	v := m.time_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeOptional returns the old "time_optional" field's value of the MessageWithOptionals entity.
+// If the MessageWithOptionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithOptionalsMutation) OldTimeOptional(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTimeOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTimeOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeOptional: %w", err)
+	}
+	return oldValue.TimeOptional, nil
+}
+
+// ClearTimeOptional clears the value of the "time_optional" field.
+func (m *MessageWithOptionalsMutation) ClearTimeOptional() {
+	m.time_optional = nil
+	m.clearedFields[messagewithoptionals.FieldTimeOptional] = struct{}{}
+}
+
+// TimeOptionalCleared returns if the "time_optional" field was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) TimeOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithoptionals.FieldTimeOptional]
+	return ok
+}
+
+// ResetTimeOptional resets all changes to the "time_optional" field.
+func (m *MessageWithOptionalsMutation) ResetTimeOptional() {
+	m.time_optional = nil
+	delete(m.clearedFields, messagewithoptionals.FieldTimeOptional)
+}
+
+// Where appends a list predicates to the MessageWithOptionalsMutation builder.
+func (m *MessageWithOptionalsMutation) Where(ps ...predicate.MessageWithOptionals) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *MessageWithOptionalsMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (MessageWithOptionals).
+func (m *MessageWithOptionalsMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageWithOptionalsMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.str_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldStrOptional)
+	}
+	if m.int_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldIntOptional)
+	}
+	if m.uint_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldUintOptional)
+	}
+	if m.float_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldFloatOptional)
+	}
+	if m.bool_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldBoolOptional)
+	}
+	if m.bytes_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldBytesOptional)
+	}
+	if m.uuid_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldUUIDOptional)
+	}
+	if m.time_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldTimeOptional)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageWithOptionalsMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagewithoptionals.FieldStrOptional:
+		return m.StrOptional()
+	case messagewithoptionals.FieldIntOptional:
+		return m.IntOptional()
+	case messagewithoptionals.FieldUintOptional:
+		return m.UintOptional()
+	case messagewithoptionals.FieldFloatOptional:
+		return m.FloatOptional()
+	case messagewithoptionals.FieldBoolOptional:
+		return m.BoolOptional()
+	case messagewithoptionals.FieldBytesOptional:
+		return m.BytesOptional()
+	case messagewithoptionals.FieldUUIDOptional:
+		return m.UUIDOptional()
+	case messagewithoptionals.FieldTimeOptional:
+		return m.TimeOptional()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageWithOptionalsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagewithoptionals.FieldStrOptional:
+		return m.OldStrOptional(ctx)
+	case messagewithoptionals.FieldIntOptional:
+		return m.OldIntOptional(ctx)
+	case messagewithoptionals.FieldUintOptional:
+		return m.OldUintOptional(ctx)
+	case messagewithoptionals.FieldFloatOptional:
+		return m.OldFloatOptional(ctx)
+	case messagewithoptionals.FieldBoolOptional:
+		return m.OldBoolOptional(ctx)
+	case messagewithoptionals.FieldBytesOptional:
+		return m.OldBytesOptional(ctx)
+	case messagewithoptionals.FieldUUIDOptional:
+		return m.OldUUIDOptional(ctx)
+	case messagewithoptionals.FieldTimeOptional:
+		return m.OldTimeOptional(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageWithOptionals field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithOptionalsMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagewithoptionals.FieldStrOptional:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrOptional(v)
+		return nil
+	case messagewithoptionals.FieldIntOptional:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntOptional(v)
+		return nil
+	case messagewithoptionals.FieldUintOptional:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUintOptional(v)
+		return nil
+	case messagewithoptionals.FieldFloatOptional:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFloatOptional(v)
+		return nil
+	case messagewithoptionals.FieldBoolOptional:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoolOptional(v)
+		return nil
+	case messagewithoptionals.FieldBytesOptional:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBytesOptional(v)
+		return nil
+	case messagewithoptionals.FieldUUIDOptional:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUUIDOptional(v)
+		return nil
+	case messagewithoptionals.FieldTimeOptional:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeOptional(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithOptionals field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageWithOptionalsMutation) AddedFields() []string {
+	var fields []string
+	if m.addint_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldIntOptional)
+	}
+	if m.adduint_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldUintOptional)
+	}
+	if m.addfloat_optional != nil {
+		fields = append(fields, messagewithoptionals.FieldFloatOptional)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageWithOptionalsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messagewithoptionals.FieldIntOptional:
+		return m.AddedIntOptional()
+	case messagewithoptionals.FieldUintOptional:
+		return m.AddedUintOptional()
+	case messagewithoptionals.FieldFloatOptional:
+		return m.AddedFloatOptional()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithOptionalsMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case messagewithoptionals.FieldIntOptional:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIntOptional(v)
+		return nil
+	case messagewithoptionals.FieldUintOptional:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUintOptional(v)
+		return nil
+	case messagewithoptionals.FieldFloatOptional:
+		v, ok := value.(float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFloatOptional(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithOptionals numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageWithOptionalsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(messagewithoptionals.FieldStrOptional) {
+		fields = append(fields, messagewithoptionals.FieldStrOptional)
+	}
+	if m.FieldCleared(messagewithoptionals.FieldIntOptional) {
+		fields = append(fields, messagewithoptionals.FieldIntOptional)
+	}
+	if m.FieldCleared(messagewithoptionals.FieldUintOptional) {
+		fields = append(fields, messagewithoptionals.FieldUintOptional)
+	}
+	if m.FieldCleared(messagewithoptionals.FieldFloatOptional) {
+		fields = append(fields, messagewithoptionals.FieldFloatOptional)
+	}
+	if m.FieldCleared(messagewithoptionals.FieldBoolOptional) {
+		fields = append(fields, messagewithoptionals.FieldBoolOptional)
+	}
+	if m.FieldCleared(messagewithoptionals.FieldBytesOptional) {
+		fields = append(fields, messagewithoptionals.FieldBytesOptional)
+	}
+	if m.FieldCleared(messagewithoptionals.FieldUUIDOptional) {
+		fields = append(fields, messagewithoptionals.FieldUUIDOptional)
+	}
+	if m.FieldCleared(messagewithoptionals.FieldTimeOptional) {
+		fields = append(fields, messagewithoptionals.FieldTimeOptional)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageWithOptionalsMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageWithOptionalsMutation) ClearField(name string) error {
+	switch name {
+	case messagewithoptionals.FieldStrOptional:
+		m.ClearStrOptional()
+		return nil
+	case messagewithoptionals.FieldIntOptional:
+		m.ClearIntOptional()
+		return nil
+	case messagewithoptionals.FieldUintOptional:
+		m.ClearUintOptional()
+		return nil
+	case messagewithoptionals.FieldFloatOptional:
+		m.ClearFloatOptional()
+		return nil
+	case messagewithoptionals.FieldBoolOptional:
+		m.ClearBoolOptional()
+		return nil
+	case messagewithoptionals.FieldBytesOptional:
+		m.ClearBytesOptional()
+		return nil
+	case messagewithoptionals.FieldUUIDOptional:
+		m.ClearUUIDOptional()
+		return nil
+	case messagewithoptionals.FieldTimeOptional:
+		m.ClearTimeOptional()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithOptionals nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageWithOptionalsMutation) ResetField(name string) error {
+	switch name {
+	case messagewithoptionals.FieldStrOptional:
+		m.ResetStrOptional()
+		return nil
+	case messagewithoptionals.FieldIntOptional:
+		m.ResetIntOptional()
+		return nil
+	case messagewithoptionals.FieldUintOptional:
+		m.ResetUintOptional()
+		return nil
+	case messagewithoptionals.FieldFloatOptional:
+		m.ResetFloatOptional()
+		return nil
+	case messagewithoptionals.FieldBoolOptional:
+		m.ResetBoolOptional()
+		return nil
+	case messagewithoptionals.FieldBytesOptional:
+		m.ResetBytesOptional()
+		return nil
+	case messagewithoptionals.FieldUUIDOptional:
+		m.ResetUUIDOptional()
+		return nil
+	case messagewithoptionals.FieldTimeOptional:
+		m.ResetTimeOptional()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithOptionals field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageWithOptionalsMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageWithOptionalsMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageWithOptionalsMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageWithOptionalsMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageWithOptionalsMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageWithOptionalsMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageWithOptionalsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithOptionals unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageWithOptionalsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithOptionals edge %s", name)
+}
+
+// MessageWithPackageNameMutation represents an operation that mutates the MessageWithPackageName nodes in the graph.
+type MessageWithPackageNameMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*MessageWithPackageName, error)
+	predicates    []predicate.MessageWithPackageName
+}
+
+var _ ent.Mutation = (*MessageWithPackageNameMutation)(nil)
+
+// messagewithpackagenameOption allows management of the mutation configuration using functional options.
+type messagewithpackagenameOption func(*MessageWithPackageNameMutation)
+
+// newMessageWithPackageNameMutation creates new mutation for the MessageWithPackageName entity.
+func newMessageWithPackageNameMutation(c config, op Op, opts ...messagewithpackagenameOption) *MessageWithPackageNameMutation {
+	m := &MessageWithPackageNameMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageWithPackageName,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageWithPackageNameID sets the ID field of the mutation.
+func withMessageWithPackageNameID(id int) messagewithpackagenameOption {
+	return func(m *MessageWithPackageNameMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageWithPackageName
+		)
+		m.oldValue = func(ctx context.Context) (*MessageWithPackageName, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageWithPackageName.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageWithPackageName sets the old MessageWithPackageName of the mutation.
+func withMessageWithPackageName(node *MessageWithPackageName) messagewithpackagenameOption {
+	return func(m *MessageWithPackageNameMutation) {
+		m.oldValue = func(context.Context) (*MessageWithPackageName, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageWithPackageNameMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageWithPackageNameMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageWithPackageNameMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetName sets the "name" field.
+func (m *MessageWithPackageNameMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *MessageWithPackageNameMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the MessageWithPackageName entity.
+// If the MessageWithPackageName object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithPackageNameMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *MessageWithPackageNameMutation) ResetName() {
+	m.name = nil
+}
+
+// Where appends a list predicates to the MessageWithPackageNameMutation builder.
+func (m *MessageWithPackageNameMutation) Where(ps ...predicate.MessageWithPackageName) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// Op returns the operation name.
+func (m *MessageWithPackageNameMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (MessageWithPackageName).
+func (m *MessageWithPackageNameMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageWithPackageNameMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, messagewithpackagename.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageWithPackageNameMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagewithpackagename.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageWithPackageNameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagewithpackagename.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageWithPackageName field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithPackageNameMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagewithpackagename.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithPackageName field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageWithPackageNameMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageWithPackageNameMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageWithPackageNameMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageWithPackageName numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageWithPackageNameMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageWithPackageNameMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageWithPackageNameMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageWithPackageName nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageWithPackageNameMutation) ResetField(name string) error {
+	switch name {
+	case messagewithpackagename.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithPackageName field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageWithPackageNameMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageWithPackageNameMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageWithPackageNameMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageWithPackageNameMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageWithPackageNameMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageWithPackageNameMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageWithPackageNameMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithPackageName unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageWithPackageNameMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithPackageName edge %s", name)
+}
+
+// MessageWithProto3OptionalsMutation represents an operation that mutates the MessageWithProto3Optionals nodes in the graph.
+type MessageWithProto3OptionalsMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	str_optional    *string
+	int_optional    *int8
+	addint_optional *int8
+	uuid_optional   *uuid.UUID
+	time_optional   *time.Time
+	str             *string
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*MessageWithProto3Optionals, error)
+	predicates      []predicate.MessageWithProto3Optionals
+}
+
+var _ ent.Mutation = (*MessageWithProto3OptionalsMutation)(nil)
+
+// messagewithproto3optionalsOption allows management of the mutation configuration using functional options.
+type messagewithproto3optionalsOption func(*MessageWithProto3OptionalsMutation)
+
+// newMessageWithProto3OptionalsMutation creates new mutation for the MessageWithProto3Optionals entity.
+func newMessageWithProto3OptionalsMutation(c config, op Op, opts ...messagewithproto3optionalsOption) *MessageWithProto3OptionalsMutation {
+	m := &MessageWithProto3OptionalsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageWithProto3Optionals,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageWithProto3OptionalsID sets the ID field of the mutation.
+func withMessageWithProto3OptionalsID(id int) messagewithproto3optionalsOption {
+	return func(m *MessageWithProto3OptionalsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageWithProto3Optionals
+		)
+		m.oldValue = func(ctx context.Context) (*MessageWithProto3Optionals, error) {
+			once.Do(func() {
+				if m.done {
+					err = fmt.Errorf("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageWithProto3Optionals.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageWithProto3Optionals sets the old MessageWithProto3Optionals of the mutation.
+func withMessageWithProto3Optionals(node *MessageWithProto3Optionals) messagewithproto3optionalsOption {
+	return func(m *MessageWithProto3OptionalsMutation) {
+		m.oldValue = func(context.Context) (*MessageWithProto3Optionals, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageWithProto3OptionalsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageWithProto3OptionalsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageWithProto3OptionalsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetStrOptional sets the "str_optional" field.
+func (m *MessageWithProto3OptionalsMutation) SetStrOptional(s string) {
+	m.str_optional = &s
+}
+
+// StrOptional returns the value of the "str_optional" field in the mutation.
+func (m *MessageWithProto3OptionalsMutation) StrOptional() (r string, exists bool) {
+	v := m.str_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrOptional returns the old "str_optional" field's value of the MessageWithProto3Optionals entity.
+// If the MessageWithProto3Optionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithProto3OptionalsMutation) OldStrOptional(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldStrOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldStrOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrOptional: %w", err)
+	}
+	return oldValue.StrOptional, nil
+}
+
+// ClearStrOptional clears the value of the "str_optional" field.
+func (m *MessageWithProto3OptionalsMutation) ClearStrOptional() {
+	m.str_optional = nil
+	m.clearedFields[messagewithproto3optionals.FieldStrOptional] = struct{}{}
+}
+
+// StrOptionalCleared returns if the "str_optional" field was cleared in this mutation.
+func (m *MessageWithProto3OptionalsMutation) StrOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithproto3optionals.FieldStrOptional]
+	return ok
+}
+
+// ResetStrOptional resets all changes to the "str_optional" field.
+func (m *MessageWithProto3OptionalsMutation) ResetStrOptional() {
+	m.str_optional = nil
+	delete(m.clearedFields, messagewithproto3optionals.FieldStrOptional)
+}
+
+// SetIntOptional sets the "int_optional" field.
+func (m *MessageWithProto3OptionalsMutation) SetIntOptional(i int8) {
+	m.int_optional = &i
+	m.addint_optional = nil
+}
+
+// IntOptional returns the value of the "int_optional" field in the mutation.
+func (m *MessageWithProto3OptionalsMutation) IntOptional() (r int8, exists bool) {
+	v := m.int_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntOptional returns the old "int_optional" field's value of the MessageWithProto3Optionals entity.
+// If the MessageWithProto3Optionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithProto3OptionalsMutation) OldIntOptional(ctx context.Context) (v int8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldIntOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldIntOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntOptional: %w", err)
+	}
+	return oldValue.IntOptional, nil
+}
+
+// AddIntOptional adds i to the "int_optional" field.
+func (m *MessageWithProto3OptionalsMutation) AddIntOptional(i int8) {
+	if m.addint_optional != nil {
+		*m.addint_optional += i
+	} else {
+		m.addint_optional = &i
+	}
+}
+
+// AddedIntOptional returns the value that was added to the "int_optional" field in this mutation.
+func (m *MessageWithProto3OptionalsMutation) AddedIntOptional() (r int8, exists bool) {
+	v := m.addint_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIntOptional clears the value of the "int_optional" field.
+func (m *MessageWithProto3OptionalsMutation) ClearIntOptional() {
+	m.int_optional = nil
+	m.addint_optional = nil
+	m.clearedFields[messagewithproto3optionals.FieldIntOptional] = struct{}{}
+}
+
+// IntOptionalCleared returns if the "int_optional" field was cleared in this mutation.
+func (m *MessageWithProto3OptionalsMutation) IntOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithproto3optionals.FieldIntOptional]
+	return ok
+}
+
+// ResetIntOptional resets all changes to the "int_optional" field.
+func (m *MessageWithProto3OptionalsMutation) ResetIntOptional() {
+	m.int_optional = nil
+	m.addint_optional = nil
+	delete(m.clearedFields, messagewithproto3optionals.FieldIntOptional)
+}
+
+// SetUUIDOptional sets the "uuid_optional" field.
+func (m *MessageWithProto3OptionalsMutation) SetUUIDOptional(u uuid.UUID) {
+	m.uuid_optional = &u
+}
+
+// UUIDOptional returns the value of the "uuid_optional" field in the mutation.
+func (m *MessageWithProto3OptionalsMutation) UUIDOptional() (r uuid.UUID, exists bool) {
+	v := m.uuid_optional
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUUIDOptional returns the old "uuid_optional" field's value of the MessageWithProto3Optionals entity.
+// If the MessageWithProto3Optionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithProto3OptionalsMutation) OldUUIDOptional(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldUUIDOptional is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldUUIDOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUUIDOptional: %w", err)
+	}
+	return oldValue.UUIDOptional, nil
+}
+
+// ClearUUIDOptional clears the value of the "uuid_optional" field.
+func (m *MessageWithProto3OptionalsMutation) ClearUUIDOptional() {
+	m.uuid_optional = nil
+	m.clearedFields[messagewithproto3optionals.FieldUUIDOptional] = struct{}{}
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *MessageWithOptionalsMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithOptionals unique edge %s", name)
+// UUIDOptionalCleared returns if the "uuid_optional" field was cleared in this mutation.
+func (m *MessageWithProto3OptionalsMutation) UUIDOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithproto3optionals.FieldUUIDOptional]
+	return ok
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *MessageWithOptionalsMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithOptionals edge %s", name)
+// ResetUUIDOptional resets all changes to the "uuid_optional" field.
+func (m *MessageWithProto3OptionalsMutation) ResetUUIDOptional() {
+	m.uuid_optional = nil
+	delete(m.clearedFields, messagewithproto3optionals.FieldUUIDOptional)
 }
 
-// MessageWithPackageNameMutation represents an operation that mutates the MessageWithPackageName nodes in the graph.
-type MessageWithPackageNameMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*MessageWithPackageName, error)
-	predicates    []predicate.MessageWithPackageName
+// SetTimeOptional sets the "time_optional" field.
+func (m *MessageWithProto3OptionalsMutation) SetTimeOptional(t time.Time) {
+	m.time_optional = &t
 }
 
-var _ ent.Mutation = (*MessageWithPackageNameMutation)(nil)
-
-// messagewithpackagenameOption allows management of the mutation configuration using functional options.
-type messagewithpackagenameOption func(*MessageWithPackageNameMutation)
-
-// newMessageWithPackageNameMutation creates new mutation for the MessageWithPackageName entity.
-func newMessageWithPackageNameMutation(c config, op Op, opts ...messagewithpackagenameOption) *MessageWithPackageNameMutation {
-	m := &MessageWithPackageNameMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeMessageWithPackageName,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// TimeOptional returns the value of the "time_optional" field in the mutation.
+func (m *MessageWithProto3OptionalsMutation) TimeOptional() (r time.Time, exists bool) {
+	v := m.time_optional
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withMessageWithPackageNameID sets the ID field of the mutation.
-func withMessageWithPackageNameID(id int) messagewithpackagenameOption {
-	return func(m *MessageWithPackageNameMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *MessageWithPackageName
-		)
-		m.oldValue = func(ctx context.Context) (*MessageWithPackageName, error) {
-			once.Do(func() {
-				if m.done {
-					err = fmt.Errorf("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().MessageWithPackageName.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldTimeOptional returns the old "time_optional" field's value of the MessageWithProto3Optionals entity.
+// If the MessageWithProto3Optionals object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageWithProto3OptionalsMutation) OldTimeOptional(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldTimeOptional is only allowed on UpdateOne operations")
 	}
-}
-
-// withMessageWithPackageName sets the old MessageWithPackageName of the mutation.
-func withMessageWithPackageName(node *MessageWithPackageName) messagewithpackagenameOption {
-	return func(m *MessageWithPackageNameMutation) {
-		m.oldValue = func(context.Context) (*MessageWithPackageName, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldTimeOptional requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeOptional: %w", err)
 	}
+	return oldValue.TimeOptional, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageWithPackageNameMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearTimeOptional clears the value of the "time_optional" field.
+func (m *MessageWithProto3OptionalsMutation) ClearTimeOptional() {
+	m.time_optional = nil
+	m.clearedFields[messagewithproto3optionals.FieldTimeOptional] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m MessageWithPackageNameMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// TimeOptionalCleared returns if the "time_optional" field was cleared in this mutation.
+func (m *MessageWithProto3OptionalsMutation) TimeOptionalCleared() bool {
+	_, ok := m.clearedFields[messagewithproto3optionals.FieldTimeOptional]
+	return ok
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *MessageWithPackageNameMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ResetTimeOptional resets all changes to the "time_optional" field.
+func (m *MessageWithProto3OptionalsMutation) ResetTimeOptional() {
+	m.time_optional = nil
+	delete(m.clearedFields, messagewithproto3optionals.FieldTimeOptional)
 }
 
-// SetName sets the "name" field.
-func (m *MessageWithPackageNameMutation) SetName(s string) {
-	m.name = &s
+// SetStr sets the "str" field.
+func (m *MessageWithProto3OptionalsMutation) SetStr(s string) {
+	m.str = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *MessageWithPackageNameMutation) Name() (r string, exists bool) {
-	v := m.name
+// Str returns the value of the "str" field in the mutation.
+func (m *MessageWithProto3OptionalsMutation) Str() (r string, exists bool) {
+	v := m.str
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the MessageWithPackageName entity.
-// If the MessageWithPackageName object wasn't provided to the builder, the object is fetched from the database.
+// OldStr returns the old "str" field's value of the MessageWithProto3Optionals entity.
+// If the MessageWithProto3Optionals object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageWithPackageNameMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *MessageWithProto3OptionalsMutation) OldStr(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, fmt.Errorf("OldName is only allowed on UpdateOne operations")
+		return v, fmt.Errorf("OldStr is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, fmt.Errorf("OldName requires an ID field in the mutation")
+		return v, fmt.Errorf("OldStr requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldStr: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Str, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *MessageWithPackageNameMutation) ResetName() {
-	m.name = nil
+// ResetStr resets all changes to the "str" field.
+func (m *MessageWithProto3OptionalsMutation) ResetStr() {
+	m.str = nil
 }
 
-// Where appends a list predicates to the MessageWithPackageNameMutation builder.
-func (m *MessageWithPackageNameMutation) Where(ps ...predicate.MessageWithPackageName) {
+// Where appends a list predicates to the MessageWithProto3OptionalsMutation builder.
+func (m *MessageWithProto3OptionalsMutation) Where(ps ...predicate.MessageWithProto3Optionals) {
 	m.predicates = append(m.predicates, ps...)
 }
 
 // Op returns the operation name.
-func (m *MessageWithPackageNameMutation) Op() Op {
+func (m *MessageWithProto3OptionalsMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (MessageWithPackageName).
-func (m *MessageWithPackageNameMutation) Type() string {
+// Type returns the node type of this mutation (MessageWithProto3Optionals).
+func (m *MessageWithProto3OptionalsMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageWithPackageNameMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.name != nil {
-		fields = append(fields, messagewithpackagename.FieldName)
+func (m *MessageWithProto3OptionalsMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.str_optional != nil {
+		fields = append(fields, messagewithproto3optionals.FieldStrOptional)
+	}
+	if m.int_optional != nil {
+		fields = append(fields, messagewithproto3optionals.FieldIntOptional)
+	}
+	if m.uuid_optional != nil {
+		fields = append(fields, messagewithproto3optionals.FieldUUIDOptional)
+	}
+	if m.time_optional != nil {
+		fields = append(fields, messagewithproto3optionals.FieldTimeOptional)
+	}
+	if m.str != nil {
+		fields = append(fields, messagewithproto3optionals.FieldStr)
 	}
 	return fields
 }
@@ -4953,10 +6561,18 @@ func (m *MessageWithPackageNameMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageWithPackageNameMutation) Field(name string) (ent.Value, bool) {
+func (m *MessageWithProto3OptionalsMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case messagewithpackagename.FieldName:
-		return m.Name()
+	case messagewithproto3optionals.FieldStrOptional:
+		return m.StrOptional()
+	case messagewithproto3optionals.FieldIntOptional:
+		return m.IntOptional()
+	case messagewithproto3optionals.FieldUUIDOptional:
+		return m.UUIDOptional()
+	case messagewithproto3optionals.FieldTimeOptional:
+		return m.TimeOptional()
+	case messagewithproto3optionals.FieldStr:
+		return m.Str()
 	}
 	return nil, false
 }
@@ -4964,128 +6580,218 @@ func (m *MessageWithPackageNameMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageWithPackageNameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MessageWithProto3OptionalsMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case messagewithpackagename.FieldName:
-		return m.OldName(ctx)
+	case messagewithproto3optionals.FieldStrOptional:
+		return m.OldStrOptional(ctx)
+	case messagewithproto3optionals.FieldIntOptional:
+		return m.OldIntOptional(ctx)
+	case messagewithproto3optionals.FieldUUIDOptional:
+		return m.OldUUIDOptional(ctx)
+	case messagewithproto3optionals.FieldTimeOptional:
+		return m.OldTimeOptional(ctx)
+	case messagewithproto3optionals.FieldStr:
+		return m.OldStr(ctx)
 	}
-	return nil, fmt.Errorf("unknown MessageWithPackageName field %s", name)
+	return nil, fmt.Errorf("unknown MessageWithProto3Optionals field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithPackageNameMutation) SetField(name string, value ent.Value) error {
+func (m *MessageWithProto3OptionalsMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messagewithpackagename.FieldName:
+	case messagewithproto3optionals.FieldStrOptional:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetStrOptional(v)
+		return nil
+	case messagewithproto3optionals.FieldIntOptional:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntOptional(v)
+		return nil
+	case messagewithproto3optionals.FieldUUIDOptional:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUUIDOptional(v)
+		return nil
+	case messagewithproto3optionals.FieldTimeOptional:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeOptional(v)
+		return nil
+	case messagewithproto3optionals.FieldStr:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStr(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MessageWithPackageName field %s", name)
+	return fmt.Errorf("unknown MessageWithProto3Optionals field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageWithPackageNameMutation) AddedFields() []string {
-	return nil
+func (m *MessageWithProto3OptionalsMutation) AddedFields() []string {
+	var fields []string
+	if m.addint_optional != nil {
+		fields = append(fields, messagewithproto3optionals.FieldIntOptional)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageWithPackageNameMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MessageWithProto3OptionalsMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case messagewithproto3optionals.FieldIntOptional:
+		return m.AddedIntOptional()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageWithPackageNameMutation) AddField(name string, value ent.Value) error {
+func (m *MessageWithProto3OptionalsMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case messagewithproto3optionals.FieldIntOptional:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIntOptional(v)
+		return nil
 	}
-	return fmt.Errorf("unknown MessageWithPackageName numeric field %s", name)
+	return fmt.Errorf("unknown MessageWithProto3Optionals numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageWithPackageNameMutation) ClearedFields() []string {
-	return nil
+func (m *MessageWithProto3OptionalsMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(messagewithproto3optionals.FieldStrOptional) {
+		fields = append(fields, messagewithproto3optionals.FieldStrOptional)
+	}
+	if m.FieldCleared(messagewithproto3optionals.FieldIntOptional) {
+		fields = append(fields, messagewithproto3optionals.FieldIntOptional)
+	}
+	if m.FieldCleared(messagewithproto3optionals.FieldUUIDOptional) {
+		fields = append(fields, messagewithproto3optionals.FieldUUIDOptional)
+	}
+	if m.FieldCleared(messagewithproto3optionals.FieldTimeOptional) {
+		fields = append(fields, messagewithproto3optionals.FieldTimeOptional)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageWithPackageNameMutation) FieldCleared(name string) bool {
+func (m *MessageWithProto3OptionalsMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageWithPackageNameMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MessageWithPackageName nullable field %s", name)
+func (m *MessageWithProto3OptionalsMutation) ClearField(name string) error {
+	switch name {
+	case messagewithproto3optionals.FieldStrOptional:
+		m.ClearStrOptional()
+		return nil
+	case messagewithproto3optionals.FieldIntOptional:
+		m.ClearIntOptional()
+		return nil
+	case messagewithproto3optionals.FieldUUIDOptional:
+		m.ClearUUIDOptional()
+		return nil
+	case messagewithproto3optionals.FieldTimeOptional:
+		m.ClearTimeOptional()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageWithProto3Optionals nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageWithPackageNameMutation) ResetField(name string) error {
+func (m *MessageWithProto3OptionalsMutation) ResetField(name string) error {
 	switch name {
-	case messagewithpackagename.FieldName:
-		m.ResetName()
+	case messagewithproto3optionals.FieldStrOptional:
+		m.ResetStrOptional()
+		return nil
+	case messagewithproto3optionals.FieldIntOptional:
+		m.ResetIntOptional()
+		return nil
+	case messagewithproto3optionals.FieldUUIDOptional:
+		m.ResetUUIDOptional()
+		return nil
+	case messagewithproto3optionals.FieldTimeOptional:
+		m.ResetTimeOptional()
+		return nil
+	case messagewithproto3optionals.FieldStr:
+		m.ResetStr()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageWithPackageName field %s", name)
+	return fmt.Errorf("unknown MessageWithProto3Optionals field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageWithPackageNameMutation) AddedEdges() []string {
+func (m *MessageWithProto3OptionalsMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageWithPackageNameMutation) AddedIDs(name string) []ent.Value {
+func (m *MessageWithProto3OptionalsMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageWithPackageNameMutation) RemovedEdges() []string {
+func (m *MessageWithProto3OptionalsMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageWithPackageNameMutation) RemovedIDs(name string) []ent.Value {
+func (m *MessageWithProto3OptionalsMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageWithPackageNameMutation) ClearedEdges() []string {
+func (m *MessageWithProto3OptionalsMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageWithPackageNameMutation) EdgeCleared(name string) bool {
+func (m *MessageWithProto3OptionalsMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageWithPackageNameMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithPackageName unique edge %s", name)
+func (m *MessageWithProto3OptionalsMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithProto3Optionals unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageWithPackageNameMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown MessageWithPackageName edge %s", name)
+func (m *MessageWithProto3OptionalsMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown MessageWithProto3Optionals edge %s", name)
 }
 
 // PortalMutation represents an operation that mutates the Portal nodes in the graph.
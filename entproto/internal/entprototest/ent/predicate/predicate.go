@@ -30,6 +30,9 @@ type ImplicitSkippedMessage func(*sql.Selector)
 // InvalidFieldMessage is the predicate function for invalidfieldmessage builders.
 type InvalidFieldMessage func(*sql.Selector)
 
+// MessageWithConverter is the predicate function for messagewithconverter builders.
+type MessageWithConverter func(*sql.Selector)
+
 // MessageWithEnum is the predicate function for messagewithenum builders.
 type MessageWithEnum func(*sql.Selector)
 
@@ -39,12 +42,18 @@ type MessageWithFieldOne func(*sql.Selector)
 // MessageWithID is the predicate function for messagewithid builders.
 type MessageWithID func(*sql.Selector)
 
+// MessageWithJSON is the predicate function for messagewithjson builders.
+type MessageWithJSON func(*sql.Selector)
+
 // MessageWithOptionals is the predicate function for messagewithoptionals builders.
 type MessageWithOptionals func(*sql.Selector)
 
 // MessageWithPackageName is the predicate function for messagewithpackagename builders.
 type MessageWithPackageName func(*sql.Selector)
 
+// MessageWithProto3Optionals is the predicate function for messagewithproto3optionals builders.
+type MessageWithProto3Optionals func(*sql.Selector)
+
 // Portal is the predicate function for portal builders.
 type Portal func(*sql.Selector)
 
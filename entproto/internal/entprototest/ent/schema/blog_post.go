@@ -52,6 +52,8 @@ func (BlogPost) Fields() []ent.Field {
 func (BlogPost) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entproto.Message(),
-		entproto.Service(),
+		entproto.Service(
+			entproto.BatchMethods(),
+		),
 	}
 }
@@ -43,5 +43,13 @@ func (Category) Fields() []ent.Field {
 }
 
 func (Category) Annotations() []schema.Annotation {
-	return []schema.Annotation{entproto.Message()}
+	return []schema.Annotation{
+		entproto.Message(),
+		entproto.Service(
+			entproto.Methods(entproto.MethodGet|entproto.MethodList),
+			entproto.ServiceName("CategoryReader"),
+			entproto.ServicePackage("entpb.reader"),
+			entproto.HTTP("/v1/categories"),
+		),
+	}
 }
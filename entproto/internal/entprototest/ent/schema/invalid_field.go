@@ -15,14 +15,15 @@
 package schema
 
 import (
+	"database/sql"
+
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
 )
 
-type SomeJSON struct{}
-
 // InvalidFieldMessage holds the schema definition for the InvalidFieldMessage entity.
 type InvalidFieldMessage struct {
 	ent.Schema
@@ -31,7 +32,8 @@ type InvalidFieldMessage struct {
 // Fields of the InvalidFieldMessage.
 func (InvalidFieldMessage) Fields() []ent.Field {
 	return []ent.Field{
-		field.JSON("json", &SomeJSON{}).
+		field.Other("other", &sql.NullString{}).
+			SchemaType(map[string]string{dialect.SQLite: "text"}).
 			Annotations(entproto.Field(2)),
 	}
 }
@@ -0,0 +1,64 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"database/sql"
+	"time"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// MessageWithConverter holds the schema definition for the MessageWithConverter entity.
+type MessageWithConverter struct {
+	ent.Schema
+}
+
+// Fields of the MessageWithConverter.
+func (MessageWithConverter) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("duration").
+			GoType(time.Duration(0)).
+			Annotations(entproto.Field(2,
+				entproto.Converter(durationpb.New, DurationFromProto),
+			)),
+		field.Other("other", &sql.NullString{}).
+			SchemaType(map[string]string{dialect.SQLite: "text"}).
+			Annotations(entproto.Field(3,
+				entproto.Converter(NullStringToProto, NullStringFromProto),
+			)),
+	}
+}
+
+func (MessageWithConverter) Annotations() []schema.Annotation {
+	return []schema.Annotation{entproto.Message()}
+}
+
+func DurationFromProto(d *durationpb.Duration) time.Duration {
+	return d.AsDuration()
+}
+
+func NullStringToProto(s *sql.NullString) []byte {
+	return []byte(s.String)
+}
+
+func NullStringFromProto(b []byte) (*sql.NullString, error) {
+	return &sql.NullString{String: string(b), Valid: b != nil}, nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"encoding/json"
+
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+type SomeJSON struct {
+	Name string `json:"name"`
+}
+
+// Address is stored in the JSON "named" field, and mapped to the geo.Address message.
+type Address struct {
+	Street      string `json:"street,omitempty"`
+	City        string `json:"city,omitempty"`
+	CountryCode string `json:"country_code,omitempty"`
+}
+
+// MessageWithJSON holds the schema definition for the MessageWithJSON entity.
+type MessageWithJSON struct {
+	ent.Schema
+}
+
+// Fields of the MessageWithJSON.
+func (MessageWithJSON) Fields() []ent.Field {
+	return []ent.Field{
+		field.Strings("strings").
+			Annotations(entproto.Field(2)),
+		field.Ints("ints").
+			Annotations(entproto.Field(3)),
+		field.Floats("floats").
+			Optional().
+			Annotations(entproto.Field(4)),
+		field.JSON("object", map[string]interface{}{}).
+			Annotations(entproto.Field(5)),
+		field.JSON("list", []SomeJSON{}).
+			Annotations(entproto.Field(6)),
+		field.JSON("value", &SomeJSON{}).
+			Annotations(entproto.Field(7)),
+		field.JSON("raw", json.RawMessage{}).
+			Annotations(entproto.Field(8)),
+		field.JSON("named", &Address{}).
+			Annotations(entproto.Field(9,
+				entproto.Type(descriptorpb.FieldDescriptorProto_TYPE_MESSAGE),
+				entproto.TypeName("geo.Address"),
+			)),
+	}
+}
+
+func (MessageWithJSON) Annotations() []schema.Annotation {
+	return []schema.Annotation{entproto.Message()}
+}
@@ -60,3 +60,34 @@ func (MessageWithOptionals) Annotations() []schema.Annotation {
 		entproto.Message(),
 	}
 }
+
+type MessageWithProto3Optionals struct {
+	ent.Schema
+}
+
+func (MessageWithProto3Optionals) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("str_optional").
+			Optional().
+			Annotations(entproto.Field(2)),
+		field.Int8("int_optional").
+			Optional().
+			Annotations(entproto.Field(3)),
+		field.UUID("uuid_optional", uuid.New()).
+			Optional().
+			Annotations(entproto.Field(4)),
+		field.Time("time_optional").
+			Optional().
+			Annotations(entproto.Field(5)),
+		field.String("str").
+			Annotations(entproto.Field(6)),
+	}
+}
+
+func (MessageWithProto3Optionals) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entproto.Message(
+			entproto.Proto3Optional(),
+		),
+	}
+}
@@ -28,16 +28,22 @@ type Tx struct {
 	ImplicitSkippedMessage *ImplicitSkippedMessageClient
 	// InvalidFieldMessage is the client for interacting with the InvalidFieldMessage builders.
 	InvalidFieldMessage *InvalidFieldMessageClient
+	// MessageWithConverter is the client for interacting with the MessageWithConverter builders.
+	MessageWithConverter *MessageWithConverterClient
 	// MessageWithEnum is the client for interacting with the MessageWithEnum builders.
 	MessageWithEnum *MessageWithEnumClient
 	// MessageWithFieldOne is the client for interacting with the MessageWithFieldOne builders.
 	MessageWithFieldOne *MessageWithFieldOneClient
 	// MessageWithID is the client for interacting with the MessageWithID builders.
 	MessageWithID *MessageWithIDClient
+	// MessageWithJSON is the client for interacting with the MessageWithJSON builders.
+	MessageWithJSON *MessageWithJSONClient
 	// MessageWithOptionals is the client for interacting with the MessageWithOptionals builders.
 	MessageWithOptionals *MessageWithOptionalsClient
 	// MessageWithPackageName is the client for interacting with the MessageWithPackageName builders.
 	MessageWithPackageName *MessageWithPackageNameClient
+	// MessageWithProto3Optionals is the client for interacting with the MessageWithProto3Optionals builders.
+	MessageWithProto3Optionals *MessageWithProto3OptionalsClient
 	// Portal is the client for interacting with the Portal builders.
 	Portal *PortalClient
 	// User is the client for interacting with the User builders.
@@ -187,11 +193,14 @@ func (tx *Tx) init() {
 	tx.Image = NewImageClient(tx.config)
 	tx.ImplicitSkippedMessage = NewImplicitSkippedMessageClient(tx.config)
 	tx.InvalidFieldMessage = NewInvalidFieldMessageClient(tx.config)
+	tx.MessageWithConverter = NewMessageWithConverterClient(tx.config)
 	tx.MessageWithEnum = NewMessageWithEnumClient(tx.config)
 	tx.MessageWithFieldOne = NewMessageWithFieldOneClient(tx.config)
 	tx.MessageWithID = NewMessageWithIDClient(tx.config)
+	tx.MessageWithJSON = NewMessageWithJSONClient(tx.config)
 	tx.MessageWithOptionals = NewMessageWithOptionalsClient(tx.config)
 	tx.MessageWithPackageName = NewMessageWithPackageNameClient(tx.config)
+	tx.MessageWithProto3Optionals = NewMessageWithProto3OptionalsClient(tx.config)
 	tx.Portal = NewPortalClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.ValidMessage = NewValidMessageClient(tx.config)
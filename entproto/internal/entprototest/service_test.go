@@ -14,6 +14,11 @@
 
 package entprototest
 
+import (
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+)
+
 func (suite *AdapterTestSuite) TestServiceGeneration() {
 	fd, err := suite.adapter.GetFileDescriptor("BlogPost")
 	suite.Require().NoError(err)
@@ -26,6 +31,14 @@ func (suite *AdapterTestSuite) TestServiceGeneration() {
 	suite.EqualValues("GetBlogPostRequest", getMeth.GetInputType().GetName())
 	suite.EqualValues("BlogPost", getMeth.GetOutputType().GetName())
 
+	view := getMeth.GetInputType().FindFieldByName("view").GetEnumType()
+	suite.Require().NotNil(view)
+	suite.EqualValues(3, view.FindValueByName("WITH_EDGES").GetNumber())
+	edges := getMeth.GetInputType().FindFieldByName("edges")
+	suite.Require().NotNil(edges)
+	suite.EqualValues(3, edges.GetNumber())
+	suite.True(edges.IsRepeated())
+
 	createMeth := svc.FindMethodByName("Create")
 	suite.Require().NotNil(createMeth)
 	suite.EqualValues("CreateBlogPostRequest", createMeth.GetInputType().GetName())
@@ -40,4 +53,91 @@ func (suite *AdapterTestSuite) TestServiceGeneration() {
 	suite.Require().NotNil(updateMeth)
 	suite.EqualValues("UpdateBlogPostRequest", updateMeth.GetInputType().GetName())
 	suite.EqualValues("BlogPost", updateMeth.GetOutputType().GetName())
+	updateMask := updateMeth.GetInputType().FindFieldByNumber(2)
+	suite.Require().NotNil(updateMask)
+	suite.EqualValues("update_mask", updateMask.GetName())
+	suite.EqualValues("google.protobuf.FieldMask", updateMask.GetMessageType().GetFullyQualifiedName())
+
+	listMeth := svc.FindMethodByName("List")
+	suite.Require().NotNil(listMeth)
+	suite.EqualValues("ListBlogPostRequest", listMeth.GetInputType().GetName())
+	suite.EqualValues("ListBlogPostResponse", listMeth.GetOutputType().GetName())
+	suite.EqualValues("blog_posts", listMeth.GetOutputType().FindFieldByNumber(1).GetName())
+	suite.EqualValues("next_page_token", listMeth.GetOutputType().FindFieldByNumber(2).GetName())
+	for _, name := range []string{"page_size", "page_token", "view", "order_by", "filter", "edges"} {
+		suite.NotNil(listMeth.GetInputType().FindFieldByName(name), "missing field %q", name)
+	}
+
+	filter := listMeth.GetInputType().FindFieldByName("filter").GetMessageType()
+	suite.Require().NotNil(filter)
+	for _, name := range []string{"id", "title", "body", "external_id"} {
+		fld := filter.FindFieldByName(name)
+		suite.Require().NotNil(fld, "missing filter field %q", name)
+		suite.True(fld.IsRepeated())
+	}
+	suite.EqualValues(7, filter.FindFieldByName("external_id").GetNumber())
+
+	filterMap, err := suite.adapter.FilterFieldMap("BlogPost")
+	suite.Require().NoError(err)
+	suite.True(filterMap.ID().IsIDField)
+	suite.Len(filterMap.Fields(), 4)
+
+	for _, name := range []string{"BatchCreate", "BatchGet", "BatchDelete"} {
+		meth := svc.FindMethodByName(name)
+		suite.Require().NotNil(meth, "missing method %q", name)
+		suite.EqualValues(name+"BlogPostsRequest", meth.GetInputType().GetName())
+		suite.EqualValues(name+"BlogPostsResponse", meth.GetOutputType().GetName())
+		results := meth.GetOutputType().FindFieldByName("results")
+		suite.Require().NotNil(results)
+		suite.True(results.IsRepeated())
+		for _, field := range []string{"code", "message"} {
+			suite.NotNil(results.GetMessageType().FindFieldByName(field), "missing result field %q", field)
+		}
+	}
+	batchCreate := svc.FindMethodByName("BatchCreate").GetInputType()
+	suite.EqualValues("CreateBlogPostRequest", batchCreate.FindFieldByName("requests").GetMessageType().GetName())
+	suite.NotNil(batchCreate.FindFieldByName("atomic"))
+	batchGet := svc.FindMethodByName("BatchGet").GetInputType()
+	suite.True(batchGet.FindFieldByName("ids").IsRepeated())
+	suite.Nil(batchGet.FindFieldByName("atomic"))
+	suite.NotNil(batchGet.FindFieldByName("edges"))
+	batchDelete := svc.FindMethodByName("BatchDelete")
+	suite.True(batchDelete.GetInputType().FindFieldByName("ids").IsRepeated())
+	suite.NotNil(batchDelete.GetOutputType().FindFieldByName("results").GetMessageType().FindFieldByName("id"))
+	suite.False(proto.HasExtension(svc.FindMethodByName("Get").GetMethodOptions(), annotations.E_Http))
+}
+
+func (suite *AdapterTestSuite) TestServiceOptions() {
+	fd, err := suite.adapter.GetFileDescriptor("Category")
+	suite.Require().NoError(err)
+	suite.Nil(fd.FindService("entpb.CategoryService"))
+
+	svc, err := suite.adapter.GetServiceDescriptor("Category")
+	suite.Require().NoError(err)
+	suite.EqualValues("entpb.reader.CategoryReader", svc.GetFullyQualifiedName())
+	suite.EqualValues("entpb/reader/reader.proto", svc.GetFile().GetName())
+	var methods []string
+	for _, m := range svc.GetMethods() {
+		methods = append(methods, m.GetName())
+	}
+	suite.Equal([]string{"Get", "List"}, methods)
+	suite.EqualValues("entpb.Category", svc.FindMethodByName("Get").GetOutputType().GetFullyQualifiedName())
+	suite.EqualValues("entpb.reader.GetCategoryRequest", svc.FindMethodByName("Get").GetInputType().GetFullyQualifiedName())
+
+	var deps []string
+	for _, dep := range svc.GetFile().GetDependencies() {
+		deps = append(deps, dep.GetName())
+	}
+	suite.Equal([]string{"entpb/entpb.proto", "google/api/annotations.proto"}, deps)
+
+	rule, ok := proto.GetExtension(svc.FindMethodByName("Get").GetMethodOptions(), annotations.E_Http).(*annotations.HttpRule)
+	suite.Require().True(ok)
+	suite.EqualValues("/v1/categories/{id}", rule.GetGet())
+	rule, ok = proto.GetExtension(svc.FindMethodByName("List").GetMethodOptions(), annotations.E_Http).(*annotations.HttpRule)
+	suite.Require().True(ok)
+	suite.EqualValues("/v1/categories", rule.GetGet())
+
+	filterMap, err := suite.adapter.FilterFieldMap("Category")
+	suite.Require().NoError(err)
+	suite.Len(filterMap.Fields(), 3)
 }
@@ -0,0 +1,36 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build ignore
+
+package main
+
+import (
+	"log"
+
+	"entgo.io/contrib/entproto"
+	_ "entgo.io/contrib/entproto/internal/todo/ent/proto/geo" // register the messages of geo.proto
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+)
+
+func main() {
+	graph, err := entc.LoadGraph("./schema", &gen.Config{})
+	if err != nil {
+		log.Fatalf("entproto: failed loading ent graph: %v", err)
+	}
+	if err := entproto.Generate(graph); err != nil {
+		log.Fatalf("entproto: failed generating protos: %s", err)
+	}
+}
@@ -43,6 +43,7 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "str_nil", Type: field.TypeString, Nullable: true},
 		{Name: "time_nil", Type: field.TypeTime, Nullable: true},
+		{Name: "int_opt", Type: field.TypeInt, Nullable: true},
 	}
 	// NilExamplesTable holds the schema information for the "nil_examples" table.
 	NilExamplesTable = &schema.Table{
@@ -90,6 +91,13 @@ var (
 		{Name: "b_user_1", Type: field.TypeInt, Unique: true, Nullable: true},
 		{Name: "height_in_cm", Type: field.TypeFloat32, Default: 0},
 		{Name: "account_balance", Type: field.TypeFloat64, Default: 0},
+		{Name: "labels", Type: field.TypeJSON, Nullable: true},
+		{Name: "scores", Type: field.TypeJSON, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "preferences", Type: field.TypeJSON, Nullable: true},
+		{Name: "session_timeout", Type: field.TypeInt64, Nullable: true},
+		{Name: "price", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(20,4)", "postgres": "numeric", "sqlite3": "text"}},
+		{Name: "address", Type: field.TypeJSON, Nullable: true},
 		{Name: "user_group", Type: field.TypeInt, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -100,7 +108,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_groups_group",
-				Columns:    []*schema.Column{UsersColumns[17]},
+				Columns:    []*schema.Column{UsersColumns[24]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -817,6 +817,8 @@ type NilExampleMutation struct {
 	id            *int
 	str_nil       *string
 	time_nil      *time.Time
+	int_opt       *int
+	addint_opt    *int
 	clearedFields map[string]struct{}
 	done          bool
 	oldValue      func(context.Context) (*NilExample, error)
@@ -1000,6 +1002,76 @@ func (m *NilExampleMutation) ResetTimeNil() {
 	delete(m.clearedFields, nilexample.FieldTimeNil)
 }
 
+// SetIntOpt sets the "int_opt" field.
+func (m *NilExampleMutation) SetIntOpt(i int) {
+	m.int_opt = &i
+	m.addint_opt = nil
+}
+
+// IntOpt returns the value of the "int_opt" field in the mutation.
+func (m *NilExampleMutation) IntOpt() (r int, exists bool) {
+	v := m.int_opt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntOpt returns the old "int_opt" field's value of the NilExample entity.
+// If the NilExample object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NilExampleMutation) OldIntOpt(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldIntOpt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldIntOpt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntOpt: %w", err)
+	}
+	return oldValue.IntOpt, nil
+}
+
+// AddIntOpt adds i to the "int_opt" field.
+func (m *NilExampleMutation) AddIntOpt(i int) {
+	if m.addint_opt != nil {
+		*m.addint_opt += i
+	} else {
+		m.addint_opt = &i
+	}
+}
+
+// AddedIntOpt returns the value that was added to the "int_opt" field in this mutation.
+func (m *NilExampleMutation) AddedIntOpt() (r int, exists bool) {
+	v := m.addint_opt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearIntOpt clears the value of the "int_opt" field.
+func (m *NilExampleMutation) ClearIntOpt() {
+	m.int_opt = nil
+	m.addint_opt = nil
+	m.clearedFields[nilexample.FieldIntOpt] = struct{}{}
+}
+
+// IntOptCleared returns if the "int_opt" field was cleared in this mutation.
+func (m *NilExampleMutation) IntOptCleared() bool {
+	_, ok := m.clearedFields[nilexample.FieldIntOpt]
+	return ok
+}
+
+// ResetIntOpt resets all changes to the "int_opt" field.
+func (m *NilExampleMutation) ResetIntOpt() {
+	m.int_opt = nil
+	m.addint_opt = nil
+	delete(m.clearedFields, nilexample.FieldIntOpt)
+}
+
 // Where appends a list predicates to the NilExampleMutation builder.
 func (m *NilExampleMutation) Where(ps ...predicate.NilExample) {
 	m.predicates = append(m.predicates, ps...)
@@ -1019,13 +1091,16 @@ func (m *NilExampleMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *NilExampleMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 3)
 	if m.str_nil != nil {
 		fields = append(fields, nilexample.FieldStrNil)
 	}
 	if m.time_nil != nil {
 		fields = append(fields, nilexample.FieldTimeNil)
 	}
+	if m.int_opt != nil {
+		fields = append(fields, nilexample.FieldIntOpt)
+	}
 	return fields
 }
 
@@ -1038,6 +1113,8 @@ func (m *NilExampleMutation) Field(name string) (ent.Value, bool) {
 		return m.StrNil()
 	case nilexample.FieldTimeNil:
 		return m.TimeNil()
+	case nilexample.FieldIntOpt:
+		return m.IntOpt()
 	}
 	return nil, false
 }
@@ -1051,6 +1128,8 @@ func (m *NilExampleMutation) OldField(ctx context.Context, name string) (ent.Val
 		return m.OldStrNil(ctx)
 	case nilexample.FieldTimeNil:
 		return m.OldTimeNil(ctx)
+	case nilexample.FieldIntOpt:
+		return m.OldIntOpt(ctx)
 	}
 	return nil, fmt.Errorf("unknown NilExample field %s", name)
 }
@@ -1074,6 +1153,13 @@ func (m *NilExampleMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetTimeNil(v)
 		return nil
+	case nilexample.FieldIntOpt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntOpt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown NilExample field %s", name)
 }
@@ -1081,13 +1167,21 @@ func (m *NilExampleMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *NilExampleMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addint_opt != nil {
+		fields = append(fields, nilexample.FieldIntOpt)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *NilExampleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case nilexample.FieldIntOpt:
+		return m.AddedIntOpt()
+	}
 	return nil, false
 }
 
@@ -1096,6 +1190,13 @@ func (m *NilExampleMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *NilExampleMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case nilexample.FieldIntOpt:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIntOpt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown NilExample numeric field %s", name)
 }
@@ -1110,6 +1211,9 @@ func (m *NilExampleMutation) ClearedFields() []string {
 	if m.FieldCleared(nilexample.FieldTimeNil) {
 		fields = append(fields, nilexample.FieldTimeNil)
 	}
+	if m.FieldCleared(nilexample.FieldIntOpt) {
+		fields = append(fields, nilexample.FieldIntOpt)
+	}
 	return fields
 }
 
@@ -1130,6 +1234,9 @@ func (m *NilExampleMutation) ClearField(name string) error {
 	case nilexample.FieldTimeNil:
 		m.ClearTimeNil()
 		return nil
+	case nilexample.FieldIntOpt:
+		m.ClearIntOpt()
+		return nil
 	}
 	return fmt.Errorf("unknown NilExample nullable field %s", name)
 }
@@ -1144,6 +1251,9 @@ func (m *NilExampleMutation) ResetField(name string) error {
 	case nilexample.FieldTimeNil:
 		m.ResetTimeNil()
 		return nil
+	case nilexample.FieldIntOpt:
+		m.ResetIntOpt()
+		return nil
 	}
 	return fmt.Errorf("unknown NilExample field %s", name)
 }
@@ -1641,6 +1751,14 @@ type UserMutation struct {
 	addheight_in_cm    *float32
 	account_balance    *float64
 	addaccount_balance *float64
+	labels             *[]string
+	scores             *[]int
+	metadata           *map[string]interface{}
+	preferences        *schema.Preferences
+	session_timeout    *time.Duration
+	addsession_timeout *time.Duration
+	price              *schema.Price
+	address            **schema.Address
 	clearedFields      map[string]struct{}
 	group              *int
 	clearedgroup       bool
@@ -2536,6 +2654,370 @@ func (m *UserMutation) ResetAccountBalance() {
 	m.addaccount_balance = nil
 }
 
+// SetLabels sets the "labels" field.
+func (m *UserMutation) SetLabels(s []string) {
+	m.labels = &s
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *UserMutation) Labels() (r []string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLabels(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (m *UserMutation) ClearLabels() {
+	m.labels = nil
+	m.clearedFields[user.FieldLabels] = struct{}{}
+}
+
+// LabelsCleared returns if the "labels" field was cleared in this mutation.
+func (m *UserMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[user.FieldLabels]
+	return ok
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *UserMutation) ResetLabels() {
+	m.labels = nil
+	delete(m.clearedFields, user.FieldLabels)
+}
+
+// SetScores sets the "scores" field.
+func (m *UserMutation) SetScores(i []int) {
+	m.scores = &i
+}
+
+// Scores returns the value of the "scores" field in the mutation.
+func (m *UserMutation) Scores() (r []int, exists bool) {
+	v := m.scores
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScores returns the old "scores" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldScores(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldScores is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldScores requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScores: %w", err)
+	}
+	return oldValue.Scores, nil
+}
+
+// ClearScores clears the value of the "scores" field.
+func (m *UserMutation) ClearScores() {
+	m.scores = nil
+	m.clearedFields[user.FieldScores] = struct{}{}
+}
+
+// ScoresCleared returns if the "scores" field was cleared in this mutation.
+func (m *UserMutation) ScoresCleared() bool {
+	_, ok := m.clearedFields[user.FieldScores]
+	return ok
+}
+
+// ResetScores resets all changes to the "scores" field.
+func (m *UserMutation) ResetScores() {
+	m.scores = nil
+	delete(m.clearedFields, user.FieldScores)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *UserMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *UserMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *UserMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[user.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *UserMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[user.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *UserMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, user.FieldMetadata)
+}
+
+// SetPreferences sets the "preferences" field.
+func (m *UserMutation) SetPreferences(s schema.Preferences) {
+	m.preferences = &s
+}
+
+// Preferences returns the value of the "preferences" field in the mutation.
+func (m *UserMutation) Preferences() (r schema.Preferences, exists bool) {
+	v := m.preferences
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreferences returns the old "preferences" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPreferences(ctx context.Context) (v schema.Preferences, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPreferences is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPreferences requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreferences: %w", err)
+	}
+	return oldValue.Preferences, nil
+}
+
+// ClearPreferences clears the value of the "preferences" field.
+func (m *UserMutation) ClearPreferences() {
+	m.preferences = nil
+	m.clearedFields[user.FieldPreferences] = struct{}{}
+}
+
+// PreferencesCleared returns if the "preferences" field was cleared in this mutation.
+func (m *UserMutation) PreferencesCleared() bool {
+	_, ok := m.clearedFields[user.FieldPreferences]
+	return ok
+}
+
+// ResetPreferences resets all changes to the "preferences" field.
+func (m *UserMutation) ResetPreferences() {
+	m.preferences = nil
+	delete(m.clearedFields, user.FieldPreferences)
+}
+
+// SetSessionTimeout sets the "session_timeout" field.
+func (m *UserMutation) SetSessionTimeout(t time.Duration) {
+	m.session_timeout = &t
+	m.addsession_timeout = nil
+}
+
+// SessionTimeout returns the value of the "session_timeout" field in the mutation.
+func (m *UserMutation) SessionTimeout() (r time.Duration, exists bool) {
+	v := m.session_timeout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionTimeout returns the old "session_timeout" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSessionTimeout(ctx context.Context) (v time.Duration, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldSessionTimeout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldSessionTimeout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionTimeout: %w", err)
+	}
+	return oldValue.SessionTimeout, nil
+}
+
+// AddSessionTimeout adds t to the "session_timeout" field.
+func (m *UserMutation) AddSessionTimeout(t time.Duration) {
+	if m.addsession_timeout != nil {
+		*m.addsession_timeout += t
+	} else {
+		m.addsession_timeout = &t
+	}
+}
+
+// AddedSessionTimeout returns the value that was added to the "session_timeout" field in this mutation.
+func (m *UserMutation) AddedSessionTimeout() (r time.Duration, exists bool) {
+	v := m.addsession_timeout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSessionTimeout clears the value of the "session_timeout" field.
+func (m *UserMutation) ClearSessionTimeout() {
+	m.session_timeout = nil
+	m.addsession_timeout = nil
+	m.clearedFields[user.FieldSessionTimeout] = struct{}{}
+}
+
+// SessionTimeoutCleared returns if the "session_timeout" field was cleared in this mutation.
+func (m *UserMutation) SessionTimeoutCleared() bool {
+	_, ok := m.clearedFields[user.FieldSessionTimeout]
+	return ok
+}
+
+// ResetSessionTimeout resets all changes to the "session_timeout" field.
+func (m *UserMutation) ResetSessionTimeout() {
+	m.session_timeout = nil
+	m.addsession_timeout = nil
+	delete(m.clearedFields, user.FieldSessionTimeout)
+}
+
+// SetPrice sets the "price" field.
+func (m *UserMutation) SetPrice(s schema.Price) {
+	m.price = &s
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *UserMutation) Price() (r schema.Price, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPrice(ctx context.Context) (v schema.Price, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// ClearPrice clears the value of the "price" field.
+func (m *UserMutation) ClearPrice() {
+	m.price = nil
+	m.clearedFields[user.FieldPrice] = struct{}{}
+}
+
+// PriceCleared returns if the "price" field was cleared in this mutation.
+func (m *UserMutation) PriceCleared() bool {
+	_, ok := m.clearedFields[user.FieldPrice]
+	return ok
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *UserMutation) ResetPrice() {
+	m.price = nil
+	delete(m.clearedFields, user.FieldPrice)
+}
+
+// SetAddress sets the "address" field.
+func (m *UserMutation) SetAddress(s *schema.Address) {
+	m.address = &s
+}
+
+// Address returns the value of the "address" field in the mutation.
+func (m *UserMutation) Address() (r *schema.Address, exists bool) {
+	v := m.address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddress returns the old "address" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAddress(ctx context.Context) (v *schema.Address, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, fmt.Errorf("OldAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, fmt.Errorf("OldAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddress: %w", err)
+	}
+	return oldValue.Address, nil
+}
+
+// ClearAddress clears the value of the "address" field.
+func (m *UserMutation) ClearAddress() {
+	m.address = nil
+	m.clearedFields[user.FieldAddress] = struct{}{}
+}
+
+// AddressCleared returns if the "address" field was cleared in this mutation.
+func (m *UserMutation) AddressCleared() bool {
+	_, ok := m.clearedFields[user.FieldAddress]
+	return ok
+}
+
+// ResetAddress resets all changes to the "address" field.
+func (m *UserMutation) ResetAddress() {
+	m.address = nil
+	delete(m.clearedFields, user.FieldAddress)
+}
+
 // SetGroupID sets the "group" edge to the Group entity by id.
 func (m *UserMutation) SetGroupID(id int) {
 	m.group = &id
@@ -2687,7 +3169,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 16)
+	fields := make([]string, 0, 23)
 	if m.user_name != nil {
 		fields = append(fields, user.FieldUserName)
 	}
@@ -2736,6 +3218,27 @@ func (m *UserMutation) Fields() []string {
 	if m.account_balance != nil {
 		fields = append(fields, user.FieldAccountBalance)
 	}
+	if m.labels != nil {
+		fields = append(fields, user.FieldLabels)
+	}
+	if m.scores != nil {
+		fields = append(fields, user.FieldScores)
+	}
+	if m.metadata != nil {
+		fields = append(fields, user.FieldMetadata)
+	}
+	if m.preferences != nil {
+		fields = append(fields, user.FieldPreferences)
+	}
+	if m.session_timeout != nil {
+		fields = append(fields, user.FieldSessionTimeout)
+	}
+	if m.price != nil {
+		fields = append(fields, user.FieldPrice)
+	}
+	if m.address != nil {
+		fields = append(fields, user.FieldAddress)
+	}
 	return fields
 }
 
@@ -2776,6 +3279,20 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.HeightInCm()
 	case user.FieldAccountBalance:
 		return m.AccountBalance()
+	case user.FieldLabels:
+		return m.Labels()
+	case user.FieldScores:
+		return m.Scores()
+	case user.FieldMetadata:
+		return m.Metadata()
+	case user.FieldPreferences:
+		return m.Preferences()
+	case user.FieldSessionTimeout:
+		return m.SessionTimeout()
+	case user.FieldPrice:
+		return m.Price()
+	case user.FieldAddress:
+		return m.Address()
 	}
 	return nil, false
 }
@@ -2817,6 +3334,20 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldHeightInCm(ctx)
 	case user.FieldAccountBalance:
 		return m.OldAccountBalance(ctx)
+	case user.FieldLabels:
+		return m.OldLabels(ctx)
+	case user.FieldScores:
+		return m.OldScores(ctx)
+	case user.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case user.FieldPreferences:
+		return m.OldPreferences(ctx)
+	case user.FieldSessionTimeout:
+		return m.OldSessionTimeout(ctx)
+	case user.FieldPrice:
+		return m.OldPrice(ctx)
+	case user.FieldAddress:
+		return m.OldAddress(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -2938,6 +3469,55 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAccountBalance(v)
 		return nil
+	case user.FieldLabels:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
+	case user.FieldScores:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScores(v)
+		return nil
+	case user.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case user.FieldPreferences:
+		v, ok := value.(schema.Preferences)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreferences(v)
+		return nil
+	case user.FieldSessionTimeout:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionTimeout(v)
+		return nil
+	case user.FieldPrice:
+		v, ok := value.(schema.Price)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case user.FieldAddress:
+		v, ok := value.(*schema.Address)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddress(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -2970,6 +3550,9 @@ func (m *UserMutation) AddedFields() []string {
 	if m.addaccount_balance != nil {
 		fields = append(fields, user.FieldAccountBalance)
 	}
+	if m.addsession_timeout != nil {
+		fields = append(fields, user.FieldSessionTimeout)
+	}
 	return fields
 }
 
@@ -2994,6 +3577,8 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedHeightInCm()
 	case user.FieldAccountBalance:
 		return m.AddedAccountBalance()
+	case user.FieldSessionTimeout:
+		return m.AddedSessionTimeout()
 	}
 	return nil, false
 }
@@ -3059,6 +3644,13 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddAccountBalance(v)
 		return nil
+	case user.FieldSessionTimeout:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSessionTimeout(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
@@ -3082,6 +3674,27 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldBUser1) {
 		fields = append(fields, user.FieldBUser1)
 	}
+	if m.FieldCleared(user.FieldLabels) {
+		fields = append(fields, user.FieldLabels)
+	}
+	if m.FieldCleared(user.FieldScores) {
+		fields = append(fields, user.FieldScores)
+	}
+	if m.FieldCleared(user.FieldMetadata) {
+		fields = append(fields, user.FieldMetadata)
+	}
+	if m.FieldCleared(user.FieldPreferences) {
+		fields = append(fields, user.FieldPreferences)
+	}
+	if m.FieldCleared(user.FieldSessionTimeout) {
+		fields = append(fields, user.FieldSessionTimeout)
+	}
+	if m.FieldCleared(user.FieldPrice) {
+		fields = append(fields, user.FieldPrice)
+	}
+	if m.FieldCleared(user.FieldAddress) {
+		fields = append(fields, user.FieldAddress)
+	}
 	return fields
 }
 
@@ -3111,6 +3724,27 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldBUser1:
 		m.ClearBUser1()
 		return nil
+	case user.FieldLabels:
+		m.ClearLabels()
+		return nil
+	case user.FieldScores:
+		m.ClearScores()
+		return nil
+	case user.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case user.FieldPreferences:
+		m.ClearPreferences()
+		return nil
+	case user.FieldSessionTimeout:
+		m.ClearSessionTimeout()
+		return nil
+	case user.FieldPrice:
+		m.ClearPrice()
+		return nil
+	case user.FieldAddress:
+		m.ClearAddress()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -3167,6 +3801,27 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldAccountBalance:
 		m.ResetAccountBalance()
 		return nil
+	case user.FieldLabels:
+		m.ResetLabels()
+		return nil
+	case user.FieldScores:
+		m.ResetScores()
+		return nil
+	case user.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case user.FieldPreferences:
+		m.ResetPreferences()
+		return nil
+	case user.FieldSessionTimeout:
+		m.ResetSessionTimeout()
+		return nil
+	case user.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case user.FieldAddress:
+		m.ResetAddress()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
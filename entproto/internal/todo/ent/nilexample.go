@@ -20,6 +20,8 @@ type NilExample struct {
 	StrNil *string `json:"str_nil,omitempty"`
 	// TimeNil holds the value of the "time_nil" field.
 	TimeNil *time.Time `json:"time_nil,omitempty"`
+	// IntOpt holds the value of the "int_opt" field.
+	IntOpt int `json:"int_opt,omitempty"`
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -27,7 +29,7 @@ func (*NilExample) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case nilexample.FieldID:
+		case nilexample.FieldID, nilexample.FieldIntOpt:
 			values[i] = new(sql.NullInt64)
 		case nilexample.FieldStrNil:
 			values[i] = new(sql.NullString)
@@ -68,6 +70,12 @@ func (ne *NilExample) assignValues(columns []string, values []interface{}) error
 				ne.TimeNil = new(time.Time)
 				*ne.TimeNil = value.Time
 			}
+		case nilexample.FieldIntOpt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field int_opt", values[i])
+			} else if value.Valid {
+				ne.IntOpt = int(value.Int64)
+			}
 		}
 	}
 	return nil
@@ -104,6 +112,8 @@ func (ne *NilExample) String() string {
 		builder.WriteString(", time_nil=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", int_opt=")
+	builder.WriteString(fmt.Sprintf("%v", ne.IntOpt))
 	builder.WriteByte(')')
 	return builder.String()
 }
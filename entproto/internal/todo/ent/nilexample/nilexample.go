@@ -11,6 +11,8 @@ const (
 	FieldStrNil = "str_nil"
 	// FieldTimeNil holds the string denoting the time_nil field in the database.
 	FieldTimeNil = "time_nil"
+	// FieldIntOpt holds the string denoting the int_opt field in the database.
+	FieldIntOpt = "int_opt"
 	// Table holds the table name of the nilexample in the database.
 	Table = "nil_examples"
 )
@@ -20,6 +22,7 @@ var Columns = []string{
 	FieldID,
 	FieldStrNil,
 	FieldTimeNil,
+	FieldIntOpt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
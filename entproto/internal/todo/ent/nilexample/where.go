@@ -106,6 +106,13 @@ func TimeNil(v time.Time) predicate.NilExample {
 	})
 }
 
+// IntOpt applies equality check predicate on the "int_opt" field. It's identical to IntOptEQ.
+func IntOpt(v int) predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIntOpt), v))
+	})
+}
+
 // StrNilEQ applies the EQ predicate on the "str_nil" field.
 func StrNilEQ(v string) predicate.NilExample {
 	return predicate.NilExample(func(s *sql.Selector) {
@@ -321,6 +328,96 @@ func TimeNilNotNil() predicate.NilExample {
 	})
 }
 
+// IntOptEQ applies the EQ predicate on the "int_opt" field.
+func IntOptEQ(v int) predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldIntOpt), v))
+	})
+}
+
+// IntOptNEQ applies the NEQ predicate on the "int_opt" field.
+func IntOptNEQ(v int) predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldIntOpt), v))
+	})
+}
+
+// IntOptIn applies the In predicate on the "int_opt" field.
+func IntOptIn(vs ...int) predicate.NilExample {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NilExample(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldIntOpt), v...))
+	})
+}
+
+// IntOptNotIn applies the NotIn predicate on the "int_opt" field.
+func IntOptNotIn(vs ...int) predicate.NilExample {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.NilExample(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(v) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldIntOpt), v...))
+	})
+}
+
+// IntOptGT applies the GT predicate on the "int_opt" field.
+func IntOptGT(v int) predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldIntOpt), v))
+	})
+}
+
+// IntOptGTE applies the GTE predicate on the "int_opt" field.
+func IntOptGTE(v int) predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldIntOpt), v))
+	})
+}
+
+// IntOptLT applies the LT predicate on the "int_opt" field.
+func IntOptLT(v int) predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldIntOpt), v))
+	})
+}
+
+// IntOptLTE applies the LTE predicate on the "int_opt" field.
+func IntOptLTE(v int) predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldIntOpt), v))
+	})
+}
+
+// IntOptIsNil applies the IsNil predicate on the "int_opt" field.
+func IntOptIsNil() predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldIntOpt)))
+	})
+}
+
+// IntOptNotNil applies the NotNil predicate on the "int_opt" field.
+func IntOptNotNil() predicate.NilExample {
+	return predicate.NilExample(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldIntOpt)))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.NilExample) predicate.NilExample {
 	return predicate.NilExample(func(s *sql.Selector) {
@@ -47,6 +47,20 @@ func (nec *NilExampleCreate) SetNillableTimeNil(t *time.Time) *NilExampleCreate
 	return nec
 }
 
+// SetIntOpt sets the "int_opt" field.
+func (nec *NilExampleCreate) SetIntOpt(i int) *NilExampleCreate {
+	nec.mutation.SetIntOpt(i)
+	return nec
+}
+
+// SetNillableIntOpt sets the "int_opt" field if the given value is not nil.
+func (nec *NilExampleCreate) SetNillableIntOpt(i *int) *NilExampleCreate {
+	if i != nil {
+		nec.SetIntOpt(*i)
+	}
+	return nec
+}
+
 // Mutation returns the NilExampleMutation object of the builder.
 func (nec *NilExampleCreate) Mutation() *NilExampleMutation {
 	return nec.mutation
@@ -160,6 +174,14 @@ func (nec *NilExampleCreate) createSpec() (*NilExample, *sqlgraph.CreateSpec) {
 		})
 		_node.TimeNil = &value
 	}
+	if value, ok := nec.mutation.IntOpt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: nilexample.FieldIntOpt,
+		})
+		_node.IntOpt = value
+	}
 	return _node, _spec
 }
 
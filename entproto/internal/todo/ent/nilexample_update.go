@@ -67,6 +67,33 @@ func (neu *NilExampleUpdate) ClearTimeNil() *NilExampleUpdate {
 	return neu
 }
 
+// SetIntOpt sets the "int_opt" field.
+func (neu *NilExampleUpdate) SetIntOpt(i int) *NilExampleUpdate {
+	neu.mutation.ResetIntOpt()
+	neu.mutation.SetIntOpt(i)
+	return neu
+}
+
+// SetNillableIntOpt sets the "int_opt" field if the given value is not nil.
+func (neu *NilExampleUpdate) SetNillableIntOpt(i *int) *NilExampleUpdate {
+	if i != nil {
+		neu.SetIntOpt(*i)
+	}
+	return neu
+}
+
+// AddIntOpt adds i to the "int_opt" field.
+func (neu *NilExampleUpdate) AddIntOpt(i int) *NilExampleUpdate {
+	neu.mutation.AddIntOpt(i)
+	return neu
+}
+
+// ClearIntOpt clears the value of the "int_opt" field.
+func (neu *NilExampleUpdate) ClearIntOpt() *NilExampleUpdate {
+	neu.mutation.ClearIntOpt()
+	return neu
+}
+
 // Mutation returns the NilExampleMutation object of the builder.
 func (neu *NilExampleUpdate) Mutation() *NilExampleMutation {
 	return neu.mutation
@@ -170,6 +197,26 @@ func (neu *NilExampleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: nilexample.FieldTimeNil,
 		})
 	}
+	if value, ok := neu.mutation.IntOpt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: nilexample.FieldIntOpt,
+		})
+	}
+	if value, ok := neu.mutation.AddedIntOpt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: nilexample.FieldIntOpt,
+		})
+	}
+	if neu.mutation.IntOptCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: nilexample.FieldIntOpt,
+		})
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, neu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{nilexample.Label}
@@ -229,6 +276,33 @@ func (neuo *NilExampleUpdateOne) ClearTimeNil() *NilExampleUpdateOne {
 	return neuo
 }
 
+// SetIntOpt sets the "int_opt" field.
+func (neuo *NilExampleUpdateOne) SetIntOpt(i int) *NilExampleUpdateOne {
+	neuo.mutation.ResetIntOpt()
+	neuo.mutation.SetIntOpt(i)
+	return neuo
+}
+
+// SetNillableIntOpt sets the "int_opt" field if the given value is not nil.
+func (neuo *NilExampleUpdateOne) SetNillableIntOpt(i *int) *NilExampleUpdateOne {
+	if i != nil {
+		neuo.SetIntOpt(*i)
+	}
+	return neuo
+}
+
+// AddIntOpt adds i to the "int_opt" field.
+func (neuo *NilExampleUpdateOne) AddIntOpt(i int) *NilExampleUpdateOne {
+	neuo.mutation.AddIntOpt(i)
+	return neuo
+}
+
+// ClearIntOpt clears the value of the "int_opt" field.
+func (neuo *NilExampleUpdateOne) ClearIntOpt() *NilExampleUpdateOne {
+	neuo.mutation.ClearIntOpt()
+	return neuo
+}
+
 // Mutation returns the NilExampleMutation object of the builder.
 func (neuo *NilExampleUpdateOne) Mutation() *NilExampleMutation {
 	return neuo.mutation
@@ -356,6 +430,26 @@ func (neuo *NilExampleUpdateOne) sqlSave(ctx context.Context) (_node *NilExample
 			Column: nilexample.FieldTimeNil,
 		})
 	}
+	if value, ok := neuo.mutation.IntOpt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: nilexample.FieldIntOpt,
+		})
+	}
+	if value, ok := neuo.mutation.AddedIntOpt(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: nilexample.FieldIntOpt,
+		})
+	}
+	if neuo.mutation.IntOptCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: nilexample.FieldIntOpt,
+		})
+	}
 	_node = &NilExample{config: neuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestAttachmentService_Get(t *testing.T) {
@@ -97,3 +98,31 @@ func TestAttachmentService_MultiEdge(t *testing.T) {
 	require.NotNil(t, get.User)
 	require.Len(t, get.Recipients, 4)
 }
+
+func TestAttachmentService_UpdateMask(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewAttachmentService(client)
+	ctx := context.Background()
+	u := client.User.Create().
+		SetUserName("a8m").
+		SetJoined(time.Now()).
+		SetPoints(10).
+		SetExp(1000).
+		SetStatus("pending").
+		SetExternalID(1).
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SaveX(ctx)
+	att := client.Attachment.Create().SetUser(u).SaveX(ctx)
+	id, err := att.ID.MarshalBinary()
+	require.NoError(t, err)
+
+	// A masked optional edge without a value is cleared.
+	_, err = svc.Update(ctx, &UpdateAttachmentRequest{
+		Attachment: &Attachment{Id: id},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"user"}},
+	})
+	require.NoError(t, err)
+	require.False(t, client.Attachment.QueryUser(att).ExistX(ctx))
+}
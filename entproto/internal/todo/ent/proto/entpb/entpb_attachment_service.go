@@ -5,7 +5,9 @@ import (
 	context "context"
 	ent "entgo.io/contrib/entproto/internal/todo/ent"
 	attachment "entgo.io/contrib/entproto/internal/todo/ent/attachment"
+	predicate "entgo.io/contrib/entproto/internal/todo/ent/predicate"
 	user "entgo.io/contrib/entproto/internal/todo/ent/user"
+	runtime "entgo.io/contrib/entproto/runtime"
 	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
 	uuid "github.com/google/uuid"
 	codes "google.golang.org/grpc/codes"
@@ -49,6 +51,47 @@ func toProtoAttachment(e *ent.Attachment) (*Attachment, error) {
 	return v, nil
 }
 
+// toProtoAttachmentEdges sets the edges of v to the messages of the loaded edges of e, with all of their fields.
+func toProtoAttachmentEdges(e *ent.Attachment, v *Attachment) error {
+	v.Recipients = nil
+	for _, edg := range e.Edges.Recipients {
+		item, err := toProtoUser(edg)
+		if err != nil {
+			return err
+		}
+		v.Recipients = append(v.Recipients, item)
+	}
+	if edg := e.Edges.User; edg != nil {
+		item, err := toProtoUser(edg)
+		if err != nil {
+			return err
+		}
+		v.User = item
+	}
+	return nil
+}
+
+// withEdges eager-loads the given edges of the entities returned by the query, or all of them if edges is empty.
+func (svc *AttachmentService) withEdges(query *ent.AttachmentQuery, edges []string) error {
+	all := len(edges) == 0
+	load := make(map[string]bool, len(edges))
+	for _, edge := range edges {
+		switch edge {
+		case "recipients", "user":
+			load[edge] = true
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid argument: unknown edge %q", edge)
+		}
+	}
+	if all || load["recipients"] {
+		query.WithRecipients()
+	}
+	if all || load["user"] {
+		query.WithUser()
+	}
+	return nil
+}
+
 // Create implements AttachmentServiceServer.Create
 func (svc *AttachmentService) Create(ctx context.Context, req *CreateAttachmentRequest) (*Attachment, error) {
 	attachment := req.GetAttachment()
@@ -100,18 +143,28 @@ func (svc *AttachmentService) Get(ctx context.Context, req *GetAttachmentRequest
 				query.Select(user.FieldID)
 			}).
 			Only(ctx)
+	case GetAttachmentRequest_WITH_EDGES:
+		getQuery := svc.client.Attachment.Query().
+			Where(attachment.ID(id))
+		if err := svc.withEdges(getQuery, req.GetEdges()); err != nil {
+			return nil, err
+		}
+		get, err = getQuery.Only(ctx)
 	default:
 		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
 	}
 	switch {
 	case err == nil:
-		return toProtoAttachment(get)
+		protoEntity, err := toProtoAttachment(get)
+		if err == nil && req.GetView() == GetAttachmentRequest_WITH_EDGES {
+			err = toProtoAttachmentEdges(get, protoEntity)
+		}
+		return protoEntity, err
 	case ent.IsNotFound(err):
 		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
 	default:
 		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
 	}
-	return nil, nil
 
 }
 
@@ -123,12 +176,37 @@ func (svc *AttachmentService) Update(ctx context.Context, req *UpdateAttachmentR
 		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
 	}
 	m := svc.client.Attachment.UpdateOneID(attachmentID)
-	for _, item := range attachment.GetRecipients() {
-		recipients := int(item.GetId())
-		m.AddRecipientIDs(recipients)
+	paths := req.GetUpdateMask().GetPaths()
+	masked := len(paths) > 0
+	if !masked {
+		paths = []string{
+			"recipients",
+			"user",
+		}
+	}
+	for _, path := range paths {
+		switch path {
+		case "id":
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: field %q cannot be updated", path)
+		case "recipients":
+			if masked {
+				m.ClearRecipients()
+			}
+			for _, item := range attachment.GetRecipients() {
+				recipients := int(item.GetId())
+				m.AddRecipientIDs(recipients)
+			}
+		case "user":
+			if masked && attachment.GetUser() == nil {
+				m.ClearUser()
+				continue
+			}
+			attachmentUser := int(attachment.GetUser().GetId())
+			m.SetUserID(attachmentUser)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: unknown update_mask path %q", path)
+		}
 	}
-	attachmentUser := int(attachment.GetUser().GetId())
-	m.SetUserID(attachmentUser)
 	res, err := m.Save(ctx)
 	switch {
 	case err == nil:
@@ -165,3 +243,100 @@ func (svc *AttachmentService) Delete(ctx context.Context, req *DeleteAttachmentR
 	}
 
 }
+
+// List implements AttachmentServiceServer.List
+func (svc *AttachmentService) List(ctx context.Context, req *ListAttachmentRequest) (*ListAttachmentResponse, error) {
+	pageSize, err := runtime.PageSize(req.GetPageSize())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	orderBy, err := runtime.ParseOrderBy(req.GetOrderBy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	var (
+		cursor      func(*ent.Attachment) interface{}
+		orderFields = []string{attachment.FieldID}
+	)
+	switch orderBy.Field {
+	case "", "id":
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: unknown order_by field %q", orderBy.Field)
+	}
+	listQuery := svc.client.Attachment.Query()
+	if orderBy.Desc {
+		listQuery = listQuery.Order(ent.Desc(orderFields...))
+	} else {
+		listQuery = listQuery.Order(ent.Asc(orderFields...))
+	}
+	if req.GetPageToken() != "" {
+		last := &ent.Attachment{}
+		var value interface{}
+		if cursor != nil {
+			value = cursor(last)
+		}
+		if err := runtime.DecodePageToken(req.GetPageToken(), orderBy, &last.ID, value); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		listQuery = listQuery.Where(runtime.PageAfter(orderBy, attachment.FieldID, &last.ID, orderFields[0], value))
+	}
+	if items := req.GetFilter().GetId(); len(items) > 0 {
+		predicates := make([]predicate.Attachment, 0, len(items))
+		for _, item := range items {
+			var value uuid.UUID
+			if err := (&value).UnmarshalBinary(item); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			}
+			predicates = append(predicates, attachment.IDEQ(value))
+		}
+		listQuery = listQuery.Where(attachment.Or(predicates...))
+	}
+	switch req.GetView() {
+	case ListAttachmentRequest_VIEW_UNSPECIFIED, ListAttachmentRequest_BASIC:
+	case ListAttachmentRequest_WITH_EDGE_IDS:
+		listQuery = listQuery.WithRecipients(func(query *ent.UserQuery) {
+			query.Select(user.FieldID)
+		})
+		listQuery = listQuery.WithUser(func(query *ent.UserQuery) {
+			query.Select(user.FieldID)
+		})
+	case ListAttachmentRequest_WITH_EDGES:
+		if err := svc.withEdges(listQuery, req.GetEdges()); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	entList, err := listQuery.Limit(pageSize + 1).All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+	var nextPageToken string
+	if len(entList) > pageSize {
+		entList = entList[:pageSize]
+		last := entList[len(entList)-1]
+		var value interface{}
+		if cursor != nil {
+			value = cursor(last)
+		}
+		if nextPageToken, err = runtime.EncodePageToken(orderBy, last.ID, value); err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+	}
+	protoList := make([]*Attachment, 0, len(entList))
+	for _, e := range entList {
+		protoEntity, err := toProtoAttachment(e)
+		if err == nil && req.GetView() == ListAttachmentRequest_WITH_EDGES {
+			err = toProtoAttachmentEdges(e, protoEntity)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		protoList = append(protoList, protoEntity)
+	}
+	return &ListAttachmentResponse{
+		Attachments:   protoList,
+		NextPageToken: nextPageToken,
+	}, nil
+
+}
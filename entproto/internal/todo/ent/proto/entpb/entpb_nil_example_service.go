@@ -5,13 +5,13 @@ import (
 	context "context"
 	ent "entgo.io/contrib/entproto/internal/todo/ent"
 	nilexample "entgo.io/contrib/entproto/internal/todo/ent/nilexample"
+	predicate "entgo.io/contrib/entproto/internal/todo/ent/predicate"
 	runtime "entgo.io/contrib/entproto/runtime"
 	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
-	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // NilExampleService implements NilExampleServiceServer
@@ -32,9 +32,11 @@ func toProtoNilExample(e *ent.NilExample) (*NilExample, error) {
 	v := &NilExample{}
 	id := int32(e.ID)
 	v.Id = id
+	intopt := int32(e.IntOpt)
+	v.IntOpt = &intopt
 	if e.StrNil != nil {
-		strnil := wrapperspb.String(*e.StrNil)
-		v.StrNil = strnil
+		strnil := *e.StrNil
+		v.StrNil = &strnil
 	}
 	if e.TimeNil != nil {
 		timenil := timestamppb.New(*e.TimeNil)
@@ -43,12 +45,29 @@ func toProtoNilExample(e *ent.NilExample) (*NilExample, error) {
 	return v, nil
 }
 
+// toProtoNilExampleEdges sets the edges of v to the messages of the loaded edges of e, with all of their fields.
+func toProtoNilExampleEdges(e *ent.NilExample, v *NilExample) error {
+	return nil
+}
+
+// withEdges eager-loads the given edges of the entities returned by the query, or all of them if edges is empty.
+func (svc *NilExampleService) withEdges(query *ent.NilExampleQuery, edges []string) error {
+	for _, edge := range edges {
+		return status.Errorf(codes.InvalidArgument, "invalid argument: unknown edge %q", edge)
+	}
+	return nil
+}
+
 // Create implements NilExampleServiceServer.Create
 func (svc *NilExampleService) Create(ctx context.Context, req *CreateNilExampleRequest) (*NilExample, error) {
 	nilexample := req.GetNilExample()
 	m := svc.client.NilExample.Create()
-	if nilexample.GetStrNil() != nil {
-		nilexampleStrNil := nilexample.GetStrNil().GetValue()
+	if nilexample.IntOpt != nil {
+		nilexampleIntOpt := int(nilexample.GetIntOpt())
+		m.SetIntOpt(nilexampleIntOpt)
+	}
+	if nilexample.StrNil != nil {
+		nilexampleStrNil := nilexample.GetStrNil()
 		m.SetStrNil(nilexampleStrNil)
 	}
 	if nilexample.GetTimeNil() != nil {
@@ -87,18 +106,28 @@ func (svc *NilExampleService) Get(ctx context.Context, req *GetNilExampleRequest
 		get, err = svc.client.NilExample.Query().
 			Where(nilexample.ID(id)).
 			Only(ctx)
+	case GetNilExampleRequest_WITH_EDGES:
+		getQuery := svc.client.NilExample.Query().
+			Where(nilexample.ID(id))
+		if err := svc.withEdges(getQuery, req.GetEdges()); err != nil {
+			return nil, err
+		}
+		get, err = getQuery.Only(ctx)
 	default:
 		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
 	}
 	switch {
 	case err == nil:
-		return toProtoNilExample(get)
+		protoEntity, err := toProtoNilExample(get)
+		if err == nil && req.GetView() == GetNilExampleRequest_WITH_EDGES {
+			err = toProtoNilExampleEdges(get, protoEntity)
+		}
+		return protoEntity, err
 	case ent.IsNotFound(err):
 		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
 	default:
 		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
 	}
-	return nil, nil
 
 }
 
@@ -107,13 +136,49 @@ func (svc *NilExampleService) Update(ctx context.Context, req *UpdateNilExampleR
 	nilexample := req.GetNilExample()
 	nilexampleID := int(nilexample.GetId())
 	m := svc.client.NilExample.UpdateOneID(nilexampleID)
-	if nilexample.GetStrNil() != nil {
-		nilexampleStrNil := nilexample.GetStrNil().GetValue()
-		m.SetStrNil(nilexampleStrNil)
+	paths := req.GetUpdateMask().GetPaths()
+	masked := len(paths) > 0
+	if !masked {
+		paths = []string{
+			"int_opt",
+			"str_nil",
+			"time_nil",
+		}
 	}
-	if nilexample.GetTimeNil() != nil {
-		nilexampleTimeNil := runtime.ExtractTime(nilexample.GetTimeNil())
-		m.SetTimeNil(nilexampleTimeNil)
+	for _, path := range paths {
+		switch path {
+		case "id":
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: field %q cannot be updated", path)
+		case "int_opt":
+			if nilexample.IntOpt == nil {
+				if masked {
+					m.ClearIntOpt()
+				}
+				continue
+			}
+			nilexampleIntOpt := int(nilexample.GetIntOpt())
+			m.SetIntOpt(nilexampleIntOpt)
+		case "str_nil":
+			if nilexample.StrNil == nil {
+				if masked {
+					m.ClearStrNil()
+				}
+				continue
+			}
+			nilexampleStrNil := nilexample.GetStrNil()
+			m.SetStrNil(nilexampleStrNil)
+		case "time_nil":
+			if nilexample.GetTimeNil() == nil {
+				if masked {
+					m.ClearTimeNil()
+				}
+				continue
+			}
+			nilexampleTimeNil := runtime.ExtractTime(nilexample.GetTimeNil())
+			m.SetTimeNil(nilexampleTimeNil)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: unknown update_mask path %q", path)
+		}
 	}
 	res, err := m.Save(ctx)
 	switch {
@@ -148,3 +213,107 @@ func (svc *NilExampleService) Delete(ctx context.Context, req *DeleteNilExampleR
 	}
 
 }
+
+// List implements NilExampleServiceServer.List
+func (svc *NilExampleService) List(ctx context.Context, req *ListNilExampleRequest) (*ListNilExampleResponse, error) {
+	pageSize, err := runtime.PageSize(req.GetPageSize())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	orderBy, err := runtime.ParseOrderBy(req.GetOrderBy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	var (
+		cursor      func(*ent.NilExample) interface{}
+		orderFields = []string{nilexample.FieldID}
+	)
+	switch orderBy.Field {
+	case "", "id":
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: unknown order_by field %q", orderBy.Field)
+	}
+	listQuery := svc.client.NilExample.Query()
+	if orderBy.Desc {
+		listQuery = listQuery.Order(ent.Desc(orderFields...))
+	} else {
+		listQuery = listQuery.Order(ent.Asc(orderFields...))
+	}
+	if req.GetPageToken() != "" {
+		last := &ent.NilExample{}
+		var value interface{}
+		if cursor != nil {
+			value = cursor(last)
+		}
+		if err := runtime.DecodePageToken(req.GetPageToken(), orderBy, &last.ID, value); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		listQuery = listQuery.Where(runtime.PageAfter(orderBy, nilexample.FieldID, &last.ID, orderFields[0], value))
+	}
+	if items := req.GetFilter().GetId(); len(items) > 0 {
+		predicates := make([]predicate.NilExample, 0, len(items))
+		for _, item := range items {
+			value := int(item)
+			predicates = append(predicates, nilexample.IDEQ(value))
+		}
+		listQuery = listQuery.Where(nilexample.Or(predicates...))
+	}
+	if items := req.GetFilter().GetIntOpt(); len(items) > 0 {
+		predicates := make([]predicate.NilExample, 0, len(items))
+		for _, item := range items {
+			value := int(item)
+			predicates = append(predicates, nilexample.IntOptEQ(value))
+		}
+		listQuery = listQuery.Where(nilexample.Or(predicates...))
+	}
+	if items := req.GetFilter().GetStrNil(); len(items) > 0 {
+		predicates := make([]predicate.NilExample, 0, len(items))
+		for _, item := range items {
+			value := item
+			predicates = append(predicates, nilexample.StrNilEQ(value))
+		}
+		listQuery = listQuery.Where(nilexample.Or(predicates...))
+	}
+	switch req.GetView() {
+	case ListNilExampleRequest_VIEW_UNSPECIFIED, ListNilExampleRequest_BASIC:
+	case ListNilExampleRequest_WITH_EDGE_IDS:
+	case ListNilExampleRequest_WITH_EDGES:
+		if err := svc.withEdges(listQuery, req.GetEdges()); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	entList, err := listQuery.Limit(pageSize + 1).All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+	var nextPageToken string
+	if len(entList) > pageSize {
+		entList = entList[:pageSize]
+		last := entList[len(entList)-1]
+		var value interface{}
+		if cursor != nil {
+			value = cursor(last)
+		}
+		if nextPageToken, err = runtime.EncodePageToken(orderBy, last.ID, value); err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+	}
+	protoList := make([]*NilExample, 0, len(entList))
+	for _, e := range entList {
+		protoEntity, err := toProtoNilExample(e)
+		if err == nil && req.GetView() == ListNilExampleRequest_WITH_EDGES {
+			err = toProtoNilExampleEdges(e, protoEntity)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		protoList = append(protoList, protoEntity)
+	}
+	return &ListNilExampleResponse{
+		NilExamples:   protoList,
+		NextPageToken: nextPageToken,
+	}, nil
+
+}
@@ -0,0 +1,339 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpb
+
+import (
+	context "context"
+	ent "entgo.io/contrib/entproto/internal/todo/ent"
+	predicate "entgo.io/contrib/entproto/internal/todo/ent/predicate"
+	todo "entgo.io/contrib/entproto/internal/todo/ent/todo"
+	user "entgo.io/contrib/entproto/internal/todo/ent/user"
+	runtime "entgo.io/contrib/entproto/runtime"
+	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	strings "strings"
+)
+
+// TodoService implements TodoServiceServer
+type TodoService struct {
+	client *ent.Client
+	UnimplementedTodoServiceServer
+}
+
+// NewTodoService returns a new TodoService
+func NewTodoService(client *ent.Client) *TodoService {
+	return &TodoService{
+		client: client,
+	}
+}
+
+func toProtoTodo_Status(e todo.Status) Todo_Status {
+	if v, ok := Todo_Status_value[strings.ToUpper(string(e))]; ok {
+		return Todo_Status(v)
+	}
+	return Todo_Status(0)
+}
+
+func toEntTodo_Status(e Todo_Status) todo.Status {
+	if v, ok := Todo_Status_name[int32(e)]; ok {
+		return todo.Status(strings.ToLower(v))
+	}
+	return ""
+}
+
+// toProtoTodo transforms the ent type to the pb type
+func toProtoTodo(e *ent.Todo) (*Todo, error) {
+	v := &Todo{}
+	id := int32(e.ID)
+	v.Id = id
+	status := toProtoTodo_Status(e.Status)
+	v.Status = status
+	task := e.Task
+	v.Task = task
+	if edg := e.Edges.User; edg != nil {
+		id := int32(edg.ID)
+		v.User = &User{
+			Id: id,
+		}
+	}
+	return v, nil
+}
+
+// toProtoTodoEdges sets the edges of v to the messages of the loaded edges of e, with all of their fields.
+func toProtoTodoEdges(e *ent.Todo, v *Todo) error {
+	if edg := e.Edges.User; edg != nil {
+		item, err := toProtoUser(edg)
+		if err != nil {
+			return err
+		}
+		v.User = item
+	}
+	return nil
+}
+
+// withEdges eager-loads the given edges of the entities returned by the query, or all of them if edges is empty.
+func (svc *TodoService) withEdges(query *ent.TodoQuery, edges []string) error {
+	all := len(edges) == 0
+	load := make(map[string]bool, len(edges))
+	for _, edge := range edges {
+		switch edge {
+		case "user":
+			load[edge] = true
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid argument: unknown edge %q", edge)
+		}
+	}
+	if all || load["user"] {
+		query.WithUser()
+	}
+	return nil
+}
+
+// Create implements TodoServiceServer.Create
+func (svc *TodoService) Create(ctx context.Context, req *CreateTodoRequest) (*Todo, error) {
+	todo := req.GetTodo()
+	m := svc.client.Todo.Create()
+	todoStatus := toEntTodo_Status(todo.GetStatus())
+	m.SetStatus(todoStatus)
+	todoTask := todo.GetTask()
+	m.SetTask(todoTask)
+	todoUser := int(todo.GetUser().GetId())
+	m.SetUserID(todoUser)
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTodo(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Get implements TodoServiceServer.Get
+func (svc *TodoService) Get(ctx context.Context, req *GetTodoRequest) (*Todo, error) {
+	var (
+		err error
+		get *ent.Todo
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetTodoRequest_VIEW_UNSPECIFIED, GetTodoRequest_BASIC:
+		get, err = svc.client.Todo.Get(ctx, id)
+	case GetTodoRequest_WITH_EDGE_IDS:
+		get, err = svc.client.Todo.Query().
+			Where(todo.ID(id)).
+			WithUser(func(query *ent.UserQuery) {
+				query.Select(user.FieldID)
+			}).
+			Only(ctx)
+	case GetTodoRequest_WITH_EDGES:
+		getQuery := svc.client.Todo.Query().
+			Where(todo.ID(id))
+		if err := svc.withEdges(getQuery, req.GetEdges()); err != nil {
+			return nil, err
+		}
+		get, err = getQuery.Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		protoEntity, err := toProtoTodo(get)
+		if err == nil && req.GetView() == GetTodoRequest_WITH_EDGES {
+			err = toProtoTodoEdges(get, protoEntity)
+		}
+		return protoEntity, err
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements TodoServiceServer.Update
+func (svc *TodoService) Update(ctx context.Context, req *UpdateTodoRequest) (*Todo, error) {
+	todo := req.GetTodo()
+	todoID := int(todo.GetId())
+	m := svc.client.Todo.UpdateOneID(todoID)
+	paths := req.GetUpdateMask().GetPaths()
+	masked := len(paths) > 0
+	if !masked {
+		paths = []string{
+			"status",
+			"task",
+			"user",
+		}
+	}
+	for _, path := range paths {
+		switch path {
+		case "id":
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: field %q cannot be updated", path)
+		case "status":
+			todoStatus := toEntTodo_Status(todo.GetStatus())
+			m.SetStatus(todoStatus)
+		case "task":
+			todoTask := todo.GetTask()
+			m.SetTask(todoTask)
+		case "user":
+			if masked && todo.GetUser() == nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid argument: field %q cannot be cleared", path)
+			}
+			todoUser := int(todo.GetUser().GetId())
+			m.SetUserID(todoUser)
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: unknown update_mask path %q", path)
+		}
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoTodo(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Delete implements TodoServiceServer.Delete
+func (svc *TodoService) Delete(ctx context.Context, req *DeleteTodoRequest) (*emptypb.Empty, error) {
+	var err error
+	id := int(req.GetId())
+	err = svc.client.Todo.DeleteOneID(id).Exec(ctx)
+	switch {
+	case err == nil:
+		return &emptypb.Empty{}, nil
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements TodoServiceServer.List
+func (svc *TodoService) List(ctx context.Context, req *ListTodoRequest) (*ListTodoResponse, error) {
+	pageSize, err := runtime.PageSize(req.GetPageSize())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	orderBy, err := runtime.ParseOrderBy(req.GetOrderBy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	var (
+		cursor      func(*ent.Todo) interface{}
+		orderFields = []string{todo.FieldID}
+	)
+	switch orderBy.Field {
+	case "", "id":
+	case "task":
+		cursor = func(e *ent.Todo) interface{} { return &e.Task }
+		orderFields = append([]string{todo.FieldTask}, orderFields...)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: unknown order_by field %q", orderBy.Field)
+	}
+	listQuery := svc.client.Todo.Query()
+	if orderBy.Desc {
+		listQuery = listQuery.Order(ent.Desc(orderFields...))
+	} else {
+		listQuery = listQuery.Order(ent.Asc(orderFields...))
+	}
+	if req.GetPageToken() != "" {
+		last := &ent.Todo{}
+		var value interface{}
+		if cursor != nil {
+			value = cursor(last)
+		}
+		if err := runtime.DecodePageToken(req.GetPageToken(), orderBy, &last.ID, value); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		listQuery = listQuery.Where(runtime.PageAfter(orderBy, todo.FieldID, &last.ID, orderFields[0], value))
+	}
+	if items := req.GetFilter().GetId(); len(items) > 0 {
+		predicates := make([]predicate.Todo, 0, len(items))
+		for _, item := range items {
+			value := int(item)
+			predicates = append(predicates, todo.IDEQ(value))
+		}
+		listQuery = listQuery.Where(todo.Or(predicates...))
+	}
+	if items := req.GetFilter().GetStatus(); len(items) > 0 {
+		predicates := make([]predicate.Todo, 0, len(items))
+		for _, item := range items {
+			value := toEntTodo_Status(item)
+			predicates = append(predicates, todo.StatusEQ(value))
+		}
+		listQuery = listQuery.Where(todo.Or(predicates...))
+	}
+	if items := req.GetFilter().GetTask(); len(items) > 0 {
+		predicates := make([]predicate.Todo, 0, len(items))
+		for _, item := range items {
+			value := item
+			predicates = append(predicates, todo.TaskEQ(value))
+		}
+		listQuery = listQuery.Where(todo.Or(predicates...))
+	}
+	switch req.GetView() {
+	case ListTodoRequest_VIEW_UNSPECIFIED, ListTodoRequest_BASIC:
+	case ListTodoRequest_WITH_EDGE_IDS:
+		listQuery = listQuery.WithUser(func(query *ent.UserQuery) {
+			query.Select(user.FieldID)
+		})
+	case ListTodoRequest_WITH_EDGES:
+		if err := svc.withEdges(listQuery, req.GetEdges()); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	entList, err := listQuery.Limit(pageSize + 1).All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+	var nextPageToken string
+	if len(entList) > pageSize {
+		entList = entList[:pageSize]
+		last := entList[len(entList)-1]
+		var value interface{}
+		if cursor != nil {
+			value = cursor(last)
+		}
+		if nextPageToken, err = runtime.EncodePageToken(orderBy, last.ID, value); err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+	}
+	protoList := make([]*Todo, 0, len(entList))
+	for _, e := range entList {
+		protoEntity, err := toProtoTodo(e)
+		if err == nil && req.GetView() == ListTodoRequest_WITH_EDGES {
+			err = toProtoTodoEdges(e, protoEntity)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		protoList = append(protoList, protoEntity)
+	}
+	return &ListTodoResponse{
+		Todos:         protoList,
+		NextPageToken: nextPageToken,
+	}, nil
+
+}
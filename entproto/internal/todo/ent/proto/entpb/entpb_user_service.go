@@ -6,6 +6,8 @@ import (
 	ent "entgo.io/contrib/entproto/internal/todo/ent"
 	attachment "entgo.io/contrib/entproto/internal/todo/ent/attachment"
 	group "entgo.io/contrib/entproto/internal/todo/ent/group"
+	predicate "entgo.io/contrib/entproto/internal/todo/ent/predicate"
+	geo "entgo.io/contrib/entproto/internal/todo/ent/proto/geo"
 	schema "entgo.io/contrib/entproto/internal/todo/ent/schema"
 	user "entgo.io/contrib/entproto/internal/todo/ent/user"
 	runtime "entgo.io/contrib/entproto/runtime"
@@ -14,9 +16,13 @@ import (
 	uuid "github.com/google/uuid"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	proto "google.golang.org/protobuf/proto"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	http "net/http"
 	strings "strings"
 )
 
@@ -52,6 +58,11 @@ func toProtoUser(e *ent.User) (*User, error) {
 	v := &User{}
 	accountbalance := e.AccountBalance
 	v.AccountBalance = accountbalance
+	address := &geo.Address{}
+	if err := runtime.ToProtoJSON(e.Address, address); err != nil {
+		return nil, err
+	}
+	v.Address = address
 	buser1 := wrapperspb.Int32(int32(e.BUser1))
 	v.BUser_1 = buser1
 	banned := e.Banned
@@ -83,6 +94,13 @@ func toProtoUser(e *ent.User) (*User, error) {
 	v.Id = id
 	joined := timestamppb.New(e.Joined)
 	v.Joined = joined
+	labels := e.Labels
+	v.Labels = labels
+	metadata := &structpb.Struct{}
+	if err := runtime.ToProtoJSON(e.Metadata, metadata); err != nil {
+		return nil, err
+	}
+	v.Metadata = metadata
 	optbool := wrapperspb.Bool(e.OptBool)
 	v.OptBool = optbool
 	optnum := wrapperspb.Int32(int32(e.OptNum))
@@ -91,6 +109,20 @@ func toProtoUser(e *ent.User) (*User, error) {
 	v.OptStr = optstr
 	points := uint32(e.Points)
 	v.Points = points
+	preferences := &structpb.Value{}
+	if err := runtime.ToProtoJSON(e.Preferences, preferences); err != nil {
+		return nil, err
+	}
+	v.Preferences = preferences
+	price := schema.PriceToProto(e.Price)
+	v.Price = price
+	var scores []int32
+	for _, elem := range e.Scores {
+		scores = append(scores, int32(elem))
+	}
+	v.Scores = scores
+	sessiontimeout := durationpb.New(e.SessionTimeout)
+	v.SessionTimeout = sessiontimeout
 	status := toProtoUser_Status(e.Status)
 	v.Status = status
 	username := e.UserName
@@ -122,12 +154,69 @@ func toProtoUser(e *ent.User) (*User, error) {
 	return v, nil
 }
 
-// Create implements UserServiceServer.Create
-func (svc *UserService) Create(ctx context.Context, req *CreateUserRequest) (*User, error) {
-	user := req.GetUser()
-	m := svc.client.User.Create()
+// toProtoUserEdges sets the edges of v to the messages of the loaded edges of e, with all of their fields.
+func toProtoUserEdges(e *ent.User, v *User) error {
+	if edg := e.Edges.Attachment; edg != nil {
+		item, err := toProtoAttachment(edg)
+		if err != nil {
+			return err
+		}
+		v.Attachment = item
+	}
+	if edg := e.Edges.Group; edg != nil {
+		item, err := toProtoGroup(edg)
+		if err != nil {
+			return err
+		}
+		v.Group = item
+	}
+	v.Received_1 = nil
+	for _, edg := range e.Edges.Received1 {
+		item, err := toProtoAttachment(edg)
+		if err != nil {
+			return err
+		}
+		v.Received_1 = append(v.Received_1, item)
+	}
+	return nil
+}
+
+// withEdges eager-loads the given edges of the entities returned by the query, or all of them if edges is empty.
+func (svc *UserService) withEdges(query *ent.UserQuery, edges []string) error {
+	all := len(edges) == 0
+	load := make(map[string]bool, len(edges))
+	for _, edge := range edges {
+		switch edge {
+		case "attachment", "group", "received_1":
+			load[edge] = true
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid argument: unknown edge %q", edge)
+		}
+	}
+	if all || load["attachment"] {
+		query.WithAttachment()
+	}
+	if all || load["group"] {
+		query.WithGroup()
+	}
+	if all || load["received_1"] {
+		query.WithReceived1()
+	}
+	return nil
+}
+
+// createBuilder returns a builder for creating the User on the given client.
+func (svc *UserService) createBuilder(client *ent.UserClient, user *User) (*ent.UserCreate, error) {
+	m := client.Create()
 	userAccountBalance := float64(user.GetAccountBalance())
 	m.SetAccountBalance(userAccountBalance)
+	if user.GetAddress() != nil {
+		var userAddress *schema.Address
+		if err := runtime.FromProtoJSON(user.GetAddress(), &userAddress); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		m.SetAddress(userAddress)
+	}
 	if user.GetBUser_1() != nil {
 		userBUser1 := int(user.GetBUser_1().GetValue())
 		m.SetBUser1(userBUser1)
@@ -156,6 +245,17 @@ func (svc *UserService) Create(ctx context.Context, req *CreateUserRequest) (*Us
 	m.SetHeightInCm(userHeightInCm)
 	userJoined := runtime.ExtractTime(user.GetJoined())
 	m.SetJoined(userJoined)
+	if user.GetLabels() != nil {
+		userLabels := user.GetLabels()
+		m.SetLabels(userLabels)
+	}
+	if user.GetMetadata() != nil {
+		var userMetadata map[string]interface{}
+		if err := runtime.FromProtoJSON(user.GetMetadata(), &userMetadata); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		m.SetMetadata(userMetadata)
+	}
 	if user.GetOptBool() != nil {
 		userOptBool := user.GetOptBool().GetValue()
 		m.SetOptBool(userOptBool)
@@ -170,6 +270,29 @@ func (svc *UserService) Create(ctx context.Context, req *CreateUserRequest) (*Us
 	}
 	userPoints := uint(user.GetPoints())
 	m.SetPoints(userPoints)
+	if user.GetPreferences() != nil {
+		var userPreferences schema.Preferences
+		if err := runtime.FromProtoJSON(user.GetPreferences(), &userPreferences); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		m.SetPreferences(userPreferences)
+	}
+	userPrice, err := schema.PriceFromProto(user.GetPrice())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	m.SetPrice(userPrice)
+	if user.GetScores() != nil {
+		var userScores []int
+		for _, elem := range user.GetScores() {
+			userScores = append(userScores, int(elem))
+		}
+		m.SetScores(userScores)
+	}
+	if user.GetSessionTimeout() != nil {
+		userSessionTimeout := schema.DurationFromProto(user.GetSessionTimeout())
+		m.SetSessionTimeout(userSessionTimeout)
+	}
 	userStatus := toEntUser_Status(user.GetStatus())
 	m.SetStatus(userStatus)
 	userUserName := user.GetUserName()
@@ -188,69 +311,39 @@ func (svc *UserService) Create(ctx context.Context, req *CreateUserRequest) (*Us
 		}
 		m.AddReceived1IDs(received1)
 	}
-	res, err := m.Save(ctx)
-	switch {
-	case err == nil:
-		proto, err := toProtoUser(res)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
-		}
-		return proto, nil
-	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
-	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
-	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
-	}
-
+	return m, nil
 }
 
-// Get implements UserServiceServer.Get
-func (svc *UserService) Get(ctx context.Context, req *GetUserRequest) (*User, error) {
-	var (
-		err error
-		get *ent.User
-	)
-	id := int(req.GetId())
-	switch req.GetView() {
-	case GetUserRequest_VIEW_UNSPECIFIED, GetUserRequest_BASIC:
-		get, err = svc.client.User.Get(ctx, id)
-	case GetUserRequest_WITH_EDGE_IDS:
-		get, err = svc.client.User.Query().
-			Where(user.ID(id)).
-			WithAttachment(func(query *ent.AttachmentQuery) {
-				query.Select(attachment.FieldID)
-			}).
-			WithGroup(func(query *ent.GroupQuery) {
-				query.Select(group.FieldID)
-			}).
-			WithReceived1(func(query *ent.AttachmentQuery) {
-				query.Select(attachment.FieldID)
-			}).
-			Only(ctx)
-	default:
-		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+// batchStatus returns the gRPC status of an item of a batch request that failed with err.
+func (svc *UserService) batchStatus(err error) *status.Status {
+	if s, ok := status.FromError(err); ok {
+		return s
 	}
 	switch {
-	case err == nil:
-		return toProtoUser(get)
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return status.Newf(codes.NotFound, "not found: %s", err)
+	case sqlgraph.IsUniqueConstraintError(err):
+		return status.Newf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return status.Newf(codes.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return status.Newf(codes.Internal, "internal error: %s", err)
 	}
-	return nil, nil
-
 }
 
-// Update implements UserServiceServer.Update
-func (svc *UserService) Update(ctx context.Context, req *UpdateUserRequest) (*User, error) {
+// Create implements UserServiceServer.Create
+func (svc *UserService) Create(ctx context.Context, req *CreateUserRequest) (*User, error) {
 	user := req.GetUser()
-	userID := int(user.GetId())
-	m := svc.client.User.UpdateOneID(userID)
+	m := svc.client.User.Create()
 	userAccountBalance := float64(user.GetAccountBalance())
 	m.SetAccountBalance(userAccountBalance)
+	if user.GetAddress() != nil {
+		var userAddress *schema.Address
+		if err := runtime.FromProtoJSON(user.GetAddress(), &userAddress); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		m.SetAddress(userAddress)
+	}
 	if user.GetBUser_1() != nil {
 		userBUser1 := int(user.GetBUser_1().GetValue())
 		m.SetBUser1(userBUser1)
@@ -277,6 +370,19 @@ func (svc *UserService) Update(ctx context.Context, req *UpdateUserRequest) (*Us
 	m.SetExternalID(userExternalID)
 	userHeightInCm := float32(user.GetHeightInCm())
 	m.SetHeightInCm(userHeightInCm)
+	userJoined := runtime.ExtractTime(user.GetJoined())
+	m.SetJoined(userJoined)
+	if user.GetLabels() != nil {
+		userLabels := user.GetLabels()
+		m.SetLabels(userLabels)
+	}
+	if user.GetMetadata() != nil {
+		var userMetadata map[string]interface{}
+		if err := runtime.FromProtoJSON(user.GetMetadata(), &userMetadata); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		m.SetMetadata(userMetadata)
+	}
 	if user.GetOptBool() != nil {
 		userOptBool := user.GetOptBool().GetValue()
 		m.SetOptBool(userOptBool)
@@ -291,6 +397,29 @@ func (svc *UserService) Update(ctx context.Context, req *UpdateUserRequest) (*Us
 	}
 	userPoints := uint(user.GetPoints())
 	m.SetPoints(userPoints)
+	if user.GetPreferences() != nil {
+		var userPreferences schema.Preferences
+		if err := runtime.FromProtoJSON(user.GetPreferences(), &userPreferences); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		m.SetPreferences(userPreferences)
+	}
+	userPrice, err := schema.PriceFromProto(user.GetPrice())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	m.SetPrice(userPrice)
+	if user.GetScores() != nil {
+		var userScores []int
+		for _, elem := range user.GetScores() {
+			userScores = append(userScores, int(elem))
+		}
+		m.SetScores(userScores)
+	}
+	if user.GetSessionTimeout() != nil {
+		userSessionTimeout := schema.DurationFromProto(user.GetSessionTimeout())
+		m.SetSessionTimeout(userSessionTimeout)
+	}
 	userStatus := toEntUser_Status(user.GetStatus())
 	m.SetStatus(userStatus)
 	userUserName := user.GetUserName()
@@ -327,6 +456,299 @@ func (svc *UserService) Update(ctx context.Context, req *UpdateUserRequest) (*Us
 
 }
 
+// Get implements UserServiceServer.Get
+func (svc *UserService) Get(ctx context.Context, req *GetUserRequest) (*User, error) {
+	var (
+		err error
+		get *ent.User
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetUserRequest_VIEW_UNSPECIFIED, GetUserRequest_BASIC:
+		get, err = svc.client.User.Get(ctx, id)
+	case GetUserRequest_WITH_EDGE_IDS:
+		get, err = svc.client.User.Query().
+			Where(user.ID(id)).
+			WithAttachment(func(query *ent.AttachmentQuery) {
+				query.Select(attachment.FieldID)
+			}).
+			WithGroup(func(query *ent.GroupQuery) {
+				query.Select(group.FieldID)
+			}).
+			WithReceived1(func(query *ent.AttachmentQuery) {
+				query.Select(attachment.FieldID)
+			}).
+			Only(ctx)
+	case GetUserRequest_WITH_EDGES:
+		getQuery := svc.client.User.Query().
+			Where(user.ID(id))
+		if err := svc.withEdges(getQuery, req.GetEdges()); err != nil {
+			return nil, err
+		}
+		get, err = getQuery.Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		protoEntity, err := toProtoUser(get)
+		if err == nil && req.GetView() == GetUserRequest_WITH_EDGES {
+			err = toProtoUserEdges(get, protoEntity)
+		}
+		return protoEntity, err
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// Update implements UserServiceServer.Update
+func (svc *UserService) Update(ctx context.Context, req *UpdateUserRequest) (*User, error) {
+	user := req.GetUser()
+	userID := int(user.GetId())
+	m := svc.client.User.UpdateOneID(userID)
+	paths := req.GetUpdateMask().GetPaths()
+	masked := len(paths) > 0
+	if !masked {
+		paths = []string{
+			"account_balance",
+			"address",
+			"b_user_1",
+			"banned",
+			"big_int",
+			"crm_id",
+			"custom_pb",
+			"exp",
+			"external_id",
+			"height_in_cm",
+			"labels",
+			"metadata",
+			"opt_bool",
+			"opt_num",
+			"opt_str",
+			"points",
+			"preferences",
+			"price",
+			"scores",
+			"session_timeout",
+			"status",
+			"user_name",
+			"attachment",
+			"group",
+			"received_1",
+		}
+	}
+	for _, path := range paths {
+		switch path {
+		case "account_balance":
+			userAccountBalance := float64(user.GetAccountBalance())
+			m.SetAccountBalance(userAccountBalance)
+		case "address":
+			if user.GetAddress() == nil {
+				if masked {
+					m.ClearAddress()
+				}
+				continue
+			}
+			var userAddress *schema.Address
+			if err := runtime.FromProtoJSON(user.GetAddress(), &userAddress); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			}
+			m.SetAddress(userAddress)
+		case "b_user_1":
+			if user.GetBUser_1() == nil {
+				if masked {
+					m.ClearBUser1()
+				}
+				continue
+			}
+			userBUser1 := int(user.GetBUser_1().GetValue())
+			m.SetBUser1(userBUser1)
+		case "banned":
+			userBanned := user.GetBanned()
+			m.SetBanned(userBanned)
+		case "big_int":
+			if user.GetBigInt() == nil {
+				if masked {
+					m.ClearBigInt()
+				}
+				continue
+			}
+			userBigInt := schema.BigInt{}
+			if err := (&userBigInt).Scan(user.GetBigInt().GetValue()); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			}
+			m.SetBigInt(userBigInt)
+		case "crm_id":
+			var userCrmID uuid.UUID
+			if err := (&userCrmID).UnmarshalBinary(user.GetCrmId()); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			}
+			m.SetCrmID(userCrmID)
+		case "custom_pb":
+			userCustomPb := uint8(user.GetCustomPb())
+			m.SetCustomPb(userCustomPb)
+		case "exp":
+			userExp := uint64(user.GetExp())
+			m.SetExp(userExp)
+		case "external_id":
+			userExternalID := int(user.GetExternalId())
+			m.SetExternalID(userExternalID)
+		case "height_in_cm":
+			userHeightInCm := float32(user.GetHeightInCm())
+			m.SetHeightInCm(userHeightInCm)
+		case "id":
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: field %q cannot be updated", path)
+		case "joined":
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: field %q cannot be updated", path)
+		case "labels":
+			if user.GetLabels() == nil {
+				if masked {
+					m.ClearLabels()
+				}
+				continue
+			}
+			userLabels := user.GetLabels()
+			m.SetLabels(userLabels)
+		case "metadata":
+			if user.GetMetadata() == nil {
+				if masked {
+					m.ClearMetadata()
+				}
+				continue
+			}
+			var userMetadata map[string]interface{}
+			if err := runtime.FromProtoJSON(user.GetMetadata(), &userMetadata); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			}
+			m.SetMetadata(userMetadata)
+		case "opt_bool":
+			if user.GetOptBool() == nil {
+				if masked {
+					m.ClearOptBool()
+				}
+				continue
+			}
+			userOptBool := user.GetOptBool().GetValue()
+			m.SetOptBool(userOptBool)
+		case "opt_num":
+			if user.GetOptNum() == nil {
+				if masked {
+					m.ClearOptNum()
+				}
+				continue
+			}
+			userOptNum := int(user.GetOptNum().GetValue())
+			m.SetOptNum(userOptNum)
+		case "opt_str":
+			if user.GetOptStr() == nil {
+				if masked {
+					m.ClearOptStr()
+				}
+				continue
+			}
+			userOptStr := user.GetOptStr().GetValue()
+			m.SetOptStr(userOptStr)
+		case "points":
+			userPoints := uint(user.GetPoints())
+			m.SetPoints(userPoints)
+		case "preferences":
+			if user.GetPreferences() == nil {
+				if masked {
+					m.ClearPreferences()
+				}
+				continue
+			}
+			var userPreferences schema.Preferences
+			if err := runtime.FromProtoJSON(user.GetPreferences(), &userPreferences); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			}
+			m.SetPreferences(userPreferences)
+		case "price":
+			userPrice, err := schema.PriceFromProto(user.GetPrice())
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			}
+			m.SetPrice(userPrice)
+		case "scores":
+			if user.GetScores() == nil {
+				if masked {
+					m.ClearScores()
+				}
+				continue
+			}
+			var userScores []int
+			for _, elem := range user.GetScores() {
+				userScores = append(userScores, int(elem))
+			}
+			m.SetScores(userScores)
+		case "session_timeout":
+			if user.GetSessionTimeout() == nil {
+				if masked {
+					m.ClearSessionTimeout()
+				}
+				continue
+			}
+			userSessionTimeout := schema.DurationFromProto(user.GetSessionTimeout())
+			m.SetSessionTimeout(userSessionTimeout)
+		case "status":
+			userStatus := toEntUser_Status(user.GetStatus())
+			m.SetStatus(userStatus)
+		case "user_name":
+			userUserName := user.GetUserName()
+			m.SetUserName(userUserName)
+		case "attachment":
+			if masked && user.GetAttachment() == nil {
+				m.ClearAttachment()
+				continue
+			}
+			var userAttachment uuid.UUID
+			if err := (&userAttachment).UnmarshalBinary(user.GetAttachment().GetId()); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			}
+			m.SetAttachmentID(userAttachment)
+		case "group":
+			if masked && user.GetGroup() == nil {
+				m.ClearGroup()
+				continue
+			}
+			userGroup := int(user.GetGroup().GetId())
+			m.SetGroupID(userGroup)
+		case "received_1":
+			if masked {
+				m.ClearReceived1()
+			}
+			for _, item := range user.GetReceived_1() {
+				var received1 uuid.UUID
+				if err := (&received1).UnmarshalBinary(item.GetId()); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+				}
+				m.AddReceived1IDs(received1)
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: unknown update_mask path %q", path)
+		}
+	}
+	res, err := m.Save(ctx)
+	switch {
+	case err == nil:
+		proto, err := toProtoUser(res)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		return proto, nil
+	case sqlgraph.IsUniqueConstraintError(err):
+		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+	case ent.IsConstraintError(err):
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
 // Delete implements UserServiceServer.Delete
 func (svc *UserService) Delete(ctx context.Context, req *DeleteUserRequest) (*emptypb.Empty, error) {
 	var err error
@@ -342,3 +764,460 @@ func (svc *UserService) Delete(ctx context.Context, req *DeleteUserRequest) (*em
 	}
 
 }
+
+// List implements UserServiceServer.List
+func (svc *UserService) List(ctx context.Context, req *ListUserRequest) (*ListUserResponse, error) {
+	pageSize, err := runtime.PageSize(req.GetPageSize())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	orderBy, err := runtime.ParseOrderBy(req.GetOrderBy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	var (
+		cursor      func(*ent.User) interface{}
+		orderFields = []string{user.FieldID}
+	)
+	switch orderBy.Field {
+	case "", "id":
+	case "account_balance":
+		cursor = func(e *ent.User) interface{} { return &e.AccountBalance }
+		orderFields = append([]string{user.FieldAccountBalance}, orderFields...)
+	case "custom_pb":
+		cursor = func(e *ent.User) interface{} { return &e.CustomPb }
+		orderFields = append([]string{user.FieldCustomPb}, orderFields...)
+	case "exp":
+		cursor = func(e *ent.User) interface{} { return &e.Exp }
+		orderFields = append([]string{user.FieldExp}, orderFields...)
+	case "external_id":
+		cursor = func(e *ent.User) interface{} { return &e.ExternalID }
+		orderFields = append([]string{user.FieldExternalID}, orderFields...)
+	case "height_in_cm":
+		cursor = func(e *ent.User) interface{} { return &e.HeightInCm }
+		orderFields = append([]string{user.FieldHeightInCm}, orderFields...)
+	case "joined":
+		cursor = func(e *ent.User) interface{} { return &e.Joined }
+		orderFields = append([]string{user.FieldJoined}, orderFields...)
+	case "points":
+		cursor = func(e *ent.User) interface{} { return &e.Points }
+		orderFields = append([]string{user.FieldPoints}, orderFields...)
+	case "user_name":
+		cursor = func(e *ent.User) interface{} { return &e.UserName }
+		orderFields = append([]string{user.FieldUserName}, orderFields...)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: unknown order_by field %q", orderBy.Field)
+	}
+	listQuery := svc.client.User.Query()
+	if orderBy.Desc {
+		listQuery = listQuery.Order(ent.Desc(orderFields...))
+	} else {
+		listQuery = listQuery.Order(ent.Asc(orderFields...))
+	}
+	if req.GetPageToken() != "" {
+		last := &ent.User{}
+		var value interface{}
+		if cursor != nil {
+			value = cursor(last)
+		}
+		if err := runtime.DecodePageToken(req.GetPageToken(), orderBy, &last.ID, value); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		listQuery = listQuery.Where(runtime.PageAfter(orderBy, user.FieldID, &last.ID, orderFields[0], value))
+	}
+	if items := req.GetFilter().GetBUser_1(); len(items) > 0 {
+		predicates := make([]predicate.User, 0, len(items))
+		for _, item := range items {
+			value := int(item)
+			predicates = append(predicates, user.BUser1EQ(value))
+		}
+		listQuery = listQuery.Where(user.Or(predicates...))
+	}
+	if items := req.GetFilter().GetBanned(); len(items) > 0 {
+		predicates := make([]predicate.User, 0, len(items))
+		for _, item := range items {
+			value := item
+			predicates = append(predicates, user.BannedEQ(value))
+		}
+		listQuery = listQuery.Where(user.Or(predicates...))
+	}
+	if items := req.GetFilter().GetCrmId(); len(items) > 0 {
+		predicates := make([]predicate.User, 0, len(items))
+		for _, item := range items {
+			var value uuid.UUID
+			if err := (&value).UnmarshalBinary(item); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			}
+			predicates = append(predicates, user.CrmIDEQ(value))
+		}
+		listQuery = listQuery.Where(user.Or(predicates...))
+	}
+	if items := req.GetFilter().GetExp(); len(items) > 0 {
+		predicates := make([]predicate.User, 0, len(items))
+		for _, item := range items {
+			value := uint64(item)
+			predicates = append(predicates, user.ExpEQ(value))
+		}
+		listQuery = listQuery.Where(user.Or(predicates...))
+	}
+	if items := req.GetFilter().GetExternalId(); len(items) > 0 {
+		predicates := make([]predicate.User, 0, len(items))
+		for _, item := range items {
+			value := int(item)
+			predicates = append(predicates, user.ExternalIDEQ(value))
+		}
+		listQuery = listQuery.Where(user.Or(predicates...))
+	}
+	if items := req.GetFilter().GetId(); len(items) > 0 {
+		predicates := make([]predicate.User, 0, len(items))
+		for _, item := range items {
+			value := int(item)
+			predicates = append(predicates, user.IDEQ(value))
+		}
+		listQuery = listQuery.Where(user.Or(predicates...))
+	}
+	if items := req.GetFilter().GetOptBool(); len(items) > 0 {
+		predicates := make([]predicate.User, 0, len(items))
+		for _, item := range items {
+			value := item
+			predicates = append(predicates, user.OptBoolEQ(value))
+		}
+		listQuery = listQuery.Where(user.Or(predicates...))
+	}
+	if items := req.GetFilter().GetOptNum(); len(items) > 0 {
+		predicates := make([]predicate.User, 0, len(items))
+		for _, item := range items {
+			value := int(item)
+			predicates = append(predicates, user.OptNumEQ(value))
+		}
+		listQuery = listQuery.Where(user.Or(predicates...))
+	}
+	if items := req.GetFilter().GetOptStr(); len(items) > 0 {
+		predicates := make([]predicate.User, 0, len(items))
+		for _, item := range items {
+			value := item
+			predicates = append(predicates, user.OptStrEQ(value))
+		}
+		listQuery = listQuery.Where(user.Or(predicates...))
+	}
+	if items := req.GetFilter().GetPoints(); len(items) > 0 {
+		predicates := make([]predicate.User, 0, len(items))
+		for _, item := range items {
+			value := uint(item)
+			predicates = append(predicates, user.PointsEQ(value))
+		}
+		listQuery = listQuery.Where(user.Or(predicates...))
+	}
+	if items := req.GetFilter().GetStatus(); len(items) > 0 {
+		predicates := make([]predicate.User, 0, len(items))
+		for _, item := range items {
+			value := toEntUser_Status(item)
+			predicates = append(predicates, user.StatusEQ(value))
+		}
+		listQuery = listQuery.Where(user.Or(predicates...))
+	}
+	if items := req.GetFilter().GetUserName(); len(items) > 0 {
+		predicates := make([]predicate.User, 0, len(items))
+		for _, item := range items {
+			value := item
+			predicates = append(predicates, user.UserNameEQ(value))
+		}
+		listQuery = listQuery.Where(user.Or(predicates...))
+	}
+	switch req.GetView() {
+	case ListUserRequest_VIEW_UNSPECIFIED, ListUserRequest_BASIC:
+	case ListUserRequest_WITH_EDGE_IDS:
+		listQuery = listQuery.WithAttachment(func(query *ent.AttachmentQuery) {
+			query.Select(attachment.FieldID)
+		})
+		listQuery = listQuery.WithGroup(func(query *ent.GroupQuery) {
+			query.Select(group.FieldID)
+		})
+		listQuery = listQuery.WithReceived1(func(query *ent.AttachmentQuery) {
+			query.Select(attachment.FieldID)
+		})
+	case ListUserRequest_WITH_EDGES:
+		if err := svc.withEdges(listQuery, req.GetEdges()); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	entList, err := listQuery.Limit(pageSize + 1).All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+	var nextPageToken string
+	if len(entList) > pageSize {
+		entList = entList[:pageSize]
+		last := entList[len(entList)-1]
+		var value interface{}
+		if cursor != nil {
+			value = cursor(last)
+		}
+		if nextPageToken, err = runtime.EncodePageToken(orderBy, last.ID, value); err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+	}
+	protoList := make([]*User, 0, len(entList))
+	for _, e := range entList {
+		protoEntity, err := toProtoUser(e)
+		if err == nil && req.GetView() == ListUserRequest_WITH_EDGES {
+			err = toProtoUserEdges(e, protoEntity)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		protoList = append(protoList, protoEntity)
+	}
+	return &ListUserResponse{
+		Users:         protoList,
+		NextPageToken: nextPageToken,
+	}, nil
+
+}
+
+// BatchCreate implements UserServiceServer.BatchCreate
+func (svc *UserService) BatchCreate(ctx context.Context, req *BatchCreateUsersRequest) (*BatchCreateUsersResponse, error) {
+	requests := req.GetRequests()
+	if len(requests) > runtime.MaxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: batch size exceeds %d", runtime.MaxBatchSize)
+	}
+	results := make([]*BatchCreateUsersResponse_Result, len(requests))
+	if !req.GetAtomic() {
+		for i, r := range requests {
+			results[i] = &BatchCreateUsersResponse_Result{}
+			m, err := svc.createBuilder(svc.client.User, r.GetUser())
+			if err != nil {
+				st := svc.batchStatus(err)
+				results[i].Code, results[i].Message = int32(st.Code()), st.Message()
+				continue
+			}
+			res, err := m.Save(ctx)
+			if err != nil {
+				st := svc.batchStatus(err)
+				results[i].Code, results[i].Message = int32(st.Code()), st.Message()
+				continue
+			}
+			if results[i].User, err = toProtoUser(res); err != nil {
+				return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			}
+		}
+		return &BatchCreateUsersResponse{Results: results}, nil
+	}
+	tx, err := svc.client.Tx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+	builders := make([]*ent.UserCreate, len(requests))
+	for i, r := range requests {
+		if builders[i], err = svc.createBuilder(tx.User, r.GetUser()); err != nil {
+			st := svc.batchStatus(err)
+			return nil, runtime.Rollback(tx, status.Errorf(st.Code(), "item %d: %s", i, st.Message()))
+		}
+	}
+	res, err := tx.User.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, runtime.Rollback(tx, svc.batchStatus(err).Err())
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+	for i, e := range res {
+		results[i] = &BatchCreateUsersResponse_Result{}
+		if results[i].User, err = toProtoUser(e); err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+	}
+	return &BatchCreateUsersResponse{Results: results}, nil
+
+}
+
+// BatchGet implements UserServiceServer.BatchGet
+func (svc *UserService) BatchGet(ctx context.Context, req *BatchGetUsersRequest) (*BatchGetUsersResponse, error) {
+	reqIDs := req.GetIds()
+	if len(reqIDs) > runtime.MaxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: batch size exceeds %d", runtime.MaxBatchSize)
+	}
+	ids := make([]interface{}, len(reqIDs))
+	for i, item := range reqIDs {
+		id := int(item)
+		ids[i] = id
+	}
+	getQuery := svc.client.User.Query().
+		Where(runtime.IDIn(user.FieldID, ids))
+	switch req.GetView() {
+	case BatchGetUsersRequest_VIEW_UNSPECIFIED, BatchGetUsersRequest_BASIC:
+	case BatchGetUsersRequest_WITH_EDGE_IDS:
+		getQuery = getQuery.WithAttachment(func(query *ent.AttachmentQuery) {
+			query.Select(attachment.FieldID)
+		})
+		getQuery = getQuery.WithGroup(func(query *ent.GroupQuery) {
+			query.Select(group.FieldID)
+		})
+		getQuery = getQuery.WithReceived1(func(query *ent.AttachmentQuery) {
+			query.Select(attachment.FieldID)
+		})
+	case BatchGetUsersRequest_WITH_EDGES:
+		if err := svc.withEdges(getQuery, req.GetEdges()); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	entList, err := getQuery.All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+	found := make(map[interface{}]*ent.User, len(entList))
+	for _, e := range entList {
+		found[e.ID] = e
+	}
+	results := make([]*BatchGetUsersResponse_Result, len(ids))
+	for i, id := range ids {
+		results[i] = &BatchGetUsersResponse_Result{}
+		e, ok := found[id]
+		if !ok {
+			results[i].Code, results[i].Message = int32(codes.NotFound), "not found"
+			continue
+		}
+		if results[i].User, err = toProtoUser(e); err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		if req.GetView() == BatchGetUsersRequest_WITH_EDGES {
+			if err := toProtoUserEdges(e, results[i].User); err != nil {
+				return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			}
+		}
+	}
+	return &BatchGetUsersResponse{Results: results}, nil
+
+}
+
+// BatchDelete implements UserServiceServer.BatchDelete
+func (svc *UserService) BatchDelete(ctx context.Context, req *BatchDeleteUsersRequest) (*BatchDeleteUsersResponse, error) {
+	reqIDs := req.GetIds()
+	if len(reqIDs) > runtime.MaxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: batch size exceeds %d", runtime.MaxBatchSize)
+	}
+	results := make([]*BatchDeleteUsersResponse_Result, len(reqIDs))
+	if !req.GetAtomic() {
+		for i, item := range reqIDs {
+			results[i] = &BatchDeleteUsersResponse_Result{Id: item}
+			id := int(item)
+			if err := svc.client.User.DeleteOneID(id).Exec(ctx); err != nil {
+				st := svc.batchStatus(err)
+				results[i].Code, results[i].Message = int32(st.Code()), st.Message()
+			}
+		}
+		return &BatchDeleteUsersResponse{Results: results}, nil
+	}
+	ids := make([]interface{}, 0, len(reqIDs))
+	seen := make(map[interface{}]struct{}, len(reqIDs))
+	for i, item := range reqIDs {
+		results[i] = &BatchDeleteUsersResponse_Result{Id: item}
+		id := int(item)
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	tx, err := svc.client.Tx(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+	n, err := tx.User.Delete().
+		Where(runtime.IDIn(user.FieldID, ids)).
+		Exec(ctx)
+	switch {
+	case err != nil:
+		return nil, runtime.Rollback(tx, svc.batchStatus(err).Err())
+	case n != len(ids):
+		return nil, runtime.Rollback(tx, status.Errorf(codes.NotFound, "not found: %d of %d ids", len(ids)-n, len(ids)))
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+	return &BatchDeleteUsersResponse{Results: results}, nil
+
+}
+
+// NewUserServiceHTTPHandler returns an http.Handler serving the google.api.http rules of the methods of svc.
+// JSON requests are transcoded to the methods in-process, and their responses are encoded with protojson.
+// The methods are called directly, so the interceptors of a grpc.Server are not applied to the requests.
+func NewUserServiceHTTPHandler(svc UserServiceServer) http.Handler {
+	mux := runtime.NewHTTPMux()
+	mux.Handle("POST", "/v1/users", "user", func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+		req := &CreateUserRequest{}
+		if err := decode(req); err != nil {
+			return nil, err
+		}
+		return svc.Create(ctx, req)
+	})
+	mux.Handle("GET", "/v1/users/{id}", "", func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+		req := &GetUserRequest{}
+		if err := decode(req); err != nil {
+			return nil, err
+		}
+		return svc.Get(ctx, req)
+	})
+	mux.Handle("PATCH", "/v1/users/{user.id}", "user", func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+		req := &UpdateUserRequest{}
+		if err := decode(req); err != nil {
+			return nil, err
+		}
+		return svc.Update(ctx, req)
+	})
+	mux.Handle("DELETE", "/v1/users/{id}", "", func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+		req := &DeleteUserRequest{}
+		if err := decode(req); err != nil {
+			return nil, err
+		}
+		return svc.Delete(ctx, req)
+	})
+	mux.Handle("GET", "/v1/users", "", func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+		req := &ListUserRequest{}
+		if err := decode(req); err != nil {
+			return nil, err
+		}
+		return svc.List(ctx, req)
+	})
+	mux.Handle("POST", "/v1/users:batchCreate", "*", func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+		req := &BatchCreateUsersRequest{}
+		if err := decode(req); err != nil {
+			return nil, err
+		}
+		return svc.BatchCreate(ctx, req)
+	})
+	mux.Handle("GET", "/v1/users:batchGet", "", func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+		req := &BatchGetUsersRequest{}
+		if err := decode(req); err != nil {
+			return nil, err
+		}
+		return svc.BatchGet(ctx, req)
+	})
+	mux.Handle("POST", "/v1/users:batchDelete", "*", func(ctx context.Context, decode func(proto.Message) error) (proto.Message, error) {
+		req := &BatchDeleteUsersRequest{}
+		if err := decode(req); err != nil {
+			return nil, err
+		}
+		return svc.BatchDelete(ctx, req)
+	})
+	return mux
+}
+
+// toProtoGroup transforms the ent type to the pb type
+func toProtoGroup(e *ent.Group) (*Group, error) {
+	v := &Group{}
+	id := int32(e.ID)
+	v.Id = id
+	name := e.Name
+	v.Name = name
+	for _, edg := range e.Edges.Users {
+		id := int32(edg.ID)
+		v.Users = append(v.Users, &User{
+			Id: id,
+		})
+	}
+	return v, nil
+}
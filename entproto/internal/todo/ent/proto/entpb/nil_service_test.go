@@ -21,8 +21,9 @@ import (
 
 	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
-	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestNilExampleService_Get(t *testing.T) {
@@ -37,7 +38,14 @@ func TestNilExampleService_Get(t *testing.T) {
 	get, err := svc.Get(ctx, &GetNilExampleRequest{Id: int32(nex.ID)})
 	require.NoError(t, err)
 	require.EqualValues(t, nex.TimeNil.Unix(), get.GetTimeNil().AsTime().Unix())
-	require.EqualValues(t, *nex.StrNil, get.GetStrNil().GetValue())
+	require.EqualValues(t, *nex.StrNil, get.GetStrNil())
+	require.NotNil(t, get.IntOpt)
+
+	nex = client.NilExample.Create().SaveX(ctx)
+	get, err = svc.Get(ctx, &GetNilExampleRequest{Id: int32(nex.ID)})
+	require.NoError(t, err)
+	require.Nil(t, get.StrNil)
+	require.Nil(t, get.TimeNil)
 }
 
 func TestNilExampleService_Create(t *testing.T) {
@@ -48,7 +56,7 @@ func TestNilExampleService_Create(t *testing.T) {
 	ts := time.Now()
 	c, err := svc.Create(ctx, &CreateNilExampleRequest{
 		NilExample: &NilExample{
-			StrNil:  wrapperspb.String("str"),
+			StrNil:  proto.String("str"),
 			TimeNil: timestamppb.New(ts),
 		},
 	})
@@ -57,4 +65,35 @@ func TestNilExampleService_Create(t *testing.T) {
 	require.NoError(t, err)
 	require.EqualValues(t, "str", *get.StrNil)
 	require.EqualValues(t, ts.Unix(), get.TimeNil.Unix())
+
+	c, err = svc.Create(ctx, &CreateNilExampleRequest{
+		NilExample: &NilExample{
+			StrNil: proto.String(""),
+		},
+	})
+	require.NoError(t, err)
+	get, err = client.NilExample.Get(ctx, int(c.Id))
+	require.NoError(t, err)
+	require.NotNil(t, get.StrNil)
+	require.Empty(t, *get.StrNil)
+	require.Nil(t, get.TimeNil)
+}
+
+func TestNilExampleService_Update(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewNilExampleService(client)
+	ctx := context.Background()
+	nex := client.NilExample.Create().
+		SetStrNil("str").
+		SetIntOpt(10).
+		SaveX(ctx)
+	_, err := svc.Update(ctx, &UpdateNilExampleRequest{
+		NilExample: &NilExample{Id: int32(nex.ID), IntOpt: proto.Int32(0)},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"str_nil", "int_opt"}},
+	})
+	require.NoError(t, err)
+	get := client.NilExample.GetX(ctx, nex.ID)
+	require.Nil(t, get.StrNil)
+	require.Zero(t, get.IntOpt)
 }
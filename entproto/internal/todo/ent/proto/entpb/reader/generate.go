@@ -0,0 +1,17 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+//go:generate protoc -I=../.. --go_out=../.. --go-grpc_out=../.. --go_opt=paths=source_relative --go-grpc_opt=paths=source_relative --entgrpc_out=../.. --entgrpc_opt=paths=source_relative,schema_path=../../../schema entpb/reader/reader.proto
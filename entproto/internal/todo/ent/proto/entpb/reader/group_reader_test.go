@@ -0,0 +1,68 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGroupReader(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewGroupReader(client)
+	ctx := context.Background()
+	group := client.Group.Create().SetName("managers").SaveX(ctx)
+	client.User.Create().
+		SetUserName("rotemtam").
+		SetJoined(time.Now()).
+		SetPoints(10).
+		SetExp(1000).
+		SetStatus("pending").
+		SetExternalID(1).
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SetGroup(group).
+		SaveX(ctx)
+
+	get, err := svc.Get(ctx, &GetGroupRequest{
+		Id:   int32(group.ID),
+		View: GetGroupRequest_WITH_EDGES,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "managers", get.Name)
+	require.Len(t, get.Users, 1)
+	require.Equal(t, "rotemtam", get.Users[0].UserName)
+
+	list, err := svc.List(ctx, &ListGroupRequest{
+		Filter: &ListGroupRequest_Filter{Name: []string{"managers"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, list.Groups, 1)
+	require.EqualValues(t, group.ID, list.Groups[0].Id)
+
+	_, err = svc.Get(ctx, &GetGroupRequest{Id: 1000})
+	respStatus, ok := status.FromError(err)
+	require.True(t, ok, "expected a gRPC status error")
+	require.EqualValues(t, codes.NotFound, respStatus.Code())
+}
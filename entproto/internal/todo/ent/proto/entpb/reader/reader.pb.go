@@ -0,0 +1,567 @@
+// Code generated by entproto. DO NOT EDIT.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.17.3
+// source: entpb/reader/reader.proto
+
+package reader
+
+import (
+	entpb "entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetGroupRequest_View int32
+
+const (
+	GetGroupRequest_VIEW_UNSPECIFIED GetGroupRequest_View = 0
+	GetGroupRequest_BASIC            GetGroupRequest_View = 1
+	GetGroupRequest_WITH_EDGE_IDS    GetGroupRequest_View = 2
+	GetGroupRequest_WITH_EDGES       GetGroupRequest_View = 3
+)
+
+// Enum value maps for GetGroupRequest_View.
+var (
+	GetGroupRequest_View_name = map[int32]string{
+		0: "VIEW_UNSPECIFIED",
+		1: "BASIC",
+		2: "WITH_EDGE_IDS",
+		3: "WITH_EDGES",
+	}
+	GetGroupRequest_View_value = map[string]int32{
+		"VIEW_UNSPECIFIED": 0,
+		"BASIC":            1,
+		"WITH_EDGE_IDS":    2,
+		"WITH_EDGES":       3,
+	}
+)
+
+func (x GetGroupRequest_View) Enum() *GetGroupRequest_View {
+	p := new(GetGroupRequest_View)
+	*p = x
+	return p
+}
+
+func (x GetGroupRequest_View) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetGroupRequest_View) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_reader_reader_proto_enumTypes[0].Descriptor()
+}
+
+func (GetGroupRequest_View) Type() protoreflect.EnumType {
+	return &file_entpb_reader_reader_proto_enumTypes[0]
+}
+
+func (x GetGroupRequest_View) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetGroupRequest_View.Descriptor instead.
+func (GetGroupRequest_View) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_reader_reader_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type ListGroupRequest_View int32
+
+const (
+	ListGroupRequest_VIEW_UNSPECIFIED ListGroupRequest_View = 0
+	ListGroupRequest_BASIC            ListGroupRequest_View = 1
+	ListGroupRequest_WITH_EDGE_IDS    ListGroupRequest_View = 2
+	ListGroupRequest_WITH_EDGES       ListGroupRequest_View = 3
+)
+
+// Enum value maps for ListGroupRequest_View.
+var (
+	ListGroupRequest_View_name = map[int32]string{
+		0: "VIEW_UNSPECIFIED",
+		1: "BASIC",
+		2: "WITH_EDGE_IDS",
+		3: "WITH_EDGES",
+	}
+	ListGroupRequest_View_value = map[string]int32{
+		"VIEW_UNSPECIFIED": 0,
+		"BASIC":            1,
+		"WITH_EDGE_IDS":    2,
+		"WITH_EDGES":       3,
+	}
+)
+
+func (x ListGroupRequest_View) Enum() *ListGroupRequest_View {
+	p := new(ListGroupRequest_View)
+	*p = x
+	return p
+}
+
+func (x ListGroupRequest_View) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListGroupRequest_View) Descriptor() protoreflect.EnumDescriptor {
+	return file_entpb_reader_reader_proto_enumTypes[1].Descriptor()
+}
+
+func (ListGroupRequest_View) Type() protoreflect.EnumType {
+	return &file_entpb_reader_reader_proto_enumTypes[1]
+}
+
+func (x ListGroupRequest_View) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListGroupRequest_View.Descriptor instead.
+func (ListGroupRequest_View) EnumDescriptor() ([]byte, []int) {
+	return file_entpb_reader_reader_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type GetGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	View  GetGroupRequest_View `protobuf:"varint,2,opt,name=view,proto3,enum=entpb.reader.GetGroupRequest_View" json:"view,omitempty"`
+	Edges []string             `protobuf:"bytes,3,rep,name=edges,proto3" json:"edges,omitempty"`
+}
+
+func (x *GetGroupRequest) Reset() {
+	*x = GetGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_reader_reader_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupRequest) ProtoMessage() {}
+
+func (x *GetGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_reader_reader_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_reader_reader_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetGroupRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetGroupRequest) GetView() GetGroupRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return GetGroupRequest_VIEW_UNSPECIFIED
+}
+
+func (x *GetGroupRequest) GetEdges() []string {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type ListGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32                    `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                   `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	View      ListGroupRequest_View    `protobuf:"varint,3,opt,name=view,proto3,enum=entpb.reader.ListGroupRequest_View" json:"view,omitempty"`
+	OrderBy   string                   `protobuf:"bytes,4,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Filter    *ListGroupRequest_Filter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	Edges     []string                 `protobuf:"bytes,6,rep,name=edges,proto3" json:"edges,omitempty"`
+}
+
+func (x *ListGroupRequest) Reset() {
+	*x = ListGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_reader_reader_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupRequest) ProtoMessage() {}
+
+func (x *ListGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_reader_reader_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupRequest) Descriptor() ([]byte, []int) {
+	return file_entpb_reader_reader_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListGroupRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGroupRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListGroupRequest) GetView() ListGroupRequest_View {
+	if x != nil {
+		return x.View
+	}
+	return ListGroupRequest_VIEW_UNSPECIFIED
+}
+
+func (x *ListGroupRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListGroupRequest) GetFilter() *ListGroupRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListGroupRequest) GetEdges() []string {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type ListGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups        []*entpb.Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	NextPageToken string         `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListGroupResponse) Reset() {
+	*x = ListGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_reader_reader_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupResponse) ProtoMessage() {}
+
+func (x *ListGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_reader_reader_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupResponse) Descriptor() ([]byte, []int) {
+	return file_entpb_reader_reader_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListGroupResponse) GetGroups() []*entpb.Group {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ListGroupResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListGroupRequest_Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   []int32  `protobuf:"varint,1,rep,packed,name=id,proto3" json:"id,omitempty"`
+	Name []string `protobuf:"bytes,2,rep,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ListGroupRequest_Filter) Reset() {
+	*x = ListGroupRequest_Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_entpb_reader_reader_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupRequest_Filter) ProtoMessage() {}
+
+func (x *ListGroupRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_entpb_reader_reader_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListGroupRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_entpb_reader_reader_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ListGroupRequest_Filter) GetId() []int32 {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ListGroupRequest_Filter) GetName() []string {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+var File_entpb_reader_reader_proto protoreflect.FileDescriptor
+
+var file_entpb_reader_reader_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2f, 0x72,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0x11, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2f, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbb, 0x01, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x36, 0x0a, 0x04, 0x76, 0x69, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
+	0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69,
+	0x65, 0x77, 0x52, 0x04, 0x76, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x22, 0x4a,
+	0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x57, 0x49,
+	0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x53, 0x10, 0x03, 0x22, 0xf1, 0x02, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x37, 0x0a, 0x04, 0x76,
+	0x69, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12,
+	0x3d, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x64, 0x67, 0x65, 0x73, 0x1a, 0x2c, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x04, 0x56, 0x69, 0x65, 0x77, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x49,
+	0x45, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x42, 0x41, 0x53, 0x49, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57,
+	0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f, 0x49, 0x44, 0x53, 0x10, 0x02, 0x12, 0x0e,
+	0x0a, 0x0a, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x53, 0x10, 0x03, 0x22, 0x61,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x32, 0x8a, 0x01, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x32, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x47, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x40,
+	0x5a, 0x3e, 0x65, 0x6e, 0x74, 0x67, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x69, 0x62, 0x2f, 0x65, 0x6e, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x6f, 0x64, 0x6f, 0x2f, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_entpb_reader_reader_proto_rawDescOnce sync.Once
+	file_entpb_reader_reader_proto_rawDescData = file_entpb_reader_reader_proto_rawDesc
+)
+
+func file_entpb_reader_reader_proto_rawDescGZIP() []byte {
+	file_entpb_reader_reader_proto_rawDescOnce.Do(func() {
+		file_entpb_reader_reader_proto_rawDescData = protoimpl.X.CompressGZIP(file_entpb_reader_reader_proto_rawDescData)
+	})
+	return file_entpb_reader_reader_proto_rawDescData
+}
+
+var file_entpb_reader_reader_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_entpb_reader_reader_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_entpb_reader_reader_proto_goTypes = []interface{}{
+	(GetGroupRequest_View)(0),       // 0: entpb.reader.GetGroupRequest.View
+	(ListGroupRequest_View)(0),      // 1: entpb.reader.ListGroupRequest.View
+	(*GetGroupRequest)(nil),         // 2: entpb.reader.GetGroupRequest
+	(*ListGroupRequest)(nil),        // 3: entpb.reader.ListGroupRequest
+	(*ListGroupResponse)(nil),       // 4: entpb.reader.ListGroupResponse
+	(*ListGroupRequest_Filter)(nil), // 5: entpb.reader.ListGroupRequest.Filter
+	(*entpb.Group)(nil),             // 6: entpb.Group
+}
+var file_entpb_reader_reader_proto_depIdxs = []int32{
+	0, // 0: entpb.reader.GetGroupRequest.view:type_name -> entpb.reader.GetGroupRequest.View
+	1, // 1: entpb.reader.ListGroupRequest.view:type_name -> entpb.reader.ListGroupRequest.View
+	5, // 2: entpb.reader.ListGroupRequest.filter:type_name -> entpb.reader.ListGroupRequest.Filter
+	6, // 3: entpb.reader.ListGroupResponse.groups:type_name -> entpb.Group
+	2, // 4: entpb.reader.GroupReader.Get:input_type -> entpb.reader.GetGroupRequest
+	3, // 5: entpb.reader.GroupReader.List:input_type -> entpb.reader.ListGroupRequest
+	6, // 6: entpb.reader.GroupReader.Get:output_type -> entpb.Group
+	4, // 7: entpb.reader.GroupReader.List:output_type -> entpb.reader.ListGroupResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_entpb_reader_reader_proto_init() }
+func file_entpb_reader_reader_proto_init() {
+	if File_entpb_reader_reader_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_entpb_reader_reader_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_reader_reader_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_reader_reader_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_entpb_reader_reader_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupRequest_Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_entpb_reader_reader_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_entpb_reader_reader_proto_goTypes,
+		DependencyIndexes: file_entpb_reader_reader_proto_depIdxs,
+		EnumInfos:         file_entpb_reader_reader_proto_enumTypes,
+		MessageInfos:      file_entpb_reader_reader_proto_msgTypes,
+	}.Build()
+	File_entpb_reader_reader_proto = out.File
+	file_entpb_reader_reader_proto_rawDesc = nil
+	file_entpb_reader_reader_proto_goTypes = nil
+	file_entpb_reader_reader_proto_depIdxs = nil
+}
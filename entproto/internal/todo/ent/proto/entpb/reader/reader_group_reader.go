@@ -0,0 +1,341 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package reader
+
+import (
+	context "context"
+	ent "entgo.io/contrib/entproto/internal/todo/ent"
+	group "entgo.io/contrib/entproto/internal/todo/ent/group"
+	predicate "entgo.io/contrib/entproto/internal/todo/ent/predicate"
+	entpb "entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	geo "entgo.io/contrib/entproto/internal/todo/ent/proto/geo"
+	schema "entgo.io/contrib/entproto/internal/todo/ent/schema"
+	user "entgo.io/contrib/entproto/internal/todo/ent/user"
+	runtime "entgo.io/contrib/entproto/runtime"
+	errors "errors"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	strings "strings"
+)
+
+// GroupReader implements GroupReaderServer
+type GroupReader struct {
+	client *ent.Client
+	UnimplementedGroupReaderServer
+}
+
+// NewGroupReader returns a new GroupReader
+func NewGroupReader(client *ent.Client) *GroupReader {
+	return &GroupReader{
+		client: client,
+	}
+}
+
+// toProtoGroup transforms the ent type to the pb type
+func toProtoGroup(e *ent.Group) (*entpb.Group, error) {
+	v := &entpb.Group{}
+	id := int32(e.ID)
+	v.Id = id
+	name := e.Name
+	v.Name = name
+	for _, edg := range e.Edges.Users {
+		id := int32(edg.ID)
+		v.Users = append(v.Users, &entpb.User{
+			Id: id,
+		})
+	}
+	return v, nil
+}
+
+// toProtoGroupEdges sets the edges of v to the messages of the loaded edges of e, with all of their fields.
+func toProtoGroupEdges(e *ent.Group, v *entpb.Group) error {
+	v.Users = nil
+	for _, edg := range e.Edges.Users {
+		item, err := toProtoUser(edg)
+		if err != nil {
+			return err
+		}
+		v.Users = append(v.Users, item)
+	}
+	return nil
+}
+
+// withEdges eager-loads the given edges of the entities returned by the query, or all of them if edges is empty.
+func (svc *GroupReader) withEdges(query *ent.GroupQuery, edges []string) error {
+	all := len(edges) == 0
+	load := make(map[string]bool, len(edges))
+	for _, edge := range edges {
+		switch edge {
+		case "users":
+			load[edge] = true
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid argument: unknown edge %q", edge)
+		}
+	}
+	if all || load["users"] {
+		query.WithUsers()
+	}
+	return nil
+}
+
+// Get implements GroupReaderServer.Get
+func (svc *GroupReader) Get(ctx context.Context, req *GetGroupRequest) (*entpb.Group, error) {
+	var (
+		err error
+		get *ent.Group
+	)
+	id := int(req.GetId())
+	switch req.GetView() {
+	case GetGroupRequest_VIEW_UNSPECIFIED, GetGroupRequest_BASIC:
+		get, err = svc.client.Group.Get(ctx, id)
+	case GetGroupRequest_WITH_EDGE_IDS:
+		get, err = svc.client.Group.Query().
+			Where(group.ID(id)).
+			WithUsers(func(query *ent.UserQuery) {
+				query.Select(user.FieldID)
+			}).
+			Only(ctx)
+	case GetGroupRequest_WITH_EDGES:
+		getQuery := svc.client.Group.Query().
+			Where(group.ID(id))
+		if err := svc.withEdges(getQuery, req.GetEdges()); err != nil {
+			return nil, err
+		}
+		get, err = getQuery.Only(ctx)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	switch {
+	case err == nil:
+		protoEntity, err := toProtoGroup(get)
+		if err == nil && req.GetView() == GetGroupRequest_WITH_EDGES {
+			err = toProtoGroupEdges(get, protoEntity)
+		}
+		return protoEntity, err
+	case ent.IsNotFound(err):
+		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+
+}
+
+// List implements GroupReaderServer.List
+func (svc *GroupReader) List(ctx context.Context, req *ListGroupRequest) (*ListGroupResponse, error) {
+	pageSize, err := runtime.PageSize(req.GetPageSize())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	orderBy, err := runtime.ParseOrderBy(req.GetOrderBy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+	}
+	var (
+		cursor      func(*ent.Group) interface{}
+		orderFields = []string{group.FieldID}
+	)
+	switch orderBy.Field {
+	case "", "id":
+	case "name":
+		cursor = func(e *ent.Group) interface{} { return &e.Name }
+		orderFields = append([]string{group.FieldName}, orderFields...)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: unknown order_by field %q", orderBy.Field)
+	}
+	listQuery := svc.client.Group.Query()
+	if orderBy.Desc {
+		listQuery = listQuery.Order(ent.Desc(orderFields...))
+	} else {
+		listQuery = listQuery.Order(ent.Asc(orderFields...))
+	}
+	if req.GetPageToken() != "" {
+		last := &ent.Group{}
+		var value interface{}
+		if cursor != nil {
+			value = cursor(last)
+		}
+		if err := runtime.DecodePageToken(req.GetPageToken(), orderBy, &last.ID, value); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		}
+		listQuery = listQuery.Where(runtime.PageAfter(orderBy, group.FieldID, &last.ID, orderFields[0], value))
+	}
+	if items := req.GetFilter().GetId(); len(items) > 0 {
+		predicates := make([]predicate.Group, 0, len(items))
+		for _, item := range items {
+			value := int(item)
+			predicates = append(predicates, group.IDEQ(value))
+		}
+		listQuery = listQuery.Where(group.Or(predicates...))
+	}
+	if items := req.GetFilter().GetName(); len(items) > 0 {
+		predicates := make([]predicate.Group, 0, len(items))
+		for _, item := range items {
+			value := item
+			predicates = append(predicates, group.NameEQ(value))
+		}
+		listQuery = listQuery.Where(group.Or(predicates...))
+	}
+	switch req.GetView() {
+	case ListGroupRequest_VIEW_UNSPECIFIED, ListGroupRequest_BASIC:
+	case ListGroupRequest_WITH_EDGE_IDS:
+		listQuery = listQuery.WithUsers(func(query *ent.UserQuery) {
+			query.Select(user.FieldID)
+		})
+	case ListGroupRequest_WITH_EDGES:
+		if err := svc.withEdges(listQuery, req.GetEdges()); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+	}
+	entList, err := listQuery.Limit(pageSize + 1).All(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
+	var nextPageToken string
+	if len(entList) > pageSize {
+		entList = entList[:pageSize]
+		last := entList[len(entList)-1]
+		var value interface{}
+		if cursor != nil {
+			value = cursor(last)
+		}
+		if nextPageToken, err = runtime.EncodePageToken(orderBy, last.ID, value); err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+	}
+	protoList := make([]*entpb.Group, 0, len(entList))
+	for _, e := range entList {
+		protoEntity, err := toProtoGroup(e)
+		if err == nil && req.GetView() == ListGroupRequest_WITH_EDGES {
+			err = toProtoGroupEdges(e, protoEntity)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		}
+		protoList = append(protoList, protoEntity)
+	}
+	return &ListGroupResponse{
+		Groups:        protoList,
+		NextPageToken: nextPageToken,
+	}, nil
+
+}
+
+func toProtoUser_Status(e user.Status) entpb.User_Status {
+	if v, ok := entpb.User_Status_value[strings.ToUpper(string(e))]; ok {
+		return entpb.User_Status(v)
+	}
+	return entpb.User_Status(0)
+}
+
+func toEntUser_Status(e entpb.User_Status) user.Status {
+	if v, ok := entpb.User_Status_name[int32(e)]; ok {
+		return user.Status(strings.ToLower(v))
+	}
+	return ""
+}
+
+// toProtoUser transforms the ent type to the pb type
+func toProtoUser(e *ent.User) (*entpb.User, error) {
+	v := &entpb.User{}
+	accountbalance := e.AccountBalance
+	v.AccountBalance = accountbalance
+	address := &geo.Address{}
+	if err := runtime.ToProtoJSON(e.Address, address); err != nil {
+		return nil, err
+	}
+	v.Address = address
+	buser1 := wrapperspb.Int32(int32(e.BUser1))
+	v.BUser_1 = buser1
+	banned := e.Banned
+	v.Banned = banned
+	bigintValue, err := e.BigInt.Value()
+	if err != nil {
+		return nil, err
+	}
+	bigintTyped, ok := bigintValue.(string)
+	if !ok {
+		return nil, errors.New("casting value to string")
+	}
+	bigint := wrapperspb.String(bigintTyped)
+	v.BigInt = bigint
+	crmid, err := e.CrmID.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	v.CrmId = crmid
+	custompb := uint64(e.CustomPb)
+	v.CustomPb = custompb
+	exp := e.Exp
+	v.Exp = exp
+	externalid := int32(e.ExternalID)
+	v.ExternalId = externalid
+	heightincm := e.HeightInCm
+	v.HeightInCm = heightincm
+	id := int32(e.ID)
+	v.Id = id
+	joined := timestamppb.New(e.Joined)
+	v.Joined = joined
+	labels := e.Labels
+	v.Labels = labels
+	metadata := &structpb.Struct{}
+	if err := runtime.ToProtoJSON(e.Metadata, metadata); err != nil {
+		return nil, err
+	}
+	v.Metadata = metadata
+	optbool := wrapperspb.Bool(e.OptBool)
+	v.OptBool = optbool
+	optnum := wrapperspb.Int32(int32(e.OptNum))
+	v.OptNum = optnum
+	optstr := wrapperspb.String(e.OptStr)
+	v.OptStr = optstr
+	points := uint32(e.Points)
+	v.Points = points
+	preferences := &structpb.Value{}
+	if err := runtime.ToProtoJSON(e.Preferences, preferences); err != nil {
+		return nil, err
+	}
+	v.Preferences = preferences
+	price := schema.PriceToProto(e.Price)
+	v.Price = price
+	var scores []int32
+	for _, elem := range e.Scores {
+		scores = append(scores, int32(elem))
+	}
+	v.Scores = scores
+	sessiontimeout := durationpb.New(e.SessionTimeout)
+	v.SessionTimeout = sessiontimeout
+	status := toProtoUser_Status(e.Status)
+	v.Status = status
+	username := e.UserName
+	v.UserName = username
+	if edg := e.Edges.Attachment; edg != nil {
+		id, err := edg.ID.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		v.Attachment = &entpb.Attachment{
+			Id: id,
+		}
+	}
+	if edg := e.Edges.Group; edg != nil {
+		id := int32(edg.ID)
+		v.Group = &entpb.Group{
+			Id: id,
+		}
+	}
+	for _, edg := range e.Edges.Received1 {
+		id, err := edg.ID.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		v.Received_1 = append(v.Received_1, &entpb.Attachment{
+			Id: id,
+		})
+	}
+	return v, nil
+}
@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package reader
+
+import (
+	context "context"
+	entpb "entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// GroupReaderClient is the client API for GroupReader service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GroupReaderClient interface {
+	Get(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*entpb.Group, error)
+	List(ctx context.Context, in *ListGroupRequest, opts ...grpc.CallOption) (*ListGroupResponse, error)
+}
+
+type groupReaderClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroupReaderClient(cc grpc.ClientConnInterface) GroupReaderClient {
+	return &groupReaderClient{cc}
+}
+
+func (c *groupReaderClient) Get(ctx context.Context, in *GetGroupRequest, opts ...grpc.CallOption) (*entpb.Group, error) {
+	out := new(entpb.Group)
+	err := c.cc.Invoke(ctx, "/entpb.reader.GroupReader/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupReaderClient) List(ctx context.Context, in *ListGroupRequest, opts ...grpc.CallOption) (*ListGroupResponse, error) {
+	out := new(ListGroupResponse)
+	err := c.cc.Invoke(ctx, "/entpb.reader.GroupReader/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupReaderServer is the server API for GroupReader service.
+// All implementations must embed UnimplementedGroupReaderServer
+// for forward compatibility
+type GroupReaderServer interface {
+	Get(context.Context, *GetGroupRequest) (*entpb.Group, error)
+	List(context.Context, *ListGroupRequest) (*ListGroupResponse, error)
+	mustEmbedUnimplementedGroupReaderServer()
+}
+
+// UnimplementedGroupReaderServer must be embedded to have forward compatible implementations.
+type UnimplementedGroupReaderServer struct {
+}
+
+func (UnimplementedGroupReaderServer) Get(context.Context, *GetGroupRequest) (*entpb.Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedGroupReaderServer) List(context.Context, *ListGroupRequest) (*ListGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedGroupReaderServer) mustEmbedUnimplementedGroupReaderServer() {}
+
+// UnsafeGroupReaderServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupReaderServer will
+// result in compilation errors.
+type UnsafeGroupReaderServer interface {
+	mustEmbedUnimplementedGroupReaderServer()
+}
+
+func RegisterGroupReaderServer(s grpc.ServiceRegistrar, srv GroupReaderServer) {
+	s.RegisterService(&GroupReader_ServiceDesc, srv)
+}
+
+func _GroupReader_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupReaderServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.reader.GroupReader/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupReaderServer).Get(ctx, req.(*GetGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupReader_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupReaderServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/entpb.reader.GroupReader/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupReaderServer).List(ctx, req.(*ListGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GroupReader_ServiceDesc is the grpc.ServiceDesc for GroupReader service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GroupReader_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "entpb.reader.GroupReader",
+	HandlerType: (*GroupReaderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _GroupReader_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _GroupReader_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "entpb/reader/reader.proto",
+}
@@ -0,0 +1,68 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entpb
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entproto/internal/todo/ent"
+	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestTodoService_UpdateMask(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewTodoService(client)
+	ctx := context.Background()
+	var users []*ent.User
+	for i := 0; i < 2; i++ {
+		users = append(users, client.User.Create().
+			SetUserName(strconv.Itoa(i)).
+			SetJoined(time.Now()).
+			SetPoints(10).
+			SetExp(1000).
+			SetStatus("pending").
+			SetExternalID(i+1).
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SaveX(ctx))
+	}
+	todo := client.Todo.Create().SetTask("task").SetUser(users[0]).SaveX(ctx)
+
+	// A masked required edge without a value cannot be cleared.
+	_, err := svc.Update(ctx, &UpdateTodoRequest{
+		Todo:       &Todo{Id: int32(todo.ID)},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"user"}},
+	})
+	require.EqualValues(t, codes.InvalidArgument, status.Code(err))
+	require.Contains(t, err.Error(), `field "user" cannot be cleared`)
+	require.Equal(t, users[0].ID, client.Todo.QueryUser(todo).OnlyIDX(ctx))
+
+	_, err = svc.Update(ctx, &UpdateTodoRequest{
+		Todo:       &Todo{Id: int32(todo.ID), User: &User{Id: int32(users[1].ID)}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"user"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, users[1].ID, client.Todo.QueryUser(todo).OnlyIDX(ctx))
+	require.Equal(t, "task", client.Todo.GetX(ctx, todo.ID).Task)
+}
@@ -15,19 +15,35 @@
 package entpb
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
 	"entgo.io/contrib/entproto/internal/todo/ent"
 	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	"entgo.io/contrib/entproto/internal/todo/ent/proto/geo"
+	"entgo.io/contrib/entproto/internal/todo/ent/schema"
+	"entgo.io/contrib/entproto/internal/todo/ent/user"
+	"entgo.io/contrib/entproto/runtime"
 	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/require"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestUserService_Create(t *testing.T) {
@@ -119,6 +135,67 @@ func TestUserService_Get(t *testing.T) {
 	require.EqualValues(t, respStatus.Code(), codes.NotFound)
 }
 
+func TestUserService_GetWithEdges(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+	group := client.Group.Create().SetName("managers").SaveX(ctx)
+	attachment := client.Attachment.Create().SaveX(ctx)
+	created := client.User.Create().
+		SetUserName("rotemtam").
+		SetJoined(time.Now()).
+		SetPoints(10).
+		SetExp(1000).
+		SetStatus("pending").
+		SetExternalID(1).
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SetGroup(group).
+		SetAttachment(attachment).
+		SaveX(ctx)
+
+	get, err := svc.Get(ctx, &GetUserRequest{
+		Id:   int32(created.ID),
+		View: GetUserRequest_WITH_EDGES,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, group.ID, get.Group.Id)
+	require.Equal(t, "managers", get.Group.Name)
+	require.Equal(t, attachment.ID[:], get.Attachment.Id)
+
+	get, err = svc.Get(ctx, &GetUserRequest{
+		Id:    int32(created.ID),
+		View:  GetUserRequest_WITH_EDGES,
+		Edges: []string{"group"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "managers", get.Group.Name)
+	require.Nil(t, get.Attachment)
+
+	list, err := svc.List(ctx, &ListUserRequest{View: ListUserRequest_WITH_EDGES})
+	require.NoError(t, err)
+	require.Len(t, list.Users, 1)
+	require.Equal(t, "managers", list.Users[0].Group.Name)
+
+	batch, err := svc.BatchGet(ctx, &BatchGetUsersRequest{
+		Ids:   []int32{int32(created.ID)},
+		View:  BatchGetUsersRequest_WITH_EDGES,
+		Edges: []string{"group"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "managers", batch.Results[0].User.Group.Name)
+
+	_, err = svc.Get(ctx, &GetUserRequest{
+		Id:    int32(created.ID),
+		View:  GetUserRequest_WITH_EDGES,
+		Edges: []string{"unknown"},
+	})
+	respStatus, ok := status.FromError(err)
+	require.True(t, ok, "expected a gRPC status error")
+	require.EqualValues(t, codes.InvalidArgument, respStatus.Code())
+}
+
 func TestUserService_Delete(t *testing.T) {
 	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
 	defer client.Close()
@@ -203,3 +280,479 @@ func TestUserService_Update(t *testing.T) {
 	afterUpd := client.User.GetX(ctx, created.ID)
 	require.EqualValues(t, inputUser.Exp, afterUpd.Exp)
 }
+
+func TestUserService_UpdateMask(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+	created := client.User.Create().
+		SetUserName("rotemtam").
+		SetJoined(time.Now()).
+		SetPoints(10).
+		SetExp(1000).
+		SetStatus("pending").
+		SetExternalID(1).
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SetOptStr("optional").
+		SaveX(ctx)
+
+	// Only the fields listed in the mask are applied.
+	updated, err := svc.Update(ctx, &UpdateUserRequest{
+		User: &User{
+			Id:       int32(created.ID),
+			UserName: "ignored",
+			Points:   999,
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"points"}},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 999, updated.Points)
+	afterUpd := client.User.GetX(ctx, created.ID)
+	require.EqualValues(t, 999, afterUpd.Points)
+	require.EqualValues(t, "rotemtam", afterUpd.UserName)
+	require.EqualValues(t, 1000, afterUpd.Exp)
+	require.EqualValues(t, "optional", afterUpd.OptStr)
+
+	// A masked optional field without a value is cleared.
+	_, err = svc.Update(ctx, &UpdateUserRequest{
+		User:       &User{Id: int32(created.ID)},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"opt_str"}},
+	})
+	require.NoError(t, err)
+	afterUpd = client.User.GetX(ctx, created.ID)
+	require.Empty(t, afterUpd.OptStr)
+	require.EqualValues(t, 999, afterUpd.Points)
+
+	_, err = svc.Update(ctx, &UpdateUserRequest{
+		User:       &User{Id: int32(created.ID), OptStr: wrapperspb.String("again")},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"opt_str"}},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, "again", client.User.GetX(ctx, created.ID).OptStr)
+
+	for _, path := range []string{"joined", "id", "unknown"} {
+		_, err = svc.Update(ctx, &UpdateUserRequest{
+			User:       &User{Id: int32(created.ID)},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{path}},
+		})
+		require.EqualValues(t, codes.InvalidArgument, status.Code(err), path)
+	}
+}
+
+func TestUserService_JSON(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+	group := client.Group.Create().SetName("managers").SaveX(ctx)
+	attachment := client.Attachment.Create().SaveX(ctx)
+	attachmentID, err := attachment.ID.MarshalBinary()
+	require.NoError(t, err)
+	crmID, err := uuid.New().MarshalBinary()
+	require.NoError(t, err)
+	metadata, err := structpb.NewStruct(map[string]interface{}{"source": "import", "version": 2})
+	require.NoError(t, err)
+	preferences, err := structpb.NewValue(map[string]interface{}{"theme": "dark", "notifications": true})
+	require.NoError(t, err)
+
+	created, err := svc.Create(ctx, &CreateUserRequest{
+		User: &User{
+			UserName:    "rotemtam",
+			Joined:      timestamppb.Now(),
+			Status:      User_ACTIVE,
+			CrmId:       crmID,
+			Group:       &Group{Id: int32(group.ID)},
+			Attachment:  &Attachment{Id: attachmentID},
+			Labels:      []string{"admin", "beta"},
+			Scores:      []int32{1, 2, 3},
+			Metadata:    metadata,
+			Preferences: preferences,
+			Address:     &geo.Address{Street: "Rothschild Blvd 1", City: "Tel Aviv", CountryCode: "IL"},
+		},
+	})
+	require.NoError(t, err)
+	fromDB := client.User.GetX(ctx, int(created.Id))
+	require.EqualValues(t, []string{"admin", "beta"}, fromDB.Labels)
+	require.EqualValues(t, []int{1, 2, 3}, fromDB.Scores)
+	require.EqualValues(t, map[string]interface{}{"source": "import", "version": float64(2)}, fromDB.Metadata)
+	require.EqualValues(t, schema.Preferences{Theme: "dark", Notifications: true}, fromDB.Preferences)
+	require.EqualValues(t, &schema.Address{Street: "Rothschild Blvd 1", City: "Tel Aviv", CountryCode: "IL"}, fromDB.Address)
+
+	got, err := svc.Get(ctx, &GetUserRequest{Id: created.Id})
+	require.NoError(t, err)
+	require.EqualValues(t, []string{"admin", "beta"}, got.Labels)
+	require.EqualValues(t, []int32{1, 2, 3}, got.Scores)
+	require.EqualValues(t, "import", got.GetMetadata().GetFields()["source"].GetStringValue())
+	require.EqualValues(t, "dark", got.GetPreferences().GetStructValue().GetFields()["theme"].GetStringValue())
+	require.EqualValues(t, "IL", got.GetAddress().GetCountryCode())
+	require.EqualValues(t, "Tel Aviv", got.GetAddress().GetCity())
+
+	// Invalid JSON values are rejected.
+	_, err = svc.Update(ctx, &UpdateUserRequest{
+		User:       &User{Id: created.Id, Preferences: structpb.NewStringValue("dark")},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"preferences"}},
+	})
+	require.EqualValues(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = svc.Update(ctx, &UpdateUserRequest{
+		User:       &User{Id: created.Id},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"labels", "metadata", "address"}},
+	})
+	require.NoError(t, err)
+	fromDB = client.User.GetX(ctx, int(created.Id))
+	require.Empty(t, fromDB.Labels)
+	require.Empty(t, fromDB.Metadata)
+	require.Nil(t, fromDB.Address)
+	require.EqualValues(t, []int{1, 2, 3}, fromDB.Scores)
+}
+
+func TestUserService_Converter(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+	group := client.Group.Create().SetName("managers").SaveX(ctx)
+	attachment := client.Attachment.Create().SaveX(ctx)
+	attachmentID, err := attachment.ID.MarshalBinary()
+	require.NoError(t, err)
+	crmID, err := uuid.New().MarshalBinary()
+	require.NoError(t, err)
+
+	created, err := svc.Create(ctx, &CreateUserRequest{
+		User: &User{
+			UserName:       "rotemtam",
+			Joined:         timestamppb.Now(),
+			Status:         User_ACTIVE,
+			CrmId:          crmID,
+			Group:          &Group{Id: int32(group.ID)},
+			Attachment:     &Attachment{Id: attachmentID},
+			SessionTimeout: durationpb.New(90 * time.Second),
+			Price:          "10.25",
+		},
+	})
+	require.NoError(t, err)
+	fromDB := client.User.GetX(ctx, int(created.Id))
+	require.EqualValues(t, 90*time.Second, fromDB.SessionTimeout)
+	require.EqualValues(t, "41/4", fromDB.Price.String())
+
+	got, err := svc.Get(ctx, &GetUserRequest{Id: created.Id})
+	require.NoError(t, err)
+	require.EqualValues(t, 90*time.Second, got.GetSessionTimeout().AsDuration())
+	require.EqualValues(t, "10.2500", got.GetPrice())
+
+	_, err = svc.Update(ctx, &UpdateUserRequest{
+		User:       &User{Id: created.Id, Price: "ten"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"price"}},
+	})
+	require.EqualValues(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUserService_List(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+	for i, points := range []uint{30, 10, 50, 20, 40} {
+		st := user.StatusPending
+		if i%2 == 0 {
+			st = user.StatusActive
+		}
+		client.User.Create().
+			SetUserName(fmt.Sprintf("user%d", i)).
+			SetJoined(time.Now()).
+			SetPoints(points).
+			SetExp(1000).
+			SetStatus(st).
+			SetExternalID(i).
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SaveX(ctx)
+	}
+	listAll := func(req *ListUserRequest) []uint32 {
+		var points []uint32
+		for {
+			resp, err := svc.List(ctx, req)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(resp.Users), int(req.PageSize))
+			for _, u := range resp.Users {
+				points = append(points, u.Points)
+			}
+			if resp.NextPageToken == "" {
+				return points
+			}
+			req.PageToken = resp.NextPageToken
+		}
+	}
+	require.Equal(t, []uint32{30, 10, 50, 20, 40}, listAll(&ListUserRequest{PageSize: 2}))
+	require.Equal(t, []uint32{10, 20, 30, 40, 50}, listAll(&ListUserRequest{PageSize: 2, OrderBy: "points"}))
+	require.Equal(t, []uint32{50, 40, 30, 20, 10}, listAll(&ListUserRequest{PageSize: 3, OrderBy: "points desc"}))
+	require.Equal(t, []uint32{50, 40, 30}, listAll(&ListUserRequest{
+		PageSize: 1,
+		OrderBy:  "points desc",
+		Filter: &ListUserRequest_Filter{
+			Status: []User_Status{User_ACTIVE},
+		},
+	}))
+	require.Equal(t, []uint32{20, 10}, listAll(&ListUserRequest{
+		PageSize: 1,
+		OrderBy:  "external_id desc",
+		Filter: &ListUserRequest_Filter{
+			UserName: []string{"user1", "user3"},
+		},
+	}))
+
+	resp, err := svc.List(ctx, &ListUserRequest{View: ListUserRequest_WITH_EDGE_IDS})
+	require.NoError(t, err)
+	require.Len(t, resp.Users, 5)
+	require.Empty(t, resp.NextPageToken)
+
+	resp, err = svc.List(ctx, &ListUserRequest{PageSize: 2, OrderBy: "points"})
+	require.NoError(t, err)
+	for _, req := range []*ListUserRequest{
+		{PageSize: -1},
+		{OrderBy: "unknown"},
+		{OrderBy: "points up"},
+		{PageToken: "invalid"},
+		{PageToken: resp.NextPageToken, OrderBy: "points desc"},
+		{View: ListUserRequest_View(-1)},
+	} {
+		_, err := svc.List(ctx, req)
+		respStatus, ok := status.FromError(err)
+		require.True(t, ok, "expected a gRPC status error")
+		require.EqualValues(t, codes.InvalidArgument, respStatus.Code())
+	}
+}
+
+func TestUserService_BatchCreate(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+	group := client.Group.Create().SetName("managers").SaveX(ctx)
+	newRequest := func(userName string, externalID int32) *CreateUserRequest {
+		crmID, err := uuid.New().MarshalBinary()
+		require.NoError(t, err)
+		attachmentID, err := client.Attachment.Create().SaveX(ctx).ID.MarshalBinary()
+		require.NoError(t, err)
+		return &CreateUserRequest{
+			User: &User{
+				UserName:   userName,
+				Joined:     timestamppb.Now(),
+				Status:     User_ACTIVE,
+				ExternalId: externalID,
+				Group:      &Group{Id: int32(group.ID)},
+				CrmId:      crmID,
+				Attachment: &Attachment{Id: attachmentID},
+			},
+		}
+	}
+
+	resp, err := svc.BatchCreate(ctx, &BatchCreateUsersRequest{
+		Requests: []*CreateUserRequest{newRequest("a8m", 1), newRequest("rotemtam", 2), newRequest("a8m", 3)},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+	require.EqualValues(t, codes.OK, resp.Results[0].Code)
+	require.Equal(t, "a8m", resp.Results[0].User.UserName)
+	require.EqualValues(t, codes.OK, resp.Results[1].Code)
+	require.Equal(t, "rotemtam", resp.Results[1].User.UserName)
+	require.EqualValues(t, codes.AlreadyExists, resp.Results[2].Code)
+	require.Nil(t, resp.Results[2].User)
+	require.Equal(t, 2, client.User.Query().CountX(ctx))
+
+	invalid := newRequest("noam", 4)
+	invalid.User.CrmId = []byte("invalid")
+	_, err = svc.BatchCreate(ctx, &BatchCreateUsersRequest{
+		Requests: []*CreateUserRequest{newRequest("ariel", 6), invalid},
+		Atomic:   true,
+	})
+	respStatus, ok := status.FromError(err)
+	require.True(t, ok, "expected a gRPC status error")
+	require.EqualValues(t, codes.InvalidArgument, respStatus.Code())
+	require.Contains(t, respStatus.Message(), "item 1")
+	require.Equal(t, 2, client.User.Query().CountX(ctx))
+
+	resp, err = svc.BatchCreate(ctx, &BatchCreateUsersRequest{
+		Requests: []*CreateUserRequest{newRequest("noam", 4), newRequest("ariel", 6)},
+		Atomic:   true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 2)
+	for _, r := range resp.Results {
+		require.EqualValues(t, codes.OK, r.Code)
+		require.NotZero(t, r.User.Id)
+	}
+	require.Equal(t, 4, client.User.Query().CountX(ctx))
+}
+
+func TestUserService_BatchGet(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+	group := client.Group.Create().SetName("managers").SaveX(ctx)
+	var ids []int32
+	for i, name := range []string{"a8m", "rotemtam"} {
+		created := client.User.Create().
+			SetUserName(name).
+			SetJoined(time.Now()).
+			SetPoints(10).
+			SetExp(1000).
+			SetStatus("pending").
+			SetExternalID(i).
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetGroup(group).
+			SaveX(ctx)
+		ids = append(ids, int32(created.ID))
+	}
+
+	resp, err := svc.BatchGet(ctx, &BatchGetUsersRequest{
+		Ids:  []int32{ids[1], 1000, ids[0]},
+		View: BatchGetUsersRequest_WITH_EDGE_IDS,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+	require.Equal(t, "rotemtam", resp.Results[0].User.UserName)
+	require.EqualValues(t, group.ID, resp.Results[0].User.Group.Id)
+	require.EqualValues(t, codes.NotFound, resp.Results[1].Code)
+	require.Nil(t, resp.Results[1].User)
+	require.Equal(t, "a8m", resp.Results[2].User.UserName)
+
+	_, err = svc.BatchGet(ctx, &BatchGetUsersRequest{Ids: make([]int32, runtime.MaxBatchSize+1)})
+	respStatus, ok := status.FromError(err)
+	require.True(t, ok, "expected a gRPC status error")
+	require.EqualValues(t, codes.InvalidArgument, respStatus.Code())
+}
+
+func TestUserService_BatchDelete(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+	var ids []int32
+	for i := 0; i < 4; i++ {
+		created := client.User.Create().
+			SetUserName(fmt.Sprintf("user%d", i)).
+			SetJoined(time.Now()).
+			SetPoints(10).
+			SetExp(1000).
+			SetStatus("pending").
+			SetExternalID(i).
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SaveX(ctx)
+		ids = append(ids, int32(created.ID))
+	}
+
+	resp, err := svc.BatchDelete(ctx, &BatchDeleteUsersRequest{
+		Ids: []int32{ids[0], 1000},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 2)
+	require.EqualValues(t, ids[0], resp.Results[0].Id)
+	require.EqualValues(t, codes.OK, resp.Results[0].Code)
+	require.EqualValues(t, 1000, resp.Results[1].Id)
+	require.EqualValues(t, codes.NotFound, resp.Results[1].Code)
+	require.Equal(t, 3, client.User.Query().CountX(ctx))
+
+	_, err = svc.BatchDelete(ctx, &BatchDeleteUsersRequest{
+		Ids:    []int32{ids[1], 1000},
+		Atomic: true,
+	})
+	respStatus, ok := status.FromError(err)
+	require.True(t, ok, "expected a gRPC status error")
+	require.EqualValues(t, codes.NotFound, respStatus.Code())
+	require.Equal(t, 3, client.User.Query().CountX(ctx))
+
+	resp, err = svc.BatchDelete(ctx, &BatchDeleteUsersRequest{
+		Ids:    []int32{ids[1], ids[2], ids[1]},
+		Atomic: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+	require.Equal(t, 1, client.User.Query().CountX(ctx))
+}
+
+func TestUserService_HTTP(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	srv := httptest.NewServer(NewUserServiceHTTPHandler(NewUserService(client)))
+	defer srv.Close()
+	ctx := context.Background()
+	group := client.Group.Create().SetName("managers").SaveX(ctx)
+	attachment := client.Attachment.Create().SaveX(ctx)
+	attachmentID, err := attachment.ID.MarshalBinary()
+	require.NoError(t, err)
+	crmID, err := uuid.New().MarshalBinary()
+	require.NoError(t, err)
+
+	do := func(method, path string, body proto.Message, res proto.Message) int {
+		var r io.Reader
+		if body != nil {
+			b, err := protojson.Marshal(body)
+			require.NoError(t, err)
+			r = bytes.NewReader(b)
+		}
+		req, err := http.NewRequest(method, srv.URL+path, r)
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+		if res != nil {
+			require.NoError(t, protojson.Unmarshal(b, res))
+		}
+		return resp.StatusCode
+	}
+
+	created := &User{}
+	code := do("POST", "/v1/users", &User{
+		UserName:   "rotemtam",
+		Joined:     timestamppb.Now(),
+		Status:     User_ACTIVE,
+		CrmId:      crmID,
+		Group:      &Group{Id: int32(group.ID)},
+		Attachment: &Attachment{Id: attachmentID},
+	}, created)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "rotemtam", client.User.GetX(ctx, int(created.Id)).UserName)
+
+	got := &User{}
+	code = do("GET", fmt.Sprintf("/v1/users/%d?view=WITH_EDGE_IDS", created.Id), nil, got)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "rotemtam", got.UserName)
+	require.EqualValues(t, group.ID, got.Group.Id)
+
+	code = do("PATCH", fmt.Sprintf("/v1/users/%d?update_mask=user_name", created.Id), &User{UserName: "a8m", Exp: 10}, got)
+	require.Equal(t, http.StatusOK, code)
+	fromDB := client.User.GetX(ctx, int(created.Id))
+	require.Equal(t, "a8m", fromDB.UserName)
+	require.Zero(t, fromDB.Exp)
+
+	list := &ListUserResponse{}
+	code = do("GET", "/v1/users?page_size=1&filter.user_name=a8m", nil, list)
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, list.Users, 1)
+
+	st := &spb.Status{}
+	code = do("POST", "/v1/users:batchGet", nil, st)
+	require.Equal(t, http.StatusMethodNotAllowed, code)
+	code = do("GET", "/v1/users/1000", nil, st)
+	require.Equal(t, http.StatusNotFound, code)
+	require.EqualValues(t, codes.NotFound, st.Code)
+	code = do("GET", "/v1/users/abc", nil, st)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.EqualValues(t, codes.InvalidArgument, st.Code)
+	code = do("POST", "/v1/users", &User{UserName: strings.Repeat("a", runtime.MaxHTTPBodySize)}, st)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.EqualValues(t, codes.InvalidArgument, st.Code)
+
+	code = do("DELETE", fmt.Sprintf("/v1/users/%d", created.Id), nil, nil)
+	require.Equal(t, http.StatusOK, code)
+	require.Zero(t, client.User.Query().CountX(ctx))
+}
@@ -48,5 +48,10 @@ func (Group) Edges() []ent.Edge {
 func (Group) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entproto.Message(),
+		entproto.Service(
+			entproto.Methods(entproto.MethodGet|entproto.MethodList),
+			entproto.ServiceName("GroupReader"),
+			entproto.ServicePackage("entpb.reader"),
+		),
 	}
 }
@@ -39,12 +39,19 @@ func (NilExample) Fields() []ent.Field {
 			Annotations(
 				entproto.Field(3),
 			),
+		field.Int("int_opt").
+			Optional().
+			Annotations(
+				entproto.Field(4),
+			),
 	}
 }
 
 func (NilExample) Annotations() []schema.Annotation {
 	return []schema.Annotation{
-		entproto.Message(),
+		entproto.Message(
+			entproto.Proto3Optional(),
+		),
 		entproto.Service(),
 	}
 }
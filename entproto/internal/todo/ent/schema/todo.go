@@ -30,6 +30,7 @@ type Todo struct {
 func (Todo) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entproto.Message(),
+		entproto.Service(),
 	}
 }
 
@@ -57,6 +58,7 @@ func (Todo) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("user", User.Type).
 			Unique().
+			Required().
 			Annotations(entproto.Field(4)),
 	}
 }
@@ -20,14 +20,17 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // User holds the schema definition for the User entity.
@@ -38,7 +41,10 @@ type User struct {
 func (User) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entproto.Message(),
-		entproto.Service(),
+		entproto.Service(
+			entproto.BatchMethods(),
+			entproto.HTTP("/v1/users"),
+		),
 	}
 }
 
@@ -105,6 +111,40 @@ func (User) Fields() []ent.Field {
 		field.Float("account_balance").
 			Default(0.0).
 			Annotations(entproto.Field(20)),
+		field.Strings("labels").
+			Optional().
+			Annotations(entproto.Field(21)),
+		field.Ints("scores").
+			Optional().
+			Annotations(entproto.Field(22)),
+		field.JSON("metadata", map[string]interface{}{}).
+			Optional().
+			Annotations(entproto.Field(23)),
+		field.JSON("preferences", Preferences{}).
+			Optional().
+			Annotations(entproto.Field(24)),
+		field.Int64("session_timeout").
+			GoType(time.Duration(0)).
+			Optional().
+			Annotations(entproto.Field(25,
+				entproto.Converter(durationpb.New, DurationFromProto),
+			)),
+		field.Other("price", Price{}).
+			SchemaType(map[string]string{
+				dialect.MySQL:    "decimal(20,4)",
+				dialect.Postgres: "numeric",
+				dialect.SQLite:   "text",
+			}).
+			Optional().
+			Annotations(entproto.Field(26,
+				entproto.Converter(PriceToProto, PriceFromProto),
+			)),
+		field.JSON("address", &Address{}).
+			Optional().
+			Annotations(entproto.Field(27,
+				entproto.Type(descriptorpb.FieldDescriptorProto_TYPE_MESSAGE),
+				entproto.TypeName("geo.Address"),
+			)),
 	}
 }
 
@@ -126,6 +166,73 @@ func (User) Edges() []ent.Edge {
 	}
 }
 
+// Preferences is stored in the JSON "preferences" field.
+type Preferences struct {
+	Theme         string `json:"theme,omitempty"`
+	Notifications bool   `json:"notifications,omitempty"`
+}
+
+// Address is stored in the JSON "address" field, and mapped to the geo.Address message.
+type Address struct {
+	Street      string `json:"street,omitempty"`
+	City        string `json:"city,omitempty"`
+	CountryCode string `json:"country_code,omitempty"`
+}
+
+// DurationFromProto converts the "session_timeout" field from its proto type.
+func DurationFromProto(d *durationpb.Duration) time.Duration {
+	return d.AsDuration()
+}
+
+// Price is a decimal amount, stored in the "price" field.
+type Price struct {
+	*big.Rat
+}
+
+// PriceToProto converts the "price" field to its proto type.
+func PriceToProto(p Price) string {
+	if p.Rat == nil {
+		return ""
+	}
+	return p.FloatString(4)
+}
+
+// PriceFromProto converts the "price" field from its proto type.
+func PriceFromProto(s string) (Price, error) {
+	if s == "" {
+		return Price{}, nil
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Price{}, fmt.Errorf("invalid price %q", s)
+	}
+	return Price{Rat: r}, nil
+}
+
+func (p *Price) Scan(src interface{}) error {
+	var s sql.NullString
+	if err := s.Scan(src); err != nil {
+		return err
+	}
+	if !s.Valid {
+		p.Rat = nil
+		return nil
+	}
+	v, err := PriceFromProto(s.String)
+	if err != nil {
+		return err
+	}
+	*p = v
+	return nil
+}
+
+func (p Price) Value() (driver.Value, error) {
+	if p.Rat == nil {
+		return nil, nil
+	}
+	return PriceToProto(p), nil
+}
+
 type BigInt struct {
 	*big.Int
 }
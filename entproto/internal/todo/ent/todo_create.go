@@ -46,14 +46,6 @@ func (tc *TodoCreate) SetUserID(id int) *TodoCreate {
 	return tc
 }
 
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (tc *TodoCreate) SetNillableUserID(id *int) *TodoCreate {
-	if id != nil {
-		tc = tc.SetUserID(*id)
-	}
-	return tc
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (tc *TodoCreate) SetUser(u *User) *TodoCreate {
 	return tc.SetUserID(u.ID)
@@ -149,6 +141,9 @@ func (tc *TodoCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "status": %w`, err)}
 		}
 	}
+	if _, ok := tc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New("ent: missing required edge \"user\"")}
+	}
 	return nil
 }
 
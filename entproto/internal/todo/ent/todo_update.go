@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"entgo.io/contrib/entproto/internal/todo/ent/predicate"
@@ -53,14 +54,6 @@ func (tu *TodoUpdate) SetUserID(id int) *TodoUpdate {
 	return tu
 }
 
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (tu *TodoUpdate) SetNillableUserID(id *int) *TodoUpdate {
-	if id != nil {
-		tu = tu.SetUserID(*id)
-	}
-	return tu
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (tu *TodoUpdate) SetUser(u *User) *TodoUpdate {
 	return tu.SetUserID(u.ID)
@@ -144,6 +137,9 @@ func (tu *TodoUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf("ent: validator failed for field \"status\": %w", err)}
 		}
 	}
+	if _, ok := tu.mutation.UserID(); tu.mutation.UserCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"user\"")
+	}
 	return nil
 }
 
@@ -259,14 +255,6 @@ func (tuo *TodoUpdateOne) SetUserID(id int) *TodoUpdateOne {
 	return tuo
 }
 
-// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
-func (tuo *TodoUpdateOne) SetNillableUserID(id *int) *TodoUpdateOne {
-	if id != nil {
-		tuo = tuo.SetUserID(*id)
-	}
-	return tuo
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (tuo *TodoUpdateOne) SetUser(u *User) *TodoUpdateOne {
 	return tuo.SetUserID(u.ID)
@@ -357,6 +345,9 @@ func (tuo *TodoUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf("ent: validator failed for field \"status\": %w", err)}
 		}
 	}
+	if _, ok := tuo.mutation.UserID(); tuo.mutation.UserCleared() && !ok {
+		return errors.New("ent: clearing a required unique edge \"user\"")
+	}
 	return nil
 }
 
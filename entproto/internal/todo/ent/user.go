@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -52,6 +53,20 @@ type User struct {
 	HeightInCm float32 `json:"height_in_cm,omitempty"`
 	// AccountBalance holds the value of the "account_balance" field.
 	AccountBalance float64 `json:"account_balance,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels []string `json:"labels,omitempty"`
+	// Scores holds the value of the "scores" field.
+	Scores []int `json:"scores,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Preferences holds the value of the "preferences" field.
+	Preferences schema.Preferences `json:"preferences,omitempty"`
+	// SessionTimeout holds the value of the "session_timeout" field.
+	SessionTimeout time.Duration `json:"session_timeout,omitempty"`
+	// Price holds the value of the "price" field.
+	Price schema.Price `json:"price,omitempty"`
+	// Address holds the value of the "address" field.
+	Address *schema.Address `json:"address,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges      UserEdges `json:"edges"`
@@ -113,13 +128,17 @@ func (*User) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case user.FieldLabels, user.FieldScores, user.FieldMetadata, user.FieldPreferences, user.FieldAddress:
+			values[i] = new([]byte)
 		case user.FieldBigInt:
 			values[i] = new(schema.BigInt)
+		case user.FieldPrice:
+			values[i] = new(schema.Price)
 		case user.FieldBanned, user.FieldOptBool:
 			values[i] = new(sql.NullBool)
 		case user.FieldHeightInCm, user.FieldAccountBalance:
 			values[i] = new(sql.NullFloat64)
-		case user.FieldID, user.FieldPoints, user.FieldExp, user.FieldExternalID, user.FieldCustomPb, user.FieldOptNum, user.FieldBUser1:
+		case user.FieldID, user.FieldPoints, user.FieldExp, user.FieldExternalID, user.FieldCustomPb, user.FieldOptNum, user.FieldBUser1, user.FieldSessionTimeout:
 			values[i] = new(sql.NullInt64)
 		case user.FieldUserName, user.FieldStatus, user.FieldOptStr:
 			values[i] = new(sql.NullString)
@@ -246,6 +265,58 @@ func (u *User) assignValues(columns []string, values []interface{}) error {
 			} else if value.Valid {
 				u.AccountBalance = value.Float64
 			}
+		case user.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
+		case user.FieldScores:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scores", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Scores); err != nil {
+					return fmt.Errorf("unmarshal field scores: %w", err)
+				}
+			}
+		case user.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		case user.FieldPreferences:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field preferences", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Preferences); err != nil {
+					return fmt.Errorf("unmarshal field preferences: %w", err)
+				}
+			}
+		case user.FieldSessionTimeout:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field session_timeout", values[i])
+			} else if value.Valid {
+				u.SessionTimeout = time.Duration(value.Int64)
+			}
+		case user.FieldPrice:
+			if value, ok := values[i].(*schema.Price); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value != nil {
+				u.Price = *value
+			}
+		case user.FieldAddress:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Address); err != nil {
+					return fmt.Errorf("unmarshal field address: %w", err)
+				}
+			}
 		case user.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_group", value)
@@ -328,6 +399,20 @@ func (u *User) String() string {
 	builder.WriteString(fmt.Sprintf("%v", u.HeightInCm))
 	builder.WriteString(", account_balance=")
 	builder.WriteString(fmt.Sprintf("%v", u.AccountBalance))
+	builder.WriteString(", labels=")
+	builder.WriteString(fmt.Sprintf("%v", u.Labels))
+	builder.WriteString(", scores=")
+	builder.WriteString(fmt.Sprintf("%v", u.Scores))
+	builder.WriteString(", metadata=")
+	builder.WriteString(fmt.Sprintf("%v", u.Metadata))
+	builder.WriteString(", preferences=")
+	builder.WriteString(fmt.Sprintf("%v", u.Preferences))
+	builder.WriteString(", session_timeout=")
+	builder.WriteString(fmt.Sprintf("%v", u.SessionTimeout))
+	builder.WriteString(", price=")
+	builder.WriteString(fmt.Sprintf("%v", u.Price))
+	builder.WriteString(", address=")
+	builder.WriteString(fmt.Sprintf("%v", u.Address))
 	builder.WriteByte(')')
 	return builder.String()
 }
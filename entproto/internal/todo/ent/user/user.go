@@ -43,6 +43,20 @@ const (
 	FieldHeightInCm = "height_in_cm"
 	// FieldAccountBalance holds the string denoting the account_balance field in the database.
 	FieldAccountBalance = "account_balance"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
+	// FieldScores holds the string denoting the scores field in the database.
+	FieldScores = "scores"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// FieldPreferences holds the string denoting the preferences field in the database.
+	FieldPreferences = "preferences"
+	// FieldSessionTimeout holds the string denoting the session_timeout field in the database.
+	FieldSessionTimeout = "session_timeout"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldAddress holds the string denoting the address field in the database.
+	FieldAddress = "address"
 	// EdgeGroup holds the string denoting the group edge name in mutations.
 	EdgeGroup = "group"
 	// EdgeAttachment holds the string denoting the attachment edge name in mutations.
@@ -91,6 +105,13 @@ var Columns = []string{
 	FieldBUser1,
 	FieldHeightInCm,
 	FieldAccountBalance,
+	FieldLabels,
+	FieldScores,
+	FieldMetadata,
+	FieldPreferences,
+	FieldSessionTimeout,
+	FieldPrice,
+	FieldAddress,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "users"
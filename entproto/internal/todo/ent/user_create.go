@@ -184,6 +184,72 @@ func (uc *UserCreate) SetNillableAccountBalance(f *float64) *UserCreate {
 	return uc
 }
 
+// SetLabels sets the "labels" field.
+func (uc *UserCreate) SetLabels(s []string) *UserCreate {
+	uc.mutation.SetLabels(s)
+	return uc
+}
+
+// SetScores sets the "scores" field.
+func (uc *UserCreate) SetScores(i []int) *UserCreate {
+	uc.mutation.SetScores(i)
+	return uc
+}
+
+// SetMetadata sets the "metadata" field.
+func (uc *UserCreate) SetMetadata(m map[string]interface{}) *UserCreate {
+	uc.mutation.SetMetadata(m)
+	return uc
+}
+
+// SetPreferences sets the "preferences" field.
+func (uc *UserCreate) SetPreferences(s schema.Preferences) *UserCreate {
+	uc.mutation.SetPreferences(s)
+	return uc
+}
+
+// SetNillablePreferences sets the "preferences" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePreferences(s *schema.Preferences) *UserCreate {
+	if s != nil {
+		uc.SetPreferences(*s)
+	}
+	return uc
+}
+
+// SetSessionTimeout sets the "session_timeout" field.
+func (uc *UserCreate) SetSessionTimeout(t time.Duration) *UserCreate {
+	uc.mutation.SetSessionTimeout(t)
+	return uc
+}
+
+// SetNillableSessionTimeout sets the "session_timeout" field if the given value is not nil.
+func (uc *UserCreate) SetNillableSessionTimeout(t *time.Duration) *UserCreate {
+	if t != nil {
+		uc.SetSessionTimeout(*t)
+	}
+	return uc
+}
+
+// SetPrice sets the "price" field.
+func (uc *UserCreate) SetPrice(s schema.Price) *UserCreate {
+	uc.mutation.SetPrice(s)
+	return uc
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (uc *UserCreate) SetNillablePrice(s *schema.Price) *UserCreate {
+	if s != nil {
+		uc.SetPrice(*s)
+	}
+	return uc
+}
+
+// SetAddress sets the "address" field.
+func (uc *UserCreate) SetAddress(s *schema.Address) *UserCreate {
+	uc.mutation.SetAddress(s)
+	return uc
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (uc *UserCreate) SetGroupID(id int) *UserCreate {
 	uc.mutation.SetGroupID(id)
@@ -517,6 +583,62 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		})
 		_node.AccountBalance = value
 	}
+	if value, ok := uc.mutation.Labels(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldLabels,
+		})
+		_node.Labels = value
+	}
+	if value, ok := uc.mutation.Scores(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldScores,
+		})
+		_node.Scores = value
+	}
+	if value, ok := uc.mutation.Metadata(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldMetadata,
+		})
+		_node.Metadata = value
+	}
+	if value, ok := uc.mutation.Preferences(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldPreferences,
+		})
+		_node.Preferences = value
+	}
+	if value, ok := uc.mutation.SessionTimeout(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldSessionTimeout,
+		})
+		_node.SessionTimeout = value
+	}
+	if value, ok := uc.mutation.Price(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Value:  value,
+			Column: user.FieldPrice,
+		})
+		_node.Price = value
+	}
+	if value, ok := uc.mutation.Address(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldAddress,
+		})
+		_node.Address = value
+	}
 	if nodes := uc.mutation.GroupIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"entgo.io/contrib/entproto/internal/todo/ent/attachment"
 	"entgo.io/contrib/entproto/internal/todo/ent/group"
@@ -270,6 +271,121 @@ func (uu *UserUpdate) AddAccountBalance(f float64) *UserUpdate {
 	return uu
 }
 
+// SetLabels sets the "labels" field.
+func (uu *UserUpdate) SetLabels(s []string) *UserUpdate {
+	uu.mutation.SetLabels(s)
+	return uu
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (uu *UserUpdate) ClearLabels() *UserUpdate {
+	uu.mutation.ClearLabels()
+	return uu
+}
+
+// SetScores sets the "scores" field.
+func (uu *UserUpdate) SetScores(i []int) *UserUpdate {
+	uu.mutation.SetScores(i)
+	return uu
+}
+
+// ClearScores clears the value of the "scores" field.
+func (uu *UserUpdate) ClearScores() *UserUpdate {
+	uu.mutation.ClearScores()
+	return uu
+}
+
+// SetMetadata sets the "metadata" field.
+func (uu *UserUpdate) SetMetadata(m map[string]interface{}) *UserUpdate {
+	uu.mutation.SetMetadata(m)
+	return uu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (uu *UserUpdate) ClearMetadata() *UserUpdate {
+	uu.mutation.ClearMetadata()
+	return uu
+}
+
+// SetPreferences sets the "preferences" field.
+func (uu *UserUpdate) SetPreferences(s schema.Preferences) *UserUpdate {
+	uu.mutation.SetPreferences(s)
+	return uu
+}
+
+// SetNillablePreferences sets the "preferences" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePreferences(s *schema.Preferences) *UserUpdate {
+	if s != nil {
+		uu.SetPreferences(*s)
+	}
+	return uu
+}
+
+// ClearPreferences clears the value of the "preferences" field.
+func (uu *UserUpdate) ClearPreferences() *UserUpdate {
+	uu.mutation.ClearPreferences()
+	return uu
+}
+
+// SetSessionTimeout sets the "session_timeout" field.
+func (uu *UserUpdate) SetSessionTimeout(t time.Duration) *UserUpdate {
+	uu.mutation.ResetSessionTimeout()
+	uu.mutation.SetSessionTimeout(t)
+	return uu
+}
+
+// SetNillableSessionTimeout sets the "session_timeout" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableSessionTimeout(t *time.Duration) *UserUpdate {
+	if t != nil {
+		uu.SetSessionTimeout(*t)
+	}
+	return uu
+}
+
+// AddSessionTimeout adds t to the "session_timeout" field.
+func (uu *UserUpdate) AddSessionTimeout(t time.Duration) *UserUpdate {
+	uu.mutation.AddSessionTimeout(t)
+	return uu
+}
+
+// ClearSessionTimeout clears the value of the "session_timeout" field.
+func (uu *UserUpdate) ClearSessionTimeout() *UserUpdate {
+	uu.mutation.ClearSessionTimeout()
+	return uu
+}
+
+// SetPrice sets the "price" field.
+func (uu *UserUpdate) SetPrice(s schema.Price) *UserUpdate {
+	uu.mutation.SetPrice(s)
+	return uu
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePrice(s *schema.Price) *UserUpdate {
+	if s != nil {
+		uu.SetPrice(*s)
+	}
+	return uu
+}
+
+// ClearPrice clears the value of the "price" field.
+func (uu *UserUpdate) ClearPrice() *UserUpdate {
+	uu.mutation.ClearPrice()
+	return uu
+}
+
+// SetAddress sets the "address" field.
+func (uu *UserUpdate) SetAddress(s *schema.Address) *UserUpdate {
+	uu.mutation.SetAddress(s)
+	return uu
+}
+
+// ClearAddress clears the value of the "address" field.
+func (uu *UserUpdate) ClearAddress() *UserUpdate {
+	uu.mutation.ClearAddress()
+	return uu
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (uu *UserUpdate) SetGroupID(id int) *UserUpdate {
 	uu.mutation.SetGroupID(id)
@@ -640,6 +756,104 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldAccountBalance,
 		})
 	}
+	if value, ok := uu.mutation.Labels(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldLabels,
+		})
+	}
+	if uu.mutation.LabelsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldLabels,
+		})
+	}
+	if value, ok := uu.mutation.Scores(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldScores,
+		})
+	}
+	if uu.mutation.ScoresCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldScores,
+		})
+	}
+	if value, ok := uu.mutation.Metadata(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldMetadata,
+		})
+	}
+	if uu.mutation.MetadataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldMetadata,
+		})
+	}
+	if value, ok := uu.mutation.Preferences(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldPreferences,
+		})
+	}
+	if uu.mutation.PreferencesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldPreferences,
+		})
+	}
+	if value, ok := uu.mutation.SessionTimeout(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldSessionTimeout,
+		})
+	}
+	if value, ok := uu.mutation.AddedSessionTimeout(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldSessionTimeout,
+		})
+	}
+	if uu.mutation.SessionTimeoutCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: user.FieldSessionTimeout,
+		})
+	}
+	if value, ok := uu.mutation.Price(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Value:  value,
+			Column: user.FieldPrice,
+		})
+	}
+	if uu.mutation.PriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Column: user.FieldPrice,
+		})
+	}
+	if value, ok := uu.mutation.Address(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldAddress,
+		})
+	}
+	if uu.mutation.AddressCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldAddress,
+		})
+	}
 	if uu.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -1023,6 +1237,121 @@ func (uuo *UserUpdateOne) AddAccountBalance(f float64) *UserUpdateOne {
 	return uuo
 }
 
+// SetLabels sets the "labels" field.
+func (uuo *UserUpdateOne) SetLabels(s []string) *UserUpdateOne {
+	uuo.mutation.SetLabels(s)
+	return uuo
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (uuo *UserUpdateOne) ClearLabels() *UserUpdateOne {
+	uuo.mutation.ClearLabels()
+	return uuo
+}
+
+// SetScores sets the "scores" field.
+func (uuo *UserUpdateOne) SetScores(i []int) *UserUpdateOne {
+	uuo.mutation.SetScores(i)
+	return uuo
+}
+
+// ClearScores clears the value of the "scores" field.
+func (uuo *UserUpdateOne) ClearScores() *UserUpdateOne {
+	uuo.mutation.ClearScores()
+	return uuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (uuo *UserUpdateOne) SetMetadata(m map[string]interface{}) *UserUpdateOne {
+	uuo.mutation.SetMetadata(m)
+	return uuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (uuo *UserUpdateOne) ClearMetadata() *UserUpdateOne {
+	uuo.mutation.ClearMetadata()
+	return uuo
+}
+
+// SetPreferences sets the "preferences" field.
+func (uuo *UserUpdateOne) SetPreferences(s schema.Preferences) *UserUpdateOne {
+	uuo.mutation.SetPreferences(s)
+	return uuo
+}
+
+// SetNillablePreferences sets the "preferences" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePreferences(s *schema.Preferences) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPreferences(*s)
+	}
+	return uuo
+}
+
+// ClearPreferences clears the value of the "preferences" field.
+func (uuo *UserUpdateOne) ClearPreferences() *UserUpdateOne {
+	uuo.mutation.ClearPreferences()
+	return uuo
+}
+
+// SetSessionTimeout sets the "session_timeout" field.
+func (uuo *UserUpdateOne) SetSessionTimeout(t time.Duration) *UserUpdateOne {
+	uuo.mutation.ResetSessionTimeout()
+	uuo.mutation.SetSessionTimeout(t)
+	return uuo
+}
+
+// SetNillableSessionTimeout sets the "session_timeout" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSessionTimeout(t *time.Duration) *UserUpdateOne {
+	if t != nil {
+		uuo.SetSessionTimeout(*t)
+	}
+	return uuo
+}
+
+// AddSessionTimeout adds t to the "session_timeout" field.
+func (uuo *UserUpdateOne) AddSessionTimeout(t time.Duration) *UserUpdateOne {
+	uuo.mutation.AddSessionTimeout(t)
+	return uuo
+}
+
+// ClearSessionTimeout clears the value of the "session_timeout" field.
+func (uuo *UserUpdateOne) ClearSessionTimeout() *UserUpdateOne {
+	uuo.mutation.ClearSessionTimeout()
+	return uuo
+}
+
+// SetPrice sets the "price" field.
+func (uuo *UserUpdateOne) SetPrice(s schema.Price) *UserUpdateOne {
+	uuo.mutation.SetPrice(s)
+	return uuo
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePrice(s *schema.Price) *UserUpdateOne {
+	if s != nil {
+		uuo.SetPrice(*s)
+	}
+	return uuo
+}
+
+// ClearPrice clears the value of the "price" field.
+func (uuo *UserUpdateOne) ClearPrice() *UserUpdateOne {
+	uuo.mutation.ClearPrice()
+	return uuo
+}
+
+// SetAddress sets the "address" field.
+func (uuo *UserUpdateOne) SetAddress(s *schema.Address) *UserUpdateOne {
+	uuo.mutation.SetAddress(s)
+	return uuo
+}
+
+// ClearAddress clears the value of the "address" field.
+func (uuo *UserUpdateOne) ClearAddress() *UserUpdateOne {
+	uuo.mutation.ClearAddress()
+	return uuo
+}
+
 // SetGroupID sets the "group" edge to the Group entity by ID.
 func (uuo *UserUpdateOne) SetGroupID(id int) *UserUpdateOne {
 	uuo.mutation.SetGroupID(id)
@@ -1417,6 +1746,104 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldAccountBalance,
 		})
 	}
+	if value, ok := uuo.mutation.Labels(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldLabels,
+		})
+	}
+	if uuo.mutation.LabelsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldLabels,
+		})
+	}
+	if value, ok := uuo.mutation.Scores(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldScores,
+		})
+	}
+	if uuo.mutation.ScoresCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldScores,
+		})
+	}
+	if value, ok := uuo.mutation.Metadata(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldMetadata,
+		})
+	}
+	if uuo.mutation.MetadataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldMetadata,
+		})
+	}
+	if value, ok := uuo.mutation.Preferences(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldPreferences,
+		})
+	}
+	if uuo.mutation.PreferencesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldPreferences,
+		})
+	}
+	if value, ok := uuo.mutation.SessionTimeout(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldSessionTimeout,
+		})
+	}
+	if value, ok := uuo.mutation.AddedSessionTimeout(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: user.FieldSessionTimeout,
+		})
+	}
+	if uuo.mutation.SessionTimeoutCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Column: user.FieldSessionTimeout,
+		})
+	}
+	if value, ok := uuo.mutation.Price(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Value:  value,
+			Column: user.FieldPrice,
+		})
+	}
+	if uuo.mutation.PriceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Column: user.FieldPrice,
+		})
+	}
+	if value, ok := uuo.mutation.Address(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldAddress,
+		})
+	}
+	if uuo.mutation.AddressCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldAddress,
+		})
+	}
 	if uuo.mutation.GroupCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
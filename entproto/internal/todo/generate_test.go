@@ -15,6 +15,7 @@
 package todo
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -22,6 +23,7 @@ import (
 	"testing"
 
 	"entgo.io/contrib/entproto"
+	_ "entgo.io/contrib/entproto/internal/todo/ent/proto/geo" // register the messages of geo.proto
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
 	"github.com/stretchr/testify/require"
@@ -43,3 +45,102 @@ func TestGenerate(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, strings.Contains(string(bytes), "// Code generated by entproto. DO NOT EDIT."))
 }
+
+func TestGenerateLockFile(t *testing.T) {
+	tgt, err := ioutil.TempDir(os.TempDir(), "entproto-test-*")
+	defer os.RemoveAll(tgt)
+	require.NoError(t, err)
+	graph, err := entc.LoadGraph("./ent/schema", &gen.Config{
+		Target: tgt,
+	})
+	require.NoError(t, err)
+	require.NoError(t, entproto.Generate(graph))
+
+	lockPath := filepath.Join(tgt, "proto", entproto.LockFileName)
+	info, err := os.Stat(lockPath)
+	require.NoError(t, err)
+	require.EqualValues(t, 0644, info.Mode().Perm())
+	updateLock := func(f func(user map[string]interface{})) {
+		b, err := ioutil.ReadFile(lockPath)
+		require.NoError(t, err)
+		var lock map[string]map[string]map[string]interface{}
+		require.NoError(t, json.Unmarshal(b, &lock))
+		f(lock["messages"]["entpb.User"])
+		b, err = json.Marshal(lock)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(lockPath, b, 0644))
+	}
+
+	// A field that was removed from the schema is reserved.
+	updateLock(func(user map[string]interface{}) {
+		user["fields"].(map[string]interface{})["nickname"] = map[string]interface{}{"number": 30, "type": "string"}
+	})
+	require.NoError(t, entproto.Generate(graph))
+	contents, err := ioutil.ReadFile(filepath.Join(tgt, "proto", "entpb", "entpb.proto"))
+	require.NoError(t, err)
+	require.Contains(t, string(contents), "reserved 30;")
+	require.Contains(t, string(contents), `reserved "nickname";`)
+	require.NoError(t, entproto.Generate(graph))
+
+	// Reusing the number of a removed field fails.
+	updateLock(func(user map[string]interface{}) {
+		user["reserved"].(map[string]interface{})["nickname"] = map[string]interface{}{"number": 2, "type": "string"}
+	})
+	err = entproto.Generate(graph)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `field "user_name" of message "entpb.User" reuses the number 2 of removed field "nickname"`)
+
+	// A field that takes the number of a removed field with a compatible type is a rename.
+	updateLock(func(user map[string]interface{}) {
+		fields := user["fields"].(map[string]interface{})
+		fields["login"] = fields["user_name"]
+		delete(fields, "user_name")
+		delete(user, "reserved")
+	})
+	require.NoError(t, entproto.Generate(graph))
+	b, err := ioutil.ReadFile(lockPath)
+	require.NoError(t, err)
+	require.NotContains(t, string(b), `"login"`)
+	contents, err = ioutil.ReadFile(filepath.Join(tgt, "proto", "entpb", "entpb.proto"))
+	require.NoError(t, err)
+	require.NotContains(t, string(contents), `reserved "login";`)
+
+	// A rename that changes the type to an incompatible type fails.
+	updateLock(func(user map[string]interface{}) {
+		fields := user["fields"].(map[string]interface{})
+		fields["login"] = map[string]interface{}{"number": 2, "type": "int64"}
+		delete(fields, "user_name")
+	})
+	err = entproto.Generate(graph)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `field "user_name" of message "entpb.User" reuses the number 2 of removed field "login" of type int64; remove the "login" entry of "entpb.User" from entproto.lock`)
+
+	// The entries of messages that were removed from the schema are kept.
+	updateLock(func(user map[string]interface{}) {
+		fields := user["fields"].(map[string]interface{})
+		fields["user_name"] = map[string]interface{}{"number": 2, "type": "string"}
+		delete(fields, "login")
+	})
+	b, err = ioutil.ReadFile(lockPath)
+	require.NoError(t, err)
+	var lock map[string]map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &lock))
+	lock["messages"]["entpb.Comment"] = map[string]interface{}{
+		"fields": map[string]interface{}{"id": map[string]interface{}{"number": 1, "type": "int32"}},
+	}
+	b, err = json.Marshal(lock)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(lockPath, b, 0644))
+	require.NoError(t, entproto.Generate(graph))
+	b, err = ioutil.ReadFile(lockPath)
+	require.NoError(t, err)
+	require.Contains(t, string(b), `"entpb.Comment"`)
+
+	// Changing the type of a field to an incompatible type fails.
+	updateLock(func(user map[string]interface{}) {
+		user["fields"].(map[string]interface{})["exp"] = map[string]interface{}{"number": 5, "type": "string"}
+	})
+	err = entproto.Generate(graph)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `field "exp" of message "entpb.User" changed type from string to uint64`)
+}
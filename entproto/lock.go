@@ -0,0 +1,344 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/builder"
+	"go.uber.org/multierr"
+)
+
+// LockFileName is the name of the lock file written by Generate next to the generated .proto files.
+const LockFileName = "entproto.lock"
+
+type (
+	// lockFile records the field numbers of the generated messages and the value numbers of the generated enums,
+	// keyed by their fully qualified names. Fields and values that were removed from the schema are kept as
+	// reserved, so that their numbers and names are not reused by later changes.
+	lockFile struct {
+		Messages map[string]*lockedMessage `json:"messages"`
+		Enums    map[string]*lockedEnum    `json:"enums"`
+	}
+
+	lockedMessage struct {
+		Fields   map[string]*lockedField `json:"fields"`
+		Reserved map[string]*lockedField `json:"reserved,omitempty"`
+	}
+
+	lockedField struct {
+		Number int32 `json:"number"`
+		// Type is the lowercase protobuf type of the field, e.g. "string" or "message".
+		Type string `json:"type"`
+		// TypeName is the fully qualified name of message fields.
+		TypeName string `json:"type_name,omitempty"`
+		Repeated bool   `json:"repeated,omitempty"`
+	}
+
+	lockedEnum struct {
+		Values   map[string]int32 `json:"values"`
+		Reserved map[string]int32 `json:"reserved,omitempty"`
+	}
+)
+
+// readLockFile reads the lock file at path. An empty lock is returned if the file does not exist.
+func readLockFile(path string) (*lockFile, error) {
+	lock := &lockFile{
+		Messages: make(map[string]*lockedMessage),
+		Enums:    make(map[string]*lockedEnum),
+	}
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return lock, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, lock); err != nil {
+		return nil, fmt.Errorf("entproto: invalid lock file %q: %w", path, err)
+	}
+	return lock, nil
+}
+
+// write writes the lock to path.
+func (l *lockFile) write(path string) error {
+	b, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(b, '\n'), 0644)
+}
+
+// apply verifies that the messages and enums of the given files do not reuse or change the numbers recorded
+// in the lock, and returns the files with the fields and values removed since then declared as reserved.
+// The lock is updated to match the returned files. The entries of messages and enums that are no longer
+// generated are kept, so that their numbers are verified if they are generated again.
+func (l *lockFile) apply(fds []*desc.FileDescriptor) ([]*desc.FileDescriptor, error) {
+	var (
+		errs     error
+		messages = make(map[string]*lockedMessage)
+		enums    = make(map[string]*lockedEnum)
+		out      = make([]*desc.FileDescriptor, 0, len(fds))
+	)
+	for _, fd := range fds {
+		fb, err := builder.FromFile(fd)
+		if err != nil {
+			return nil, err
+		}
+		for _, md := range fd.GetMessageTypes() {
+			errs = multierr.Append(errs, l.applyMessage(md, fb.GetMessage(md.GetName()), messages, enums))
+		}
+		for _, ed := range fd.GetEnumTypes() {
+			errs = multierr.Append(errs, l.applyEnum(ed, fb.GetEnum(ed.GetName()), enums))
+		}
+		if errs != nil {
+			continue
+		}
+		if fd, err = fb.Build(); err != nil {
+			return nil, err
+		}
+		out = append(out, fd)
+	}
+	if errs != nil {
+		return nil, fmt.Errorf("entproto: generated files do not match %s: %w", LockFileName, errs)
+	}
+	for name, m := range l.Messages {
+		if _, ok := messages[name]; !ok {
+			messages[name] = m
+		}
+	}
+	for name, e := range l.Enums {
+		if _, ok := enums[name]; !ok {
+			enums[name] = e
+		}
+	}
+	l.Messages, l.Enums = messages, enums
+	return out, nil
+}
+
+func (l *lockFile) applyMessage(md *desc.MessageDescriptor, mb *builder.MessageBuilder, messages map[string]*lockedMessage, enums map[string]*lockedEnum) error {
+	var errs error
+	for _, nested := range md.GetNestedMessageTypes() {
+		errs = multierr.Append(errs, l.applyMessage(nested, mb.GetNestedMessage(nested.GetName()), messages, enums))
+	}
+	for _, ed := range md.GetNestedEnumTypes() {
+		errs = multierr.Append(errs, l.applyEnum(ed, mb.GetNestedEnum(ed.GetName()), enums))
+	}
+	name := md.GetFullyQualifiedName()
+	locked, ok := l.Messages[name]
+	if !ok {
+		locked = &lockedMessage{}
+	}
+	msg := &lockedMessage{
+		Fields:   make(map[string]*lockedField),
+		Reserved: make(map[string]*lockedField),
+	}
+	renamed := make(map[string]bool)
+	for n, f := range locked.Reserved {
+		msg.Reserved[n] = f
+	}
+	for _, fld := range md.GetFields() {
+		cur := toLockedField(fld)
+		msg.Fields[fld.GetName()] = cur
+		for n, prev := range locked.Reserved {
+			if prev.Number == cur.Number && n != fld.GetName() {
+				errs = multierr.Append(errs, fmt.Errorf("field %q of message %q reuses the number %d of removed field %q",
+					fld.GetName(), name, cur.Number, n))
+			}
+		}
+		if prev, ok := locked.Fields[fld.GetName()]; ok {
+			switch {
+			case prev.Number != cur.Number:
+				errs = multierr.Append(errs, fmt.Errorf("field %q of message %q changed number from %d to %d",
+					fld.GetName(), name, prev.Number, cur.Number))
+			case !prev.compatible(cur):
+				errs = multierr.Append(errs, fmt.Errorf("field %q of message %q changed type from %s to %s",
+					fld.GetName(), name, prev, cur))
+			}
+			continue
+		}
+		// A removed field may be added back with its original number and a compatible type.
+		if prev, ok := msg.Reserved[fld.GetName()]; ok {
+			if prev.Number != cur.Number || !prev.compatible(cur) {
+				errs = multierr.Append(errs, fmt.Errorf("field %q of message %q reuses the name of a removed field",
+					fld.GetName(), name))
+			}
+			delete(msg.Reserved, fld.GetName())
+			continue
+		}
+		// A field that takes the number of a removed field with a compatible type is a rename.
+		for n, prev := range locked.Fields {
+			switch {
+			case prev.Number != cur.Number || md.FindFieldByName(n) != nil:
+			case prev.compatible(cur):
+				renamed[n] = true
+			default:
+				errs = multierr.Append(errs, fmt.Errorf("field %q of message %q reuses the number %d of removed field %q of type %s; remove the %q entry of %q from %s to accept the change",
+					fld.GetName(), name, cur.Number, n, prev, n, name, LockFileName))
+			}
+		}
+	}
+	for n, prev := range locked.Fields {
+		if _, ok := msg.Fields[n]; !ok && !renamed[n] {
+			msg.Reserved[n] = prev
+		}
+	}
+	if errs != nil {
+		return errs
+	}
+	names := make([]string, 0, len(msg.Reserved))
+	for n := range msg.Reserved {
+		names = append(names, n)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return msg.Reserved[names[i]].Number < msg.Reserved[names[j]].Number
+	})
+	for _, n := range names {
+		num := msg.Reserved[n].Number
+		mb.AddReservedRange(num, num)
+		mb.AddReservedName(n)
+	}
+	messages[name] = msg
+	return nil
+}
+
+func (l *lockFile) applyEnum(ed *desc.EnumDescriptor, eb *builder.EnumBuilder, enums map[string]*lockedEnum) error {
+	var errs error
+	name := ed.GetFullyQualifiedName()
+	locked, ok := l.Enums[name]
+	if !ok {
+		locked = &lockedEnum{}
+	}
+	enum := &lockedEnum{
+		Values:   make(map[string]int32),
+		Reserved: make(map[string]int32),
+	}
+	for n, num := range locked.Reserved {
+		enum.Reserved[n] = num
+	}
+	for _, v := range ed.GetValues() {
+		num := v.GetNumber()
+		enum.Values[v.GetName()] = num
+		for n, prev := range locked.Reserved {
+			if prev == num && n != v.GetName() {
+				errs = multierr.Append(errs, fmt.Errorf("value %q of enum %q reuses the number %d of removed value %q",
+					v.GetName(), name, num, n))
+			}
+		}
+		if prev, ok := locked.Values[v.GetName()]; ok {
+			if prev != num {
+				errs = multierr.Append(errs, fmt.Errorf("value %q of enum %q changed number from %d to %d",
+					v.GetName(), name, prev, num))
+			}
+			continue
+		}
+		if prev, ok := enum.Reserved[v.GetName()]; ok {
+			if prev != num {
+				errs = multierr.Append(errs, fmt.Errorf("value %q of enum %q reuses the name of a removed value",
+					v.GetName(), name))
+			}
+			delete(enum.Reserved, v.GetName())
+			continue
+		}
+		for n, prev := range locked.Values {
+			if prev == num && ed.FindValueByName(n) == nil {
+				errs = multierr.Append(errs, fmt.Errorf("value %q of enum %q reuses the number %d of removed value %q",
+					v.GetName(), name, num, n))
+			}
+		}
+	}
+	for n, num := range locked.Values {
+		if _, ok := enum.Values[n]; !ok {
+			enum.Reserved[n] = num
+		}
+	}
+	if errs != nil {
+		return errs
+	}
+	names := make([]string, 0, len(enum.Reserved))
+	for n := range enum.Reserved {
+		names = append(names, n)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return enum.Reserved[names[i]] < enum.Reserved[names[j]]
+	})
+	for _, n := range names {
+		num := enum.Reserved[n]
+		eb.AddReservedRange(num, num)
+		eb.AddReservedName(n)
+	}
+	enums[name] = enum
+	return nil
+}
+
+func toLockedField(fld *desc.FieldDescriptor) *lockedField {
+	f := &lockedField{
+		Number:   fld.GetNumber(),
+		Type:     strings.ToLower(strings.TrimPrefix(fld.GetType().String(), "TYPE_")),
+		Repeated: fld.IsRepeated(),
+	}
+	if md := fld.GetMessageType(); md != nil {
+		f.TypeName = md.GetFullyQualifiedName()
+	}
+	return f
+}
+
+// wireTypes groups the scalar types whose values can be read as each other, as described in
+// https://developers.google.com/protocol-buffers/docs/proto3#updating.
+var wireTypes = map[string]string{
+	"int32":    "varint",
+	"int64":    "varint",
+	"uint32":   "varint",
+	"uint64":   "varint",
+	"bool":     "varint",
+	"enum":     "varint",
+	"sint32":   "zigzag",
+	"sint64":   "zigzag",
+	"fixed32":  "fixed32",
+	"sfixed32": "fixed32",
+	"fixed64":  "fixed64",
+	"sfixed64": "fixed64",
+	"string":   "bytes",
+	"bytes":    "bytes",
+}
+
+// compatible reports if values of the field f can be read as values of the field o.
+func (f *lockedField) compatible(o *lockedField) bool {
+	if f.Repeated != o.Repeated {
+		return false
+	}
+	if f.Type == o.Type {
+		return f.TypeName == o.TypeName
+	}
+	g, ok := wireTypes[f.Type]
+	return ok && g == wireTypes[o.Type]
+}
+
+func (f *lockedField) String() string {
+	s := f.Type
+	if f.TypeName != "" {
+		s = f.TypeName
+	}
+	if f.Repeated {
+		s = "repeated " + s
+	}
+	return s
+}
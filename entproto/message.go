@@ -55,9 +55,18 @@ func PackageName(pkg string) MessageOption {
 	}
 }
 
+// Proto3Optional modifies the generated message to map optional scalar fields to proto3 `optional` fields
+// with explicit presence, instead of wrapper types such as google.protobuf.StringValue.
+func Proto3Optional() MessageOption {
+	return func(msg *message) {
+		msg.Proto3Optional = true
+	}
+}
+
 type message struct {
-	Generate bool
-	Package  string
+	Generate       bool
+	Package        string
+	Proto3Optional bool
 }
 
 func (m message) Name() string {
@@ -0,0 +1,40 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MaxBatchSize is the maximum number of items in the requests of batch methods.
+const MaxBatchSize = 1000
+
+// IDIn returns a predicate that matches the rows whose id column is one of the given ids.
+func IDIn(idColumn string, ids []interface{}) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.In(s.C(idColumn), ids...))
+	}
+}
+
+// Rollback rolls back the transaction of a failed atomic batch request, and returns the error
+// the request failed with.
+func Rollback(tx interface{ Rollback() error }, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return status.Errorf(codes.Internal, "internal error: %s: rolling back transaction: %s", err, rerr)
+	}
+	return err
+}
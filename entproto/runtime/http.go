@@ -0,0 +1,333 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MaxHTTPBodySize is the maximum size of the HTTP request bodies read by HTTPMux. It matches the default
+// maximum size of the messages received by a gRPC server.
+const MaxHTTPBodySize = 4 << 20
+
+// HTTPCall calls a service method with its request, after decoding the HTTP request into it.
+type HTTPCall func(ctx context.Context, decode func(req proto.Message) error) (proto.Message, error)
+
+// HTTPMux is an http.Handler that transcodes JSON HTTP requests to the methods of a service, following
+// their google.api.http rules. Requests and responses are encoded with protojson, and errors are written
+// as the JSON encoding of their google.rpc.Status.
+type HTTPMux struct {
+	routes []*httpRoute
+}
+
+type httpRoute struct {
+	method string
+	// segments holds the path segments of the rule, where variables are held as "{field.path}".
+	segments []string
+	verb     string
+	body     string
+	call     HTTPCall
+}
+
+// NewHTTPMux returns a new HTTPMux with no routes.
+func NewHTTPMux() *HTTPMux {
+	return &HTTPMux{}
+}
+
+// Handle routes the requests matching the HTTP method and path template of a google.api.http rule to
+// call. Variables of the template match a single path segment. body is the body field of the rule:
+// empty if the request has no body, "*" if the body holds the request, or the name of the request
+// field it holds.
+func (m *HTTPMux) Handle(method, template, body string, call HTTPCall) {
+	rt := &httpRoute{method: method, body: body, call: call}
+	template = strings.TrimPrefix(template, "/")
+	if i := strings.LastIndexByte(template, ':'); i > strings.LastIndexByte(template, '}') {
+		template, rt.verb = template[:i], template[i+1:]
+	}
+	rt.segments = strings.Split(template, "/")
+	m.routes = append(m.routes, rt)
+}
+
+// ServeHTTP implements http.Handler.
+func (m *HTTPMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	methodMismatch := false
+	for _, rt := range m.routes {
+		params, ok := rt.match(r.URL.EscapedPath())
+		if !ok {
+			continue
+		}
+		if rt.method != r.Method {
+			methodMismatch = true
+			continue
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, MaxHTTPBodySize)
+		res, err := rt.call(r.Context(), func(req proto.Message) error {
+			if err := rt.decode(r, params, req); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			}
+			return nil
+		})
+		if err != nil {
+			writeHTTPError(w, 0, err)
+			return
+		}
+		b, err := protojson.Marshal(res)
+		if err != nil {
+			writeHTTPError(w, 0, status.Errorf(codes.Internal, "internal error: %s", err))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b)
+		return
+	}
+	if methodMismatch {
+		writeHTTPError(w, http.StatusMethodNotAllowed, status.Errorf(codes.Unimplemented, "method %s not allowed", r.Method))
+		return
+	}
+	writeHTTPError(w, 0, status.Errorf(codes.NotFound, "not found: %s", r.URL.Path))
+}
+
+// match matches the escaped path to the route, and returns the unescaped values of its variables.
+func (rt *httpRoute) match(path string) (map[string]string, bool) {
+	path = strings.TrimPrefix(path, "/")
+	if rt.verb != "" {
+		if !strings.HasSuffix(path, ":"+rt.verb) {
+			return nil, false
+		}
+		path = strings.TrimSuffix(path, ":"+rt.verb)
+	}
+	segments := strings.Split(path, "/")
+	if len(segments) != len(rt.segments) {
+		return nil, false
+	}
+	params := make(map[string]string)
+	for i, s := range rt.segments {
+		if !strings.HasPrefix(s, "{") {
+			if segments[i] != s {
+				return nil, false
+			}
+			continue
+		}
+		v, err := url.PathUnescape(segments[i])
+		if err != nil || v == "" {
+			return nil, false
+		}
+		params[strings.Trim(s, "{}")] = v
+	}
+	return params, true
+}
+
+// decode decodes the body, query parameters and path variables of the HTTP request into req.
+func (rt *httpRoute) decode(r *http.Request, params map[string]string, req proto.Message) error {
+	msg := req.ProtoReflect()
+	if rt.body != "" {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			return err
+		}
+		if len(b) > 0 {
+			target := req
+			if rt.body != "*" {
+				fd := msg.Descriptor().Fields().ByName(protoreflect.Name(rt.body))
+				if fd == nil || fd.Message() == nil || fd.IsList() {
+					return fmt.Errorf("unknown body field %q", rt.body)
+				}
+				target = msg.Mutable(fd).Message().Interface()
+			}
+			if err := protojson.Unmarshal(b, target); err != nil {
+				return err
+			}
+		}
+	}
+	// The fields of requests that are held by the body cannot be set by query parameters.
+	if rt.body != "*" {
+		for key, values := range r.URL.Query() {
+			for _, v := range values {
+				if err := setHTTPField(msg, key, v); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	for path, v := range params {
+		if err := setHTTPField(msg, path, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setHTTPField sets the field of msg at the dot-separated path of field names to the value of a path
+// variable or query parameter. Values of repeated fields are appended to the field.
+func setHTTPField(msg protoreflect.Message, path, value string) error {
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fields := msg.Descriptor().Fields()
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = fields.ByJSONName(name)
+		}
+		if fd == nil || fd.IsMap() {
+			return fmt.Errorf("unknown field %q", path)
+		}
+		if i < len(names)-1 {
+			if fd.Message() == nil || fd.IsList() {
+				return fmt.Errorf("unknown field %q", path)
+			}
+			msg = msg.Mutable(fd).Message()
+			continue
+		}
+		if !fd.IsList() {
+			v, err := parseHTTPValue(fd, msg.NewField(fd), value)
+			if err != nil {
+				return fmt.Errorf("field %q: %w", path, err)
+			}
+			msg.Set(fd, v)
+			continue
+		}
+		list := msg.Mutable(fd).List()
+		v, err := parseHTTPValue(fd, list.NewElement(), value)
+		if err != nil {
+			return fmt.Errorf("field %q: %w", path, err)
+		}
+		list.Append(v)
+	}
+	return nil
+}
+
+// parseHTTPValue parses the value of a path variable or query parameter for the field. zero is the
+// zero value of the field, holding a new message for message fields.
+func parseHTTPValue(fd protoreflect.FieldDescriptor, zero protoreflect.Value, value string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(value)
+		return protoreflect.ValueOfBool(v), err
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(value)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		v, err := strconv.ParseInt(value, 10, 32)
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(value, 10, 32)
+		return protoreflect.ValueOfInt32(int32(v)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(value, 10, 64)
+		return protoreflect.ValueOfInt64(v), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(value, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(v)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(value, 10, 64)
+		return protoreflect.ValueOfUint64(v), err
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(value, 32)
+		return protoreflect.ValueOfFloat32(float32(v)), err
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(value, 64)
+		return protoreflect.ValueOfFloat64(v), err
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(value), nil
+	case protoreflect.BytesKind:
+		v, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			v, err = base64.URLEncoding.DecodeString(value)
+		}
+		return protoreflect.ValueOfBytes(v), err
+	case protoreflect.MessageKind:
+		msg := zero.Message()
+		md := msg.Descriptor()
+		switch {
+		case md.FullName() == "google.protobuf.FieldMask":
+			// Field masks are accepted with the proto names of their paths, unlike their JSON encoding.
+			paths := msg.Mutable(md.Fields().ByName("paths")).List()
+			for _, p := range strings.Split(value, ",") {
+				paths.Append(protoreflect.ValueOfString(p))
+			}
+		case md.FullName().Parent() == "google.protobuf" && md.Fields().Len() == 1 &&
+			md.Fields().Get(0).Name() == "value" && md.Fields().Get(0).Message() == nil:
+			// Wrapper types hold the value of their wrapped field.
+			if err := setHTTPField(msg, "value", value); err != nil {
+				return zero, err
+			}
+		default:
+			if err := protojson.Unmarshal([]byte(strconv.Quote(value)), msg.Interface()); err != nil {
+				return zero, err
+			}
+		}
+		return zero, nil
+	default:
+		return zero, fmt.Errorf("unsupported field kind %s", fd.Kind())
+	}
+}
+
+// writeHTTPError writes the JSON encoding of the google.rpc.Status of err. A zero code writes the HTTP
+// status code mapped from the gRPC code of err.
+func writeHTTPError(w http.ResponseWriter, code int, err error) {
+	st := status.Convert(err)
+	if code == 0 {
+		code = httpStatusFromCode(st.Code())
+	}
+	b, err := protojson.Marshal(st.Proto())
+	if err != nil {
+		code, b = http.StatusInternalServerError, []byte(`{"code":13,"message":"internal error"}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(b)
+}
+
+// httpStatusFromCode returns the HTTP status code of a gRPC code, as mapped by grpc-gateway.
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
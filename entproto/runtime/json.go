@@ -0,0 +1,50 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ToProtoJSON sets msg to the JSON encoding of v, the value of an ent JSON field. A nil v leaves msg
+// empty, unless msg is a google.protobuf.Value, which is set to null.
+func ToProtoJSON(v interface{}, msg proto.Message) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if _, ok := msg.(*structpb.Value); !ok && bytes.Equal(b, []byte("null")) {
+		return nil
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(b, msg)
+}
+
+// FromProtoJSON decodes the JSON encoding of msg into v, the value of an ent JSON field. Message fields
+// are encoded using their proto names. A nil msg leaves v unchanged.
+func FromProtoJSON(msg proto.Message, v interface{}) error {
+	if msg == nil || !msg.ProtoReflect().IsValid() {
+		return nil
+	}
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
@@ -0,0 +1,168 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// DefaultPageSize is the number of results returned by List methods when no page size is requested.
+	DefaultPageSize = 50
+	// MaxPageSize is the maximum number of results returned by List methods. Larger page sizes are
+	// coerced to it.
+	MaxPageSize = 1000
+)
+
+// PageSize returns the number of results to return for the page_size field of a List request.
+func PageSize(size int32) (int, error) {
+	switch {
+	case size < 0:
+		return 0, errors.New("page size cannot be less than zero")
+	case size == 0:
+		return DefaultPageSize, nil
+	case size > MaxPageSize:
+		return MaxPageSize, nil
+	default:
+		return int(size), nil
+	}
+}
+
+// OrderBy is the parsed form of the order_by field of a List request.
+type OrderBy struct {
+	// Field is the name of the proto field to order by, or empty when ordering by id.
+	Field string
+	// Desc reports if the results are ordered in descending order.
+	Desc bool
+}
+
+// ParseOrderBy parses an order_by field in the form of "<field> [asc|desc]".
+func ParseOrderBy(orderBy string) (OrderBy, error) {
+	parts := strings.Fields(orderBy)
+	switch {
+	case len(parts) == 0:
+		return OrderBy{}, nil
+	case len(parts) > 2:
+		return OrderBy{}, fmt.Errorf("malformed order_by %q", orderBy)
+	case len(parts) == 1 || strings.EqualFold(parts[1], "asc"):
+		return OrderBy{Field: parts[0]}, nil
+	case strings.EqualFold(parts[1], "desc"):
+		return OrderBy{Field: parts[0], Desc: true}, nil
+	default:
+		return OrderBy{}, fmt.Errorf("unknown order_by direction %q", parts[1])
+	}
+}
+
+// String returns the canonical form of the order_by.
+func (o OrderBy) String() string {
+	if o.Desc {
+		return o.Field + " desc"
+	}
+	return o.Field
+}
+
+// pageToken is the JSON form of the page tokens returned by List methods. It holds the id and the
+// ordered field value of the last result of the page, and the order_by of the request that it was
+// returned for.
+type pageToken struct {
+	OrderBy string          `json:"o,omitempty"`
+	ID      json.RawMessage `json:"i"`
+	Value   json.RawMessage `json:"v,omitempty"`
+}
+
+// EncodePageToken returns the page token that points after the result with the given id and ordered field
+// value. The value is nil when the results are ordered by id.
+func EncodePageToken(o OrderBy, id, value interface{}) (string, error) {
+	var (
+		t   = pageToken{OrderBy: o.String()}
+		err error
+	)
+	if t.ID, err = json.Marshal(id); err != nil {
+		return "", err
+	}
+	if value != nil {
+		if t.Value, err = json.Marshal(value); err != nil {
+			return "", err
+		}
+	}
+	buf, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// DecodePageToken decodes a page token returned by EncodePageToken into the id and value pointers. An error
+// is returned if the token is malformed or was returned for a different order_by.
+func DecodePageToken(token string, o OrderBy, id, value interface{}) error {
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return errors.New("malformed page token")
+	}
+	var t pageToken
+	if err := json.Unmarshal(buf, &t); err != nil || t.ID == nil {
+		return errors.New("malformed page token")
+	}
+	if t.OrderBy != o.String() {
+		return errors.New("page token does not match order_by")
+	}
+	if err := json.Unmarshal(t.ID, id); err != nil {
+		return errors.New("malformed page token")
+	}
+	if value != nil {
+		if t.Value == nil {
+			return errors.New("malformed page token")
+		}
+		if err := json.Unmarshal(t.Value, value); err != nil {
+			return errors.New("malformed page token")
+		}
+	}
+	return nil
+}
+
+// PageAfter returns a predicate that matches the results ordered after the result with the given id and
+// ordered column value, for keyset pagination. The id and value are pointers, as passed to DecodePageToken,
+// and the value is nil when the results are ordered by id.
+func PageAfter(o OrderBy, idColumn string, id interface{}, column string, value interface{}) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		cmp := sql.GT
+		if o.Desc {
+			cmp = sql.LT
+		}
+		after := cmp(s.C(idColumn), indirect(id))
+		if value != nil {
+			v := indirect(value)
+			after = sql.Or(
+				cmp(s.C(column), v),
+				sql.And(sql.EQ(s.C(column), v), after),
+			)
+		}
+		s.Where(after)
+	}
+}
+
+func indirect(v interface{}) interface{} {
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr {
+		return rv.Elem().Interface()
+	}
+	return v
+}
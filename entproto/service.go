@@ -20,7 +20,10 @@ import (
 
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
 	"github.com/mitchellh/mapstructure"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/descriptorpb"
 	_ "google.golang.org/protobuf/types/known/emptypb"
 )
@@ -33,27 +36,137 @@ const (
 	create                   = "Create"
 	update                   = "Update"
 	delete_                  = "Delete"
+	list                     = "List"
+	batchCreate              = "BatchCreate"
+	batchGet                 = "BatchGet"
+	batchDelete              = "BatchDelete"
 )
 
 var (
 	errNoServiceDef = errors.New("entproto: annotation entproto.Service missing")
 )
 
+// Method is a bit set of the methods of a generated service.
+type Method uint
+
+// Methods of the generated service.
+const (
+	MethodCreate Method = 1 << iota
+	MethodGet
+	MethodUpdate
+	MethodDelete
+	MethodList
+	MethodBatchCreate
+	MethodBatchGet
+	MethodBatchDelete
+	// MethodAll holds the Create, Get, Update, Delete and List methods, which are generated by default.
+	MethodAll = MethodCreate | MethodGet | MethodUpdate | MethodDelete | MethodList
+	// MethodBatch holds the BatchCreate, BatchGet and BatchDelete methods.
+	MethodBatch = MethodBatchCreate | MethodBatchGet | MethodBatchDelete
+)
+
+// serviceMethods lists the methods of a generated service in their order in the service definition.
+var serviceMethods = []struct {
+	bit Method
+	m   method
+}{
+	{MethodCreate, create},
+	{MethodGet, get},
+	{MethodUpdate, update},
+	{MethodDelete, delete_},
+	{MethodList, list},
+	{MethodBatchCreate, batchCreate},
+	{MethodBatchGet, batchGet},
+	{MethodBatchDelete, batchDelete},
+}
+
 type service struct {
-	Generate bool
+	Generate       bool
+	Batch          bool
+	Methods        Method
+	ServiceName    string
+	ServicePackage string
+	HTTPPath       string
 }
 
 func (service) Name() string {
 	return ServiceAnnotation
 }
 
-func Service() schema.Annotation {
-	return service{Generate: true}
+// ServiceOption configures the entproto.Service annotation
+type ServiceOption func(svc *service)
+
+// Service annotates an ent.Schema to specify that protobuf service generation is required for it.
+func Service(opts ...ServiceOption) schema.Annotation {
+	s := service{Generate: true, Methods: MethodAll}
+	for _, apply := range opts {
+		apply(&s)
+	}
+	return s
+}
+
+// BatchMethods adds the BatchCreate, BatchGet and BatchDelete methods to the generated service. The results
+// of batch methods are reported per item, unless the request is atomic. Atomic BatchCreate and BatchDelete
+// requests are executed in a single transaction, and fail as a whole if one of their items fails.
+func BatchMethods() ServiceOption {
+	return func(svc *service) {
+		svc.Batch = true
+	}
+}
+
+// Methods sets the methods of the generated service, replacing the default MethodAll. For example, a
+// read-only service is generated with:
+//
+//	entproto.Service(
+//		entproto.Methods(entproto.MethodGet | entproto.MethodList),
+//	)
+func Methods(methods Method) ServiceOption {
+	return func(svc *service) {
+		svc.Methods = methods
+	}
+}
+
+// ServiceName sets the name of the generated service. Defaults to "<Schema>Service".
+func ServiceName(name string) ServiceOption {
+	return func(svc *service) {
+		svc.ServiceName = name
+	}
+}
+
+// ServicePackage sets the protobuf package of the generated service and its request and response
+// messages. Defaults to the package of the schema message.
+func ServicePackage(pkg string) ServiceOption {
+	return func(svc *service) {
+		svc.ServicePackage = pkg
+	}
+}
+
+// HTTP adds google.api.http annotations to the methods of the generated service, mapping them to REST
+// routes under the given collection path, such as "/v1/users":
+//
+//	Create       POST   /v1/users                body: user
+//	Get          GET    /v1/users/{id}
+//	Update       PATCH  /v1/users/{user.id}      body: user
+//	Delete       DELETE /v1/users/{id}
+//	List         GET    /v1/users
+//	BatchCreate  POST   /v1/users:batchCreate    body: *
+//	BatchGet     GET    /v1/users:batchGet
+//	BatchDelete  POST   /v1/users:batchDelete    body: *
+//
+// protoc-gen-entgrpc generates an http.Handler serving these routes, and an OpenAPI document describing them.
+func HTTP(path string) ServiceOption {
+	return func(svc *service) {
+		svc.HTTPPath = path
+	}
 }
 
-func (a *Adapter) createServiceResources(genType *gen.Type) (serviceResources, error) {
-	name := genType.Name
-	serviceFqn := fmt.Sprintf("%sService", name)
+// createServiceResources creates the service of the given type. msgType is the name of the type message,
+// qualified with its package if it is not defined in the package of the service.
+func (a *Adapter) createServiceResources(genType *gen.Type, svcAnnot *service, msgType string) (serviceResources, error) {
+	serviceFqn := svcAnnot.ServiceName
+	if serviceFqn == "" {
+		serviceFqn = fmt.Sprintf("%sService", genType.Name)
+	}
 
 	out := serviceResources{
 		svc: &descriptorpb.ServiceDescriptorProto{
@@ -61,19 +174,50 @@ func (a *Adapter) createServiceResources(genType *gen.Type) (serviceResources, e
 		},
 	}
 
-	for _, m := range []method{create, get, update, delete_} {
-		resources, err := a.genMethodProtos(genType, m)
+	methods := svcAnnot.Methods
+	if svcAnnot.Batch {
+		methods |= MethodBatch
+	}
+	if methods == 0 {
+		return serviceResources{}, fmt.Errorf("entproto: service of schema %q has no methods", genType.Name)
+	}
+	if methods&MethodDelete != 0 {
+		out.dependencies = append(out.dependencies, "google/protobuf/empty.proto")
+	}
+	if methods&MethodUpdate != 0 {
+		out.dependencies = append(out.dependencies, "google/protobuf/field_mask.proto")
+	}
+	if svcAnnot.HTTPPath != "" {
+		out.dependencies = append(out.dependencies, "google/api/annotations.proto")
+	}
+	idField, err := toProtoFieldDescriptor(genType.ID)
+	if err != nil {
+		return serviceResources{}, err
+	}
+	for _, sm := range serviceMethods {
+		if methods&sm.bit == 0 {
+			continue
+		}
+		resources, err := a.genMethodProtos(genType, sm.m, msgType)
 		if err != nil {
 			return serviceResources{}, err
 		}
+		if svcAnnot.HTTPPath != "" {
+			resources.methodDescriptor.Options = &descriptorpb.MethodOptions{}
+			rule := httpRule(sm.m, svcAnnot.HTTPPath, idField.GetName(), snake(genType.Name))
+			proto.SetExtension(resources.methodDescriptor.Options, annotations.E_Http, rule)
+		}
 		out.svc.Method = append(out.svc.Method, resources.methodDescriptor)
 		out.svcMessages = append(out.svcMessages, resources.input)
+		if resources.output != nil {
+			out.svcMessages = append(out.svcMessages, resources.output)
+		}
 	}
 
 	return out, nil
 }
 
-func (a *Adapter) genMethodProtos(genType *gen.Type, m method) (methodResources, error) {
+func (a *Adapter) genMethodProtos(genType *gen.Type, m method, msgType string) (methodResources, error) {
 	input := &descriptorpb.DescriptorProto{
 		Name: strptr(fmt.Sprintf("%s%sRequest", m, genType.Name)),
 	}
@@ -87,9 +231,30 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m method) (methodResources,
 		Name:     strptr(snake(genType.Name)),
 		Number:   int32ptr(1),
 		Type:     &protoMessageFieldType,
-		TypeName: &genType.Name,
+		TypeName: &msgType,
+	}
+	viewEnum := &descriptorpb.EnumDescriptorProto{
+		Name: strptr("View"),
+		Value: []*descriptorpb.EnumValueDescriptorProto{
+			{Number: int32ptr(0), Name: strptr("VIEW_UNSPECIFIED")},
+			{Number: int32ptr(1), Name: strptr("BASIC")},
+			{Number: int32ptr(2), Name: strptr("WITH_EDGE_IDS")},
+			{Number: int32ptr(3), Name: strptr("WITH_EDGES")},
+		},
+	}
+	protoStringFieldType := descriptorpb.FieldDescriptorProto_TYPE_STRING
+	edgesField := func(num int32) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:   strptr("edges"),
+			Number: int32ptr(num),
+			Label:  &repeatedFieldLabel,
+			Type:   &protoStringFieldType,
+		}
 	}
-	var output string
+	var (
+		output    string
+		outputMsg *descriptorpb.DescriptorProto
+	)
 	switch m {
 	case get:
 		input.Field = []*descriptorpb.FieldDescriptorProto{
@@ -100,25 +265,157 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m method) (methodResources,
 				Type:     &protoEnumFieldType,
 				TypeName: strptr("View"),
 			},
+			edgesField(3),
 		}
-		input.EnumType = append(input.EnumType, &descriptorpb.EnumDescriptorProto{
-			Name: strptr("View"),
-			Value: []*descriptorpb.EnumValueDescriptorProto{
-				{Number: int32ptr(0), Name: strptr("VIEW_UNSPECIFIED")},
-				{Number: int32ptr(1), Name: strptr("BASIC")},
-				{Number: int32ptr(2), Name: strptr("WITH_EDGE_IDS")},
-			},
-		})
-		output = genType.Name
+		input.EnumType = append(input.EnumType, viewEnum)
+		output = msgType
 	case create:
 		input.Field = []*descriptorpb.FieldDescriptorProto{singleMessageField}
-		output = genType.Name
+		output = msgType
 	case update:
-		input.Field = []*descriptorpb.FieldDescriptorProto{singleMessageField}
-		output = genType.Name
+		input.Field = []*descriptorpb.FieldDescriptorProto{
+			singleMessageField,
+			{
+				Name:     strptr("update_mask"),
+				Number:   int32ptr(2),
+				Type:     &protoMessageFieldType,
+				TypeName: strptr("google.protobuf.FieldMask"),
+			},
+		}
+		output = msgType
 	case delete_:
 		input.Field = []*descriptorpb.FieldDescriptorProto{idField}
 		output = "google.protobuf.Empty"
+	case list:
+		filter, err := toProtoFilterDescriptor(genType, msgType)
+		if err != nil {
+			return methodResources{}, err
+		}
+		protoInt32FieldType := descriptorpb.FieldDescriptorProto_TYPE_INT32
+		input.Field = []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:   strptr("page_size"),
+				Number: int32ptr(1),
+				Type:   &protoInt32FieldType,
+			},
+			{
+				Name:   strptr("page_token"),
+				Number: int32ptr(2),
+				Type:   &protoStringFieldType,
+			},
+			{
+				Name:     strptr("view"),
+				Number:   int32ptr(3),
+				Type:     &protoEnumFieldType,
+				TypeName: strptr("View"),
+			},
+			{
+				Name:   strptr("order_by"),
+				Number: int32ptr(4),
+				Type:   &protoStringFieldType,
+			},
+			{
+				Name:     strptr("filter"),
+				Number:   int32ptr(5),
+				Type:     &protoMessageFieldType,
+				TypeName: filter.Name,
+			},
+			edgesField(6),
+		}
+		input.NestedType = append(input.NestedType, filter)
+		input.EnumType = append(input.EnumType, viewEnum)
+		output = fmt.Sprintf("%s%sResponse", m, genType.Name)
+		outputMsg = &descriptorpb.DescriptorProto{
+			Name: strptr(output),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     strptr(snake(plural(genType.Name))),
+					Number:   int32ptr(1),
+					Label:    &repeatedFieldLabel,
+					Type:     &protoMessageFieldType,
+					TypeName: &msgType,
+				},
+				{
+					Name:   strptr("next_page_token"),
+					Number: int32ptr(2),
+					Type:   &protoStringFieldType,
+				},
+			},
+		}
+	case batchCreate, batchGet, batchDelete:
+		input.Name = strptr(fmt.Sprintf("%s%sRequest", m, plural(genType.Name)))
+		output = fmt.Sprintf("%s%sResponse", m, plural(genType.Name))
+		protoBoolFieldType := descriptorpb.FieldDescriptorProto_TYPE_BOOL
+		protoInt32FieldType := descriptorpb.FieldDescriptorProto_TYPE_INT32
+		idsField, err := toProtoFieldDescriptor(genType.ID)
+		if err != nil {
+			return methodResources{}, err
+		}
+		idsField.Name = strptr(plural(idField.GetName()))
+		idsField.Label = &repeatedFieldLabel
+		atomicField := &descriptorpb.FieldDescriptorProto{
+			Name:   strptr("atomic"),
+			Number: int32ptr(2),
+			Type:   &protoBoolFieldType,
+		}
+		// Batch results hold the result of each item, or its google.rpc.Code and error message.
+		result := &descriptorpb.DescriptorProto{
+			Name: strptr("Result"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				singleMessageField,
+				{
+					Name:   strptr("code"),
+					Number: int32ptr(2),
+					Type:   &protoInt32FieldType,
+				},
+				{
+					Name:   strptr("message"),
+					Number: int32ptr(3),
+					Type:   &protoStringFieldType,
+				},
+			},
+		}
+		switch m {
+		case batchCreate:
+			input.Field = []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     strptr("requests"),
+					Number:   int32ptr(1),
+					Label:    &repeatedFieldLabel,
+					Type:     &protoMessageFieldType,
+					TypeName: strptr(fmt.Sprintf("%s%sRequest", create, genType.Name)),
+				},
+				atomicField,
+			}
+		case batchGet:
+			input.Field = []*descriptorpb.FieldDescriptorProto{
+				idsField,
+				{
+					Name:     strptr("view"),
+					Number:   int32ptr(2),
+					Type:     &protoEnumFieldType,
+					TypeName: strptr("View"),
+				},
+				edgesField(3),
+			}
+			input.EnumType = append(input.EnumType, viewEnum)
+		case batchDelete:
+			input.Field = []*descriptorpb.FieldDescriptorProto{idsField, atomicField}
+			result.Field[0] = idField
+		}
+		outputMsg = &descriptorpb.DescriptorProto{
+			Name: strptr(output),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     strptr("results"),
+					Number:   int32ptr(1),
+					Label:    &repeatedFieldLabel,
+					Type:     &protoMessageFieldType,
+					TypeName: result.Name,
+				},
+			},
+			NestedType: []*descriptorpb.DescriptorProto{result},
+		}
 	default:
 		return methodResources{}, fmt.Errorf("unknown method %q", m)
 	}
@@ -128,18 +425,103 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m method) (methodResources,
 			InputType:  input.Name,
 			OutputType: &output,
 		},
-		input: input,
+		input:  input,
+		output: outputMsg,
 	}, nil
 }
 
+// httpRule returns the google.api.http rule of the method. id is the name of the ID field of the type message,
+// and msgField the name of the message field of the Create and Update requests.
+func httpRule(m method, path, id, msgField string) *annotations.HttpRule {
+	switch m {
+	case create:
+		return &annotations.HttpRule{Pattern: &annotations.HttpRule_Post{Post: path}, Body: msgField}
+	case get:
+		return &annotations.HttpRule{Pattern: &annotations.HttpRule_Get{Get: path + "/{" + id + "}"}}
+	case update:
+		return &annotations.HttpRule{
+			Pattern: &annotations.HttpRule_Patch{Patch: path + "/{" + msgField + "." + id + "}"},
+			Body:    msgField,
+		}
+	case delete_:
+		return &annotations.HttpRule{Pattern: &annotations.HttpRule_Delete{Delete: path + "/{" + id + "}"}}
+	case list:
+		return &annotations.HttpRule{Pattern: &annotations.HttpRule_Get{Get: path}}
+	case batchCreate:
+		return &annotations.HttpRule{Pattern: &annotations.HttpRule_Post{Post: path + ":batchCreate"}, Body: "*"}
+	case batchGet:
+		return &annotations.HttpRule{Pattern: &annotations.HttpRule_Get{Get: path + ":batchGet"}}
+	default:
+		return &annotations.HttpRule{Pattern: &annotations.HttpRule_Post{Post: path + ":batchDelete"}, Body: "*"}
+	}
+}
+
+// toProtoFilterDescriptor returns the Filter message of the List method of the given type. The Filter
+// message holds a repeated field for each of the filterable fields of the type, sharing the name and
+// number of the field in the type message. A row matches the filter if the value of each non-empty field
+// is one of the listed values.
+func toProtoFilterDescriptor(genType *gen.Type, msgType string) (*descriptorpb.DescriptorProto, error) {
+	filter := &descriptorpb.DescriptorProto{
+		Name: strptr("Filter"),
+	}
+	all := []*gen.Field{genType.ID}
+	all = append(all, genType.Fields...)
+	for _, f := range all {
+		ok, err := filterable(f)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		fieldDesc, err := toProtoFieldDescriptor(f)
+		if err != nil {
+			return nil, err
+		}
+		cfg := typeMap[f.Type.Type]
+		fieldDesc.Type = &cfg.pbType
+		fieldDesc.Label = &repeatedFieldLabel
+		fieldDesc.TypeName = nil
+		if f.IsEnum() {
+			fieldDesc.TypeName = strptr(msgType + "." + pascal(f.Name))
+		}
+		filter.Field = append(filter.Field, fieldDesc)
+	}
+	return filter, nil
+}
+
+// filterable reports if the List method of the type can filter on the given field.
+func filterable(f *gen.Field) (bool, error) {
+	if f.Sensitive() {
+		return false, nil
+	}
+	fann, err := extractFieldAnnotation(f)
+	if err != nil {
+		return false, err
+	}
+	if fann.Type != descriptorpb.FieldDescriptorProto_Type(0) {
+		return false, nil
+	}
+	switch f.Type.Type {
+	case field.TypeBool, field.TypeEnum, field.TypeString, field.TypeUUID,
+		field.TypeInt, field.TypeInt8, field.TypeInt16, field.TypeInt32, field.TypeInt64,
+		field.TypeUint, field.TypeUint8, field.TypeUint16, field.TypeUint32, field.TypeUint64:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
 type methodResources struct {
 	methodDescriptor *descriptorpb.MethodDescriptorProto
 	input            *descriptorpb.DescriptorProto
+	output           *descriptorpb.DescriptorProto
 }
 
 type serviceResources struct {
-	svc         *descriptorpb.ServiceDescriptorProto
-	svcMessages []*descriptorpb.DescriptorProto
+	svc          *descriptorpb.ServiceDescriptorProto
+	svcMessages  []*descriptorpb.DescriptorProto
+	dependencies []string
 }
 
 func extractServiceAnnotation(sch *gen.Type) (*service, error) {
@@ -15,6 +15,9 @@
 package entproto
 
 import (
+	"reflect"
+	"strings"
+
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema/field"
 	"google.golang.org/protobuf/types/descriptorpb"
@@ -23,7 +26,7 @@ import (
 var typeMap = map[field.Type]typeConfig{
 	field.TypeBool:  {pbType: descriptorpb.FieldDescriptorProto_TYPE_BOOL, optionalType: "google.protobuf.BoolValue"},
 	field.TypeTime:  {pbType: descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, msgTypeName: "google.protobuf.Timestamp", optionalType: "google.protobuf.Timestamp"},
-	field.TypeJSON:  {unsupported: true},
+	field.TypeJSON:  {pbType: descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, msgTypeName: "google.protobuf.Value"},
 	field.TypeOther: {unsupported: true},
 	field.TypeUUID:  {pbType: descriptorpb.FieldDescriptorProto_TYPE_BYTES, optionalType: "google.protobuf.BytesValue"},
 	field.TypeBytes: {pbType: descriptorpb.FieldDescriptorProto_TYPE_BYTES, optionalType: "google.protobuf.BytesValue"},
@@ -52,3 +55,45 @@ type typeConfig struct {
 	optionalType string
 	namer        func(fld *gen.Field) string
 }
+
+// jsonScalars maps the element types of JSON slice fields to the protobuf scalar types of their repeated fields.
+var jsonScalars = map[string]descriptorpb.FieldDescriptorProto_Type{
+	"bool":    descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+	"string":  descriptorpb.FieldDescriptorProto_TYPE_STRING,
+	"int":     descriptorpb.FieldDescriptorProto_TYPE_INT32,
+	"int8":    descriptorpb.FieldDescriptorProto_TYPE_INT32,
+	"int16":   descriptorpb.FieldDescriptorProto_TYPE_INT32,
+	"int32":   descriptorpb.FieldDescriptorProto_TYPE_INT32,
+	"int64":   descriptorpb.FieldDescriptorProto_TYPE_INT64,
+	"uint":    descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+	"uint16":  descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+	"uint32":  descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+	"uint64":  descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+	"float32": descriptorpb.FieldDescriptorProto_TYPE_FLOAT,
+	"float64": descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+}
+
+// extractJSONTypeDetails returns the protobuf type of a JSON field. Slices of scalars are mapped to
+// repeated scalars, other slices to google.protobuf.ListValue, maps with string keys to google.protobuf.Struct
+// and any other Go type to google.protobuf.Value.
+func extractJSONTypeDetails(f *gen.Field) fieldType {
+	details := fieldType{
+		protoType:   descriptorpb.FieldDescriptorProto_TYPE_MESSAGE,
+		messageName: typeMap[field.TypeJSON].msgTypeName,
+	}
+	rt := f.Type.RType
+	switch {
+	case rt == nil:
+	case rt.Kind == reflect.Slice && strings.HasPrefix(rt.Ident, "[]"):
+		if t, ok := jsonScalars[strings.TrimPrefix(rt.Ident, "[]")]; ok {
+			return fieldType{protoType: t, repeated: true}
+		}
+		// Byte slices are encoded as base64 strings.
+		if rt.Ident != "[]uint8" {
+			details.messageName = "google.protobuf.ListValue"
+		}
+	case rt.Kind == reflect.Map && strings.HasPrefix(rt.Ident, "map[string]"):
+		details.messageName = "google.protobuf.Struct"
+	}
+	return details
+}
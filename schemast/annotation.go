@@ -19,6 +19,7 @@ import (
 	"go/ast"
 	"sort"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
@@ -48,6 +49,7 @@ func Annotation(annot schema.Annotation) (ast.Expr, bool, error) {
 		entproto.FieldAnnotation:   protoField,
 		entproto.EnumAnnotation:    protoEnum,
 		"EntSQL":                   entSQL,
+		"EntGQL":                   entGQL,
 	}
 	fn, ok := annotators[annot.Name()]
 	if !ok {
@@ -186,6 +188,114 @@ func entSQL(annot schema.Annotation) (ast.Expr, bool, error) {
 	return c, true, nil
 }
 
+// entGQL builds the AST of an entgql annotation.
+func entGQL(annot schema.Annotation) (ast.Expr, bool, error) {
+	m := &entgql.Annotation{}
+	if err := m.Decode(annot); err != nil {
+		return nil, false, err
+	}
+	var (
+		calls []ast.Expr
+		attrs []ast.Expr
+	)
+	if m.OrderField != "" {
+		calls = append(calls, fnCall(selectorLit("entgql", "OrderField"), strLit(m.OrderField)))
+		attrs = append(attrs, structAttr("OrderField", strLit(m.OrderField)))
+	}
+	if m.Bind {
+		calls = append(calls, fnCall(selectorLit("entgql", "Bind")))
+		attrs = append(attrs, structAttr("Bind", ast.NewIdent("true")))
+	}
+	if len(m.Mapping) > 0 {
+		names := make([]ast.Expr, 0, len(m.Mapping))
+		for _, n := range m.Mapping {
+			names = append(names, strLit(n))
+		}
+		calls = append(calls, fnCall(selectorLit("entgql", "MapsTo"), names...))
+		attrs = append(attrs, structAttr("Mapping", &ast.CompositeLit{Type: &ast.ArrayType{Elt: ast.NewIdent("string")}, Elts: names}))
+	}
+	if m.Type != "" {
+		calls = append(calls, fnCall(selectorLit("entgql", "Type"), strLit(m.Type)))
+		attrs = append(attrs, structAttr("Type", strLit(m.Type)))
+	}
+	if m.Skip {
+		calls = append(calls, fnCall(selectorLit("entgql", "Skip")))
+		attrs = append(attrs, structAttr("Skip", ast.NewIdent("true")))
+	}
+	if m.QueryField != "" {
+		calls = append(calls, fnCall(selectorLit("entgql", "QueryField"), strLit(m.QueryField)))
+		attrs = append(attrs, structAttr("QueryField", strLit(m.QueryField)))
+	}
+	if len(m.RelayMutations) > 0 {
+		ops := make([]ast.Expr, 0, len(m.RelayMutations))
+		for _, op := range m.RelayMutations {
+			ops = append(ops, strLit(op))
+		}
+		calls = append(calls, fnCall(selectorLit("entgql", "RelayMutations"), ops...))
+		attrs = append(attrs, structAttr("RelayMutations", &ast.CompositeLit{Type: &ast.ArrayType{Elt: ast.NewIdent("string")}, Elts: ops}))
+	}
+	if m.MutationInputs {
+		calls = append(calls, fnCall(selectorLit("entgql", "MutationInputs")))
+		attrs = append(attrs, structAttr("MutationInputs", ast.NewIdent("true")))
+	}
+	if m.SoftDelete {
+		var opts []ast.Expr
+		attrs = append(attrs, structAttr("SoftDelete", ast.NewIdent("true")))
+		if m.IncludeDeleted {
+			opts = append(opts, fnCall(selectorLit("entgql", "AllowIncludeDeleted")))
+			attrs = append(attrs, structAttr("IncludeDeleted", ast.NewIdent("true")))
+		}
+		calls = append(calls, fnCall(selectorLit("entgql", "SoftDelete"), opts...))
+	}
+	if m.Scalar != "" {
+		calls = append(calls, fnCall(selectorLit("entgql", "Scalar"), strLit(m.Scalar)))
+		attrs = append(attrs, structAttr("Scalar", strLit(m.Scalar)))
+	}
+	if m.Deprecated != "" {
+		calls = append(calls, fnCall(selectorLit("entgql", "Deprecated"), strLit(m.Deprecated)))
+		attrs = append(attrs, structAttr("Deprecated", strLit(m.Deprecated)))
+	}
+	// The constraint arguments are pointers, and therefore, they are built
+	// using the entgql.Constraint options and merged into the other options.
+	var constraint *ast.CallExpr
+	if c := m.Constraint; c != nil {
+		var opts []ast.Expr
+		if c.MinLength != nil {
+			opts = append(opts, fnCall(selectorLit("entgql", "MinLen"), intLit(*c.MinLength)))
+		}
+		if c.MaxLength != nil {
+			opts = append(opts, fnCall(selectorLit("entgql", "MaxLen"), intLit(*c.MaxLength)))
+		}
+		if c.Min != nil {
+			opts = append(opts, fnCall(selectorLit("entgql", "Min"), floatLit(*c.Min)))
+		}
+		if c.Max != nil {
+			opts = append(opts, fnCall(selectorLit("entgql", "Max"), floatLit(*c.Max)))
+		}
+		if c.Pattern != "" {
+			opts = append(opts, fnCall(selectorLit("entgql", "Match"), strLit(c.Pattern)))
+		}
+		constraint = fnCall(selectorLit("entgql", "Constraint"), opts...)
+	}
+	var expr ast.Expr
+	switch len(calls) {
+	case 0:
+		if constraint == nil {
+			return nil, false, nil
+		}
+		return constraint, true, nil
+	case 1:
+		expr = calls[0]
+	default:
+		// Annotations that combine multiple options are built as a struct literal.
+		expr = &ast.CompositeLit{Type: selectorLit("entgql", "Annotation"), Elts: attrs}
+	}
+	if constraint != nil {
+		expr = fnCall(&ast.SelectorExpr{X: expr, Sel: ast.NewIdent("Merge")}, constraint)
+	}
+	return expr, true, nil
+}
+
 func toAnnotASTs(annots []schema.Annotation) ([]ast.Expr, error) {
 	out := make([]ast.Expr, 0, len(annots))
 	for _, annot := range annots {
@@ -20,6 +20,7 @@ import (
 	"go/token"
 	"testing"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
@@ -142,6 +143,54 @@ func TestAnnotation(t *testing.T) {
 			expectedOk:     false,
 			expectedErrMsg: `schemast: unknown entsql ReferenceOption: "UNSUPPORTED"`,
 		},
+		{
+			name:       "entgql order field",
+			annot:      entgql.Annotation{OrderField: "TEXT"},
+			expectedOk: true,
+			expected:   `entgql.OrderField("TEXT")`,
+		},
+		{
+			name:       "entgql mapping",
+			annot:      entgql.Annotation{Mapping: []string{"owner", "author"}},
+			expectedOk: true,
+			expected:   `entgql.MapsTo("owner", "author")`,
+		},
+		{
+			name:       "entgql combined",
+			annot:      entgql.Annotation{OrderField: "TEXT", Bind: true},
+			expectedOk: true,
+			expected:   `entgql.Annotation{OrderField: "TEXT", Bind: true}`,
+		},
+		{
+			name:       "entgql mutation inputs",
+			annot:      entgql.Annotation{MutationInputs: true},
+			expectedOk: true,
+			expected:   `entgql.MutationInputs()`,
+		},
+		{
+			name:       "entgql scalar",
+			annot:      entgql.Annotation{Scalar: "Money"},
+			expectedOk: true,
+			expected:   `entgql.Scalar("Money")`,
+		},
+		{
+			name:       "entgql soft delete",
+			annot:      entgql.SoftDelete(entgql.AllowIncludeDeleted()),
+			expectedOk: true,
+			expected:   `entgql.SoftDelete(entgql.AllowIncludeDeleted())`,
+		},
+		{
+			name:       "entgql constraint",
+			annot:      entgql.Constraint(entgql.NotEmpty(), entgql.Range(0, 1.5)),
+			expectedOk: true,
+			expected:   `entgql.Constraint(entgql.MinLen(1), entgql.Min(0), entgql.Max(1.5))`,
+		},
+		{
+			name:       "entgql combined with constraint",
+			annot:      entgql.Annotation{OrderField: "TEXT", Deprecated: "unused"}.Merge(entgql.Constraint(entgql.MaxLen(10))),
+			expectedOk: true,
+			expected:   `entgql.Annotation{OrderField: "TEXT", Deprecated: "unused"}.Merge(entgql.Constraint(entgql.MaxLen(10)))`,
+		},
 		{
 			name:           "unsupported annotation",
 			annot:          annotation("unsupported"),
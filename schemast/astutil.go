@@ -93,6 +93,13 @@ func intLit(lit int) ast.Expr {
 	}
 }
 
+func floatLit(lit float64) ast.Expr {
+	return &ast.BasicLit{
+		Kind:  token.FLOAT,
+		Value: strconv.FormatFloat(lit, 'g', -1, 64),
+	}
+}
+
 func selectorLit(x, sel string) *ast.SelectorExpr {
 	return &ast.SelectorExpr{
 		X:   ast.NewIdent(x),